@@ -24,7 +24,9 @@ import (
 	computev1alpha3 "github.com/crossplane/provider-azure/apis/compute/v1alpha3"
 	databasev1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
 	databasev1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	managedidentityv1alpha3 "github.com/crossplane/provider-azure/apis/managedidentity/v1alpha3"
 	networkv1alpha3 "github.com/crossplane/provider-azure/apis/network/v1alpha3"
+	networkv1beta1 "github.com/crossplane/provider-azure/apis/network/v1beta1"
 	storagev1alpha3 "github.com/crossplane/provider-azure/apis/storage/v1alpha3"
 	azurev1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
 	azurev1beta1 "github.com/crossplane/provider-azure/apis/v1beta1"
@@ -39,7 +41,9 @@ func init() {
 		computev1alpha3.SchemeBuilder.AddToScheme,
 		databasev1alpha3.SchemeBuilder.AddToScheme,
 		databasev1beta1.SchemeBuilder.AddToScheme,
+		managedidentityv1alpha3.SchemeBuilder.AddToScheme,
 		networkv1alpha3.SchemeBuilder.AddToScheme,
+		networkv1beta1.SchemeBuilder.AddToScheme,
 		storagev1alpha3.SchemeBuilder.AddToScheme,
 	)
 }
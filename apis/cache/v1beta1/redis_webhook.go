@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=update,path=/validate-cache-azure-crossplane-io-v1beta1-redis,mutating=false,failurePolicy=fail,groups=cache.azure.crossplane.io,resources=redis,versions=v1beta1,name=redis.cache.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// ValidateCreate validates a new Redis.
+func (r *Redis) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a Redis.
+func (r *Redis) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*Redis)
+	if !ok || validation.Deleting(r) {
+		return nil
+	}
+	p, op := r.Spec.ForProvider, o.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, op.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, op.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, op.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.Location, op.Location, path.Child("location"))...)
+	errs = append(errs, validation.Immutable(p.SubnetID, op.SubnetID, path.Child("subnetId"))...)
+	errs = append(errs, validation.Immutable(p.StaticIP, op.StaticIP, path.Child("staticIp"))...)
+	errs = append(errs, validation.Immutable(p.Zones, op.Zones, path.Child("zones"))...)
+	return validation.Invalid(RedisGroupVersionKind.GroupKind(), r.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of a Redis.
+func (r *Redis) ValidateDelete() error {
+	return nil
+}
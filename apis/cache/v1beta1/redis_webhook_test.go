@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRedisValidateUpdate(t *testing.T) {
+	now := metav1.Now()
+	redis := func(p RedisParameters, deleting bool) *Redis {
+		r := &Redis{Spec: RedisSpec{ForProvider: p}}
+		if deleting {
+			r.SetDeletionTimestamp(&now)
+		}
+		return r
+	}
+
+	cases := map[string]struct {
+		r, old  *Redis
+		wantErr bool
+	}{
+		"Unchanged": {
+			r:       redis(RedisParameters{Location: "westus", Zones: []string{"1"}}, false),
+			old:     redis(RedisParameters{Location: "westus", Zones: []string{"1"}}, false),
+			wantErr: false,
+		},
+		"PreviouslyUnset": {
+			r:       redis(RedisParameters{Location: "westus", Zones: []string{"1"}}, false),
+			old:     redis(RedisParameters{Location: "westus"}, false),
+			wantErr: false,
+		},
+		"LocationChanged": {
+			r:       redis(RedisParameters{Location: "eastus"}, false),
+			old:     redis(RedisParameters{Location: "westus"}, false),
+			wantErr: true,
+		},
+		"ZonesChanged": {
+			r:       redis(RedisParameters{Location: "westus", Zones: []string{"2"}}, false),
+			old:     redis(RedisParameters{Location: "westus", Zones: []string{"1"}}, false),
+			wantErr: true,
+		},
+		"LocationChangedButDeleting": {
+			r:       redis(RedisParameters{Location: "eastus"}, true),
+			old:     redis(RedisParameters{Location: "westus"}, false),
+			wantErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.r.ValidateUpdate(tc.old)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-compute-azure-crossplane-io-v1alpha3-akscluster,mutating=false,failurePolicy=fail,groups=compute.azure.crossplane.io,resources=aksclusters,versions=v1alpha3,name=aksclusters.compute.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// ValidateCreate validates a new AKSCluster.
+func (c *AKSCluster) ValidateCreate() error {
+	return validation.Invalid(AKSClusterGroupVersionKind.GroupKind(), c.GetName(), validateAKSClusterParameters(c.Spec.AKSClusterParameters))
+}
+
+// ValidateUpdate validates an update to an AKSCluster.
+func (c *AKSCluster) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*AKSCluster)
+	if !ok || validation.Deleting(c) {
+		return nil
+	}
+	errs := field.ErrorList{}
+	if validation.Changed(c.Spec.AKSClusterParameters, o.Spec.AKSClusterParameters) {
+		errs = validateAKSClusterParameters(c.Spec.AKSClusterParameters)
+	}
+	if c.Spec.EnablePrivateCluster != o.Spec.EnablePrivateCluster {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "enablePrivateCluster"), c.Spec.EnablePrivateCluster, "field is immutable"))
+	}
+	errs = append(errs, validation.Immutable(c.Spec.PrivateDNSZone, o.Spec.PrivateDNSZone, field.NewPath("spec", "privateDNSZone"))...)
+	return validation.Invalid(AKSClusterGroupVersionKind.GroupKind(), c.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of an AKSCluster.
+func (c *AKSCluster) ValidateDelete() error {
+	return nil
+}
+
+func validateAKSClusterParameters(p AKSClusterParameters) field.ErrorList {
+	path := field.NewPath("spec")
+	errs := field.ErrorList{}
+	if p.PrivateDNSZone != nil && !p.EnablePrivateCluster {
+		errs = append(errs, field.Forbidden(path.Child("privateDNSZone"), "only allowed when enablePrivateCluster is true"))
+	}
+	if len(p.APIServerAuthorizedIPRanges) > 0 && p.EnablePrivateCluster {
+		errs = append(errs, field.Forbidden(path.Child("apiServerAuthorizedIPRanges"), "not allowed when enablePrivateCluster is true"))
+	}
+	if !p.EnableAutoScaling {
+		return errs
+	}
+	if p.MinNodeCount == nil {
+		errs = append(errs, field.Required(path.Child("minNodeCount"), "required when enableAutoScaling is true"))
+	}
+	if p.MaxNodeCount == nil {
+		errs = append(errs, field.Required(path.Child("maxNodeCount"), "required when enableAutoScaling is true"))
+	}
+	if p.MinNodeCount != nil && p.MaxNodeCount != nil && *p.MinNodeCount > *p.MaxNodeCount {
+		errs = append(errs, field.Invalid(path.Child("minNodeCount"), *p.MinNodeCount, "must not be greater than maxNodeCount"))
+	}
+	return errs
+}
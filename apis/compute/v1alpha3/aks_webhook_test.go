@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateAKSClusterParameters(t *testing.T) {
+	one, three := 1, 3
+	zone := "System"
+	path := field.NewPath("spec")
+
+	cases := map[string]struct {
+		p    AKSClusterParameters
+		want []string
+	}{
+		"Defaults": {
+			p:    AKSClusterParameters{},
+			want: []string{},
+		},
+		"AutoScalingMissingNodeCounts": {
+			p: AKSClusterParameters{EnableAutoScaling: true},
+			want: []string{
+				path.Child("minNodeCount").String(),
+				path.Child("maxNodeCount").String(),
+			},
+		},
+		"AutoScalingMinGreaterThanMax": {
+			p:    AKSClusterParameters{EnableAutoScaling: true, MinNodeCount: &three, MaxNodeCount: &one},
+			want: []string{path.Child("minNodeCount").String()},
+		},
+		"AutoScalingComplete": {
+			p:    AKSClusterParameters{EnableAutoScaling: true, MinNodeCount: &one, MaxNodeCount: &three},
+			want: []string{},
+		},
+		"PrivateDNSZoneWithoutPrivateCluster": {
+			p:    AKSClusterParameters{PrivateDNSZone: &zone},
+			want: []string{path.Child("privateDNSZone").String()},
+		},
+		"PrivateDNSZoneWithPrivateCluster": {
+			p:    AKSClusterParameters{EnablePrivateCluster: true, PrivateDNSZone: &zone},
+			want: []string{},
+		},
+		"AuthorizedIPRangesWithPrivateCluster": {
+			p:    AKSClusterParameters{EnablePrivateCluster: true, APIServerAuthorizedIPRanges: []string{"10.0.0.0/8"}},
+			want: []string{path.Child("apiServerAuthorizedIPRanges").String()},
+		},
+		"AuthorizedIPRangesWithPublicCluster": {
+			p:    AKSClusterParameters{APIServerAuthorizedIPRanges: []string{"10.0.0.0/8"}},
+			want: []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			for _, err := range validateAKSClusterParameters(tc.p) {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("validateAKSClusterParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAKSClusterValidateUpdate(t *testing.T) {
+	now := metav1.Now()
+	zone, other := "System", "None"
+	cluster := func(p AKSClusterParameters, deleting bool) *AKSCluster {
+		c := &AKSCluster{Spec: AKSClusterSpec{AKSClusterParameters: p}}
+		if deleting {
+			c.SetDeletionTimestamp(&now)
+		}
+		return c
+	}
+
+	cases := map[string]struct {
+		c, old  *AKSCluster
+		wantErr bool
+	}{
+		"InvalidButUnchanged": {
+			c:       cluster(AKSClusterParameters{EnableAutoScaling: true}, false),
+			old:     cluster(AKSClusterParameters{EnableAutoScaling: true}, false),
+			wantErr: false,
+		},
+		"InvalidAndChanged": {
+			c:       cluster(AKSClusterParameters{EnableAutoScaling: true, NodeCount: new(int)}, false),
+			old:     cluster(AKSClusterParameters{EnableAutoScaling: true}, false),
+			wantErr: true,
+		},
+		"EnablePrivateClusterChanged": {
+			c:       cluster(AKSClusterParameters{EnablePrivateCluster: true}, false),
+			old:     cluster(AKSClusterParameters{}, false),
+			wantErr: true,
+		},
+		"PrivateDNSZoneChanged": {
+			c:       cluster(AKSClusterParameters{EnablePrivateCluster: true, PrivateDNSZone: &other}, false),
+			old:     cluster(AKSClusterParameters{EnablePrivateCluster: true, PrivateDNSZone: &zone}, false),
+			wantErr: true,
+		},
+		"PrivateDNSZoneChangedButDeleting": {
+			c:       cluster(AKSClusterParameters{EnablePrivateCluster: true, PrivateDNSZone: &other}, true),
+			old:     cluster(AKSClusterParameters{EnablePrivateCluster: true, PrivateDNSZone: &zone}, false),
+			wantErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.c.ValidateUpdate(tc.old)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
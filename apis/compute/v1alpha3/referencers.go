@@ -24,7 +24,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
-	networkv1alpha3 "github.com/crossplane/provider-azure/apis/network/v1alpha3"
+	networkv1beta1 "github.com/crossplane/provider-azure/apis/network/v1beta1"
 	"github.com/crossplane/provider-azure/apis/v1alpha3"
 )
 
@@ -51,8 +51,8 @@ func (mg *AKSCluster) ResolveReferences(ctx context.Context, c client.Reader) er
 		CurrentValue: mg.Spec.VnetSubnetID,
 		Reference:    mg.Spec.VnetSubnetIDRef,
 		Selector:     mg.Spec.VnetSubnetIDSelector,
-		To:           reference.To{Managed: &networkv1alpha3.Subnet{}, List: &networkv1alpha3.SubnetList{}},
-		Extract:      networkv1alpha3.SubnetID(),
+		To:           reference.To{Managed: &networkv1beta1.Subnet{}, List: &networkv1beta1.SubnetList{}},
+		Extract:      networkv1beta1.SubnetID(),
 	})
 	if err != nil {
 		return errors.Wrap(err, "spec.vnetSubnetID")
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
 )
 
 const (
@@ -27,6 +29,23 @@ const (
 	DefaultNodeCount = 1
 )
 
+// Kubeconfig credential types of an AKS cluster.
+const (
+	KubeconfigCredentialTypeAdmin      = "Admin"
+	KubeconfigCredentialTypeUser       = "User"
+	KubeconfigCredentialTypeMonitoring = "Monitoring"
+)
+
+// Power states of an AKS cluster.
+const (
+	PowerStateRunning = "Running"
+	PowerStateStopped = "Stopped"
+)
+
+// AutoUpgradeChannelNone disables automatic upgrades of an AKS cluster's
+// Kubernetes version.
+const AutoUpgradeChannelNone = "none"
+
 // AKSClusterParameters define the desired state of an Azure Kubernetes Engine
 // cluster.
 type AKSClusterParameters struct {
@@ -45,7 +64,9 @@ type AKSClusterParameters struct {
 	// Location is the Azure location that the cluster will be created in
 	Location string `json:"location"`
 
-	// Version is the Kubernetes version that will be deployed to the cluster
+	// Version is the Kubernetes version that will be deployed to the cluster.
+	// Changing it upgrades the cluster during its maintenance window, unless
+	// AutoUpgradeChannel is set.
 	Version string `json:"version"`
 
 	// VnetSubnetID is the subnet to which the cluster will be deployed.
@@ -60,12 +81,40 @@ type AKSClusterParameters struct {
 	VnetSubnetIDSelector *xpv1.Selector `json:"vnetSubnetIDSelector,omitempty"`
 
 	// NodeCount is the number of nodes that the cluster will initially be
-	// created with.  This can be scaled over time and defaults to 1.
+	// created with.  This can be scaled over time and defaults to 1. It is
+	// ignored once the cluster exists if EnableAutoScaling is true.
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	NodeCount *int `json:"nodeCount,omitempty"`
 
+	// EnableAutoScaling determines whether the cluster autoscaler will scale
+	// the number of nodes in the default node pool between MinNodeCount and
+	// MaxNodeCount.
+	// +optional
+	EnableAutoScaling bool `json:"enableAutoScaling,omitempty"`
+
+	// MinNodeCount is the minimum number of nodes the cluster autoscaler will
+	// scale the default node pool down to. It is required if
+	// EnableAutoScaling is true.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinNodeCount *int `json:"minNodeCount,omitempty"`
+
+	// MaxNodeCount is the maximum number of nodes the cluster autoscaler will
+	// scale the default node pool up to. It is required if
+	// EnableAutoScaling is true.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxNodeCount *int `json:"maxNodeCount,omitempty"`
+
+	// AutoScalerProfile configures the cluster autoscaler. It is only used
+	// if EnableAutoScaling is true.
+	// +optional
+	AutoScalerProfile *AutoScalerProfile `json:"autoScalerProfile,omitempty"`
+
 	// NodeVMSize is the name of the worker node VM size, e.g., Standard_B2s,
 	// Standard_F2s_v2, etc.
 	// +optional
@@ -81,6 +130,204 @@ type AKSClusterParameters struct {
 	// cluster.
 	// +optional
 	DisableRBAC bool `json:"disableRBAC,omitempty"`
+
+	// EnablePrivateCluster determines whether the Kubernetes API server will
+	// only be reachable via a private endpoint within the cluster's virtual
+	// network.
+	// +immutable
+	// +optional
+	EnablePrivateCluster bool `json:"enablePrivateCluster,omitempty"`
+
+	// PrivateDNSZone determines how the private DNS zone of a private
+	// cluster is managed. It is System, None, or the resource ID of an
+	// existing private DNS zone. AKS defaults to System if it is omitted. It
+	// may only be set if EnablePrivateCluster is true.
+	// +immutable
+	// +optional
+	PrivateDNSZone *string `json:"privateDNSZone,omitempty"`
+
+	// APIServerAuthorizedIPRanges is the list of CIDR ranges that are allowed
+	// to reach the Kubernetes API server. All ranges are allowed if it is
+	// empty. It cannot be used together with EnablePrivateCluster.
+	// +optional
+	APIServerAuthorizedIPRanges []string `json:"apiServerAuthorizedIPRanges,omitempty"`
+
+	// PowerState is the desired power state of the cluster. A Stopped
+	// cluster keeps its configuration but does not run its control plane or
+	// nodes. The power state is not managed if it is omitted.
+	// +kubebuilder:validation:Enum=Running;Stopped
+	// +optional
+	PowerState string `json:"powerState,omitempty"`
+
+	// AADProfile enables AKS-managed Azure Active Directory integration for
+	// the cluster. Users of AAD integrated clusters authenticate to the
+	// Kubernetes API server with AAD.
+	// +optional
+	AADProfile *AADProfile `json:"aadProfile,omitempty"`
+
+	// KubeconfigCredentialType determines which credentials the kubeconfig
+	// published to the connection secret contains. Admin credentials grant
+	// cluster-admin and bypass AAD. User credentials are subject to
+	// Kubernetes RBAC and, for AAD integrated clusters, require an AAD login.
+	// Monitoring credentials are only allowed to read metrics. Defaults to
+	// Admin.
+	// +kubebuilder:validation:Enum=Admin;User;Monitoring
+	// +optional
+	KubeconfigCredentialType string `json:"kubeconfigCredentialType,omitempty"`
+
+	// KubeconfigExecPlugin replaces the credentials of the published
+	// kubeconfig with an exec credential plugin, for example kubelogin for
+	// AAD integrated clusters.
+	// +optional
+	KubeconfigExecPlugin *ExecPlugin `json:"kubeconfigExecPlugin,omitempty"`
+
+	// ServicePrincipalSecretRotationInterval is how often the secret of the
+	// cluster's service principal is rotated, e.g. 720h. The secret is never
+	// rotated if this is omitted.
+	// +optional
+	ServicePrincipalSecretRotationInterval *metav1.Duration `json:"servicePrincipalSecretRotationInterval,omitempty"`
+
+	// MaintenanceWindow restricts when the cluster may be upgraded, both by
+	// AKS and by changing its version. Upgrades may happen at any time if
+	// this is omitted.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// NodeMaxSurge is the maximum number or percentage of extra nodes the
+	// node pool may add while it is upgraded, e.g. 1 or 33%.
+	// +optional
+	NodeMaxSurge *string `json:"nodeMaxSurge,omitempty"`
+
+	// NodeOSUpgradeChannel determines how the OS images of the cluster's
+	// nodes are upgraded.
+	// +kubebuilder:validation:Enum=None;Unmanaged;SecurityPatch;NodeImage
+	// +optional
+	NodeOSUpgradeChannel *string `json:"nodeOSUpgradeChannel,omitempty"`
+
+	// AutoUpgradeChannel determines how AKS automatically upgrades the
+	// cluster's Kubernetes version. Version is only used to create the
+	// cluster if it is set to anything other than none.
+	// +kubebuilder:validation:Enum=none;patch;stable;rapid;node-image
+	// +optional
+	AutoUpgradeChannel *string `json:"autoUpgradeChannel,omitempty"`
+}
+
+// A MaintenanceWindow configures when an AKS cluster may be upgraded.
+type MaintenanceWindow struct {
+	// TimeInWeek are the days of the week, and the hours of those days, in
+	// which maintenance is allowed. Maintenance is allowed at any time that
+	// is not explicitly disallowed if this is omitted.
+	// +optional
+	TimeInWeek []TimeInWeek `json:"timeInWeek,omitempty"`
+
+	// NotAllowedTime are time spans in which maintenance is not allowed.
+	// +optional
+	NotAllowedTime []TimeSpan `json:"notAllowedTime,omitempty"`
+}
+
+// A TimeInWeek allows maintenance during some hours of a day of the week.
+type TimeInWeek struct {
+	// Day of the week.
+	// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+	Day string `json:"day"`
+
+	// HourSlots are the hours of the day, in UTC, in which maintenance is
+	// allowed. For example 1 allows maintenance from 01:00 to 02:00.
+	HourSlots []int32 `json:"hourSlots"`
+}
+
+// A TimeSpan is a span of time.
+type TimeSpan struct {
+	// Start of the time span.
+	Start metav1.Time `json:"start"`
+
+	// End of the time span.
+	End metav1.Time `json:"end"`
+}
+
+// An AADProfile configures AKS-managed Azure Active Directory integration.
+type AADProfile struct {
+	// AdminGroupObjectIDs are the object IDs of the AAD groups whose members
+	// will be cluster administrators.
+	// +optional
+	AdminGroupObjectIDs []string `json:"adminGroupObjectIDs,omitempty"`
+
+	// TenantID is the AAD tenant users authenticate against. Defaults to the
+	// tenant of the cluster's subscription.
+	// +optional
+	TenantID *string `json:"tenantID,omitempty"`
+}
+
+// An ExecPlugin configures an exec credential plugin that kubeconfig users
+// run to get credentials for the cluster.
+type ExecPlugin struct {
+	// APIVersion of the ExecCredential the plugin returns. Defaults to
+	// client.authentication.k8s.io/v1beta1.
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Command to run, e.g. kubelogin.
+	Command string `json:"command"`
+
+	// Args to pass to the command, e.g. get-token --login azurecli
+	// --server-id 6dae42f8-4368-4678-94ff-3960e28e3630.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env are environment variables to set when running the command.
+	// +optional
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// An AutoScalerProfile configures the cluster autoscaler of an AKS cluster.
+// Durations are expressed as a number followed by a unit, e.g. 10s or 10m.
+// Fields that are omitted use the AKS defaults.
+type AutoScalerProfile struct {
+	// BalanceSimilarNodeGroups determines whether the autoscaler will balance
+	// the size of similar node groups, either "true" or "false".
+	// +optional
+	BalanceSimilarNodeGroups *string `json:"balanceSimilarNodeGroups,omitempty"`
+
+	// ScanInterval is how often the cluster is reevaluated for scale up or
+	// down.
+	// +optional
+	ScanInterval *string `json:"scanInterval,omitempty"`
+
+	// ScaleDownDelayAfterAdd is how long after a scale up that scale down
+	// evaluation resumes.
+	// +optional
+	ScaleDownDelayAfterAdd *string `json:"scaleDownDelayAfterAdd,omitempty"`
+
+	// ScaleDownDelayAfterDelete is how long after a node deletion that scale
+	// down evaluation resumes.
+	// +optional
+	ScaleDownDelayAfterDelete *string `json:"scaleDownDelayAfterDelete,omitempty"`
+
+	// ScaleDownDelayAfterFailure is how long after a scale down failure that
+	// scale down evaluation resumes.
+	// +optional
+	ScaleDownDelayAfterFailure *string `json:"scaleDownDelayAfterFailure,omitempty"`
+
+	// ScaleDownUnneededTime is how long a node should be unneeded before it
+	// is eligible for scale down.
+	// +optional
+	ScaleDownUnneededTime *string `json:"scaleDownUnneededTime,omitempty"`
+
+	// ScaleDownUnreadyTime is how long an unready node should be unneeded
+	// before it is eligible for scale down.
+	// +optional
+	ScaleDownUnreadyTime *string `json:"scaleDownUnreadyTime,omitempty"`
+
+	// ScaleDownUtilizationThreshold is the node utilization level, defined as
+	// the sum of requested resources divided by capacity, below which a node
+	// can be considered for scale down, e.g. 0.5.
+	// +optional
+	ScaleDownUtilizationThreshold *string `json:"scaleDownUtilizationThreshold,omitempty"`
+
+	// MaxGracefulTerminationSec is the maximum number of seconds the
+	// autoscaler waits for pod termination when trying to scale down a node.
+	// +optional
+	MaxGracefulTerminationSec *string `json:"maxGracefulTerminationSec,omitempty"`
 }
 
 // An AKSClusterSpec defines the desired state of a AKSCluster.
@@ -100,8 +347,96 @@ type AKSClusterStatus struct {
 	// provider.
 	ProviderID string `json:"providerID,omitempty"`
 
-	// Endpoint is the endpoint where the cluster can be reached
+	// Endpoint is the endpoint where the cluster can be reached. It is the
+	// private FQDN of the API server if the cluster is private.
 	Endpoint string `json:"endpoint,omitempty"`
+
+	// KubernetesVersion is the Kubernetes version currently running on the
+	// cluster.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// NodeResourceGroup is the name of the resource group that contains the
+	// cluster's nodes.
+	NodeResourceGroup string `json:"nodeResourceGroup,omitempty"`
+
+	// FQDN is the public FQDN of the cluster's API server.
+	FQDN string `json:"fqdn,omitempty"`
+
+	// PrivateFQDN is the FQDN of the cluster's API server within its virtual
+	// network, if the cluster is private.
+	PrivateFQDN string `json:"privateFQDN,omitempty"`
+
+	// AgentPoolProfiles is the observed state of the cluster's node pools.
+	AgentPoolProfiles []AgentPoolProfileObservation `json:"agentPoolProfiles,omitempty"`
+
+	// PowerState is the current power state of the cluster, either Running
+	// or Stopped.
+	PowerState string `json:"powerState,omitempty"`
+
+	// OIDCIssuerURL is the URL of the cluster's OIDC issuer, if it is
+	// enabled.
+	OIDCIssuerURL string `json:"oidcIssuerURL,omitempty"`
+
+	// LastOperation represents the state of the last start or stop
+	// operation started by the controller.
+	LastOperation v1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+
+	// ApplicationObjectID is the object ID of the AAD application created
+	// for the cluster's service principal.
+	ApplicationObjectID string `json:"applicationObjectID,omitempty"`
+
+	// ServicePrincipalObjectID is the object ID of the cluster's service
+	// principal.
+	ServicePrincipalObjectID string `json:"servicePrincipalObjectID,omitempty"`
+
+	// RoleAssignmentID is the ID of the role assignment that allows the
+	// cluster's service principal to manage its subnet, if any.
+	RoleAssignmentID string `json:"roleAssignmentID,omitempty"`
+
+	// ServicePrincipalSecret is the observed state of the secret of the
+	// cluster's service principal.
+	ServicePrincipalSecret ServicePrincipalSecretObservation `json:"servicePrincipalSecret,omitempty"`
+}
+
+// A ServicePrincipalSecretObservation represents the observed state of the
+// secret of an AKSCluster's service principal.
+type ServicePrincipalSecretObservation struct {
+	// KeyID of the password credential the cluster uses.
+	KeyID string `json:"keyID,omitempty"`
+
+	// LastRotationTime is when the secret was last rotated, or created.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// NextRotationTime is when the secret will next be rotated.
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+
+	// StaleKeyIDs are the key IDs of password credentials that will be
+	// removed once the cluster has switched to the current secret.
+	StaleKeyIDs []string `json:"staleKeyIDs,omitempty"`
+
+	// LastRotation represents the state of the last operation that pushed a
+	// new secret to the cluster.
+	LastRotation v1alpha3.AsyncOperation `json:"lastRotation,omitempty"`
+}
+
+// An AgentPoolProfileObservation represents the observed state of a node pool
+// of an AKSCluster.
+type AgentPoolProfileObservation struct {
+	// Name of the node pool.
+	Name string `json:"name"`
+
+	// Count is the current number of nodes in the node pool.
+	Count int `json:"count,omitempty"`
+
+	// VMSize is the VM size of the nodes in the node pool.
+	VMSize string `json:"vmSize,omitempty"`
+
+	// OrchestratorVersion is the Kubernetes version running on the nodes in
+	// the node pool.
+	OrchestratorVersion string `json:"orchestratorVersion,omitempty"`
+
+	// ProvisioningState is the current provisioning state of the node pool.
+	ProvisioningState string `json:"provisioningState,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -111,6 +446,7 @@ type AKSClusterStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.endpoint"
+// +kubebuilder:printcolumn:name="POWER",type="string",JSONPath=".status.powerState"
 // +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.location"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
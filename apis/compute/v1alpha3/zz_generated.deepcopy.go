@@ -22,9 +22,35 @@ package v1alpha3
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AADProfile) DeepCopyInto(out *AADProfile) {
+	*out = *in
+	if in.AdminGroupObjectIDs != nil {
+		in, out := &in.AdminGroupObjectIDs, &out.AdminGroupObjectIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TenantID != nil {
+		in, out := &in.TenantID, &out.TenantID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AADProfile.
+func (in *AADProfile) DeepCopy() *AADProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AADProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AKSCluster) DeepCopyInto(out *AKSCluster) {
 	*out = *in
@@ -112,6 +138,66 @@ func (in *AKSClusterParameters) DeepCopyInto(out *AKSClusterParameters) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.MinNodeCount != nil {
+		in, out := &in.MinNodeCount, &out.MinNodeCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxNodeCount != nil {
+		in, out := &in.MaxNodeCount, &out.MaxNodeCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.AutoScalerProfile != nil {
+		in, out := &in.AutoScalerProfile, &out.AutoScalerProfile
+		*out = new(AutoScalerProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateDNSZone != nil {
+		in, out := &in.PrivateDNSZone, &out.PrivateDNSZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerAuthorizedIPRanges != nil {
+		in, out := &in.APIServerAuthorizedIPRanges, &out.APIServerAuthorizedIPRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AADProfile != nil {
+		in, out := &in.AADProfile, &out.AADProfile
+		*out = new(AADProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeconfigExecPlugin != nil {
+		in, out := &in.KubeconfigExecPlugin, &out.KubeconfigExecPlugin
+		*out = new(ExecPlugin)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServicePrincipalSecretRotationInterval != nil {
+		in, out := &in.ServicePrincipalSecretRotationInterval, &out.ServicePrincipalSecretRotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeMaxSurge != nil {
+		in, out := &in.NodeMaxSurge, &out.NodeMaxSurge
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeOSUpgradeChannel != nil {
+		in, out := &in.NodeOSUpgradeChannel, &out.NodeOSUpgradeChannel
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoUpgradeChannel != nil {
+		in, out := &in.AutoUpgradeChannel, &out.AutoUpgradeChannel
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterParameters.
@@ -145,6 +231,13 @@ func (in *AKSClusterSpec) DeepCopy() *AKSClusterSpec {
 func (in *AKSClusterStatus) DeepCopyInto(out *AKSClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	if in.AgentPoolProfiles != nil {
+		in, out := &in.AgentPoolProfiles, &out.AgentPoolProfiles
+		*out = make([]AgentPoolProfileObservation, len(*in))
+		copy(*out, *in)
+	}
+	out.LastOperation = in.LastOperation
+	in.ServicePrincipalSecret.DeepCopyInto(&out.ServicePrincipalSecret)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterStatus.
@@ -156,3 +249,200 @@ func (in *AKSClusterStatus) DeepCopy() *AKSClusterStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolProfileObservation) DeepCopyInto(out *AgentPoolProfileObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolProfileObservation.
+func (in *AgentPoolProfileObservation) DeepCopy() *AgentPoolProfileObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolProfileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalerProfile) DeepCopyInto(out *AutoScalerProfile) {
+	*out = *in
+	if in.BalanceSimilarNodeGroups != nil {
+		in, out := &in.BalanceSimilarNodeGroups, &out.BalanceSimilarNodeGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScanInterval != nil {
+		in, out := &in.ScanInterval, &out.ScanInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownDelayAfterAdd != nil {
+		in, out := &in.ScaleDownDelayAfterAdd, &out.ScaleDownDelayAfterAdd
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownDelayAfterDelete != nil {
+		in, out := &in.ScaleDownDelayAfterDelete, &out.ScaleDownDelayAfterDelete
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownDelayAfterFailure != nil {
+		in, out := &in.ScaleDownDelayAfterFailure, &out.ScaleDownDelayAfterFailure
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownUnneededTime != nil {
+		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownUnreadyTime != nil {
+		in, out := &in.ScaleDownUnreadyTime, &out.ScaleDownUnreadyTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownUtilizationThreshold != nil {
+		in, out := &in.ScaleDownUtilizationThreshold, &out.ScaleDownUtilizationThreshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxGracefulTerminationSec != nil {
+		in, out := &in.MaxGracefulTerminationSec, &out.MaxGracefulTerminationSec
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalerProfile.
+func (in *AutoScalerProfile) DeepCopy() *AutoScalerProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalerProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecPlugin) DeepCopyInto(out *ExecPlugin) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecPlugin.
+func (in *ExecPlugin) DeepCopy() *ExecPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.TimeInWeek != nil {
+		in, out := &in.TimeInWeek, &out.TimeInWeek
+		*out = make([]TimeInWeek, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NotAllowedTime != nil {
+		in, out := &in.NotAllowedTime, &out.NotAllowedTime
+		*out = make([]TimeSpan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePrincipalSecretObservation) DeepCopyInto(out *ServicePrincipalSecretObservation) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleKeyIDs != nil {
+		in, out := &in.StaleKeyIDs, &out.StaleKeyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.LastRotation = in.LastRotation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePrincipalSecretObservation.
+func (in *ServicePrincipalSecretObservation) DeepCopy() *ServicePrincipalSecretObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePrincipalSecretObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeInWeek) DeepCopyInto(out *TimeInWeek) {
+	*out = *in
+	if in.HourSlots != nil {
+		in, out := &in.HourSlots, &out.HourSlots
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeInWeek.
+func (in *TimeInWeek) DeepCopy() *TimeInWeek {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeInWeek)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSpan) DeepCopyInto(out *TimeSpan) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSpan.
+func (in *TimeSpan) DeepCopy() *TimeSpan {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSpan)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+)
+
+// A ConfigurationObservation represents the observed state of an Azure SQL
+// server configuration.
+type ConfigurationObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// Value of the configuration.
+	Value string `json:"value,omitempty"`
+
+	// DefaultValue of the configuration.
+	DefaultValue string `json:"defaultValue,omitempty"`
+
+	// DataType of the configuration.
+	DataType string `json:"dataType,omitempty"`
+
+	// AllowedValues of the configuration.
+	AllowedValues string `json:"allowedValues,omitempty"`
+
+	// Source of the configuration's value; either system-default or
+	// user-override.
+	Source string `json:"source,omitempty"`
+
+	// Description of the configuration.
+	Description string `json:"description,omitempty"`
+
+	// LastOperation represents the state of the last operation started by the
+	// controller.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// A ConfigurationStatus represents the status of an Azure SQL server
+// configuration.
+type ConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// ConfigurationParameters define the desired state of an Azure SQL server
+// configuration, also known as a server parameter. The configuration is reset
+// to its default value when the managed resource is deleted.
+type ConfigurationParameters struct {
+	// ServerName - Name of the Configuration's server.
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the Configuration's server.
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects a server to reference.
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the Configuration's resource group.
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Name of the configuration, e.g. max_connections.
+	// +immutable
+	Name string `json:"name"`
+
+	// Value of the configuration.
+	Value string `json:"value"`
+}
+
+// A ConfigurationSpec defines the desired state of an Azure SQL server
+// configuration.
+type ConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigurationParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MySQLServerConfiguration is a managed resource that represents an Azure
+// MySQL server configuration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MySQLServerConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurationSpec   `json:"spec"`
+	Status ConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLServerConfigurationList contains a list of MySQLServerConfiguration.
+type MySQLServerConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLServerConfiguration `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostgreSQLServerConfiguration is a managed resource that represents an
+// Azure PostgreSQL server configuration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PostgreSQLServerConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurationSpec   `json:"spec"`
+	Status ConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLServerConfigurationList contains a list of
+// PostgreSQLServerConfiguration.
+type PostgreSQLServerConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLServerConfiguration `json:"items"`
+}
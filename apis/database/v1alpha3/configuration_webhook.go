@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-mysqlserverconfiguration,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mysqlserverconfigurations,versions=v1alpha3,name=mysqlserverconfigurations.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-postgresqlserverconfiguration,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=postgresqlserverconfigurations,versions=v1alpha3,name=postgresqlserverconfigurations.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-postgresqlflexibleserverconfiguration,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=postgresqlflexibleserverconfigurations,versions=v1alpha3,name=postgresqlflexibleserverconfigurations.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// validateConfigurationUpdate returns an error for each immutable parameter
+// of a server configuration that was changed.
+func validateConfigurationUpdate(p, old ConfigurationParameters) field.ErrorList {
+	path := field.NewPath("spec", "forProvider")
+	return validation.Immutable(p.Name, old.Name, path.Child("name"))
+}
+
+// ValidateCreate validates a new MySQLServerConfiguration.
+func (c *MySQLServerConfiguration) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a MySQLServerConfiguration.
+func (c *MySQLServerConfiguration) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*MySQLServerConfiguration)
+	if !ok || validation.Deleting(c) {
+		return nil
+	}
+	return validation.Invalid(MySQLServerConfigurationGroupVersionKind.GroupKind(), c.GetName(), validateConfigurationUpdate(c.Spec.ForProvider, o.Spec.ForProvider))
+}
+
+// ValidateDelete validates the deletion of a MySQLServerConfiguration.
+func (c *MySQLServerConfiguration) ValidateDelete() error {
+	return nil
+}
+
+// ValidateCreate validates a new PostgreSQLServerConfiguration.
+func (c *PostgreSQLServerConfiguration) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a PostgreSQLServerConfiguration.
+func (c *PostgreSQLServerConfiguration) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*PostgreSQLServerConfiguration)
+	if !ok || validation.Deleting(c) {
+		return nil
+	}
+	return validation.Invalid(PostgreSQLServerConfigurationGroupVersionKind.GroupKind(), c.GetName(), validateConfigurationUpdate(c.Spec.ForProvider, o.Spec.ForProvider))
+}
+
+// ValidateDelete validates the deletion of a PostgreSQLServerConfiguration.
+func (c *PostgreSQLServerConfiguration) ValidateDelete() error {
+	return nil
+}
+
+// ValidateCreate validates a new PostgreSQLFlexibleServerConfiguration.
+func (c *PostgreSQLFlexibleServerConfiguration) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a
+// PostgreSQLFlexibleServerConfiguration.
+func (c *PostgreSQLFlexibleServerConfiguration) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*PostgreSQLFlexibleServerConfiguration)
+	if !ok || validation.Deleting(c) {
+		return nil
+	}
+	return validation.Invalid(PostgreSQLFlexibleServerConfigurationGroupVersionKind.GroupKind(), c.GetName(), validateConfigurationUpdate(c.Spec.ForProvider, o.Spec.ForProvider))
+}
+
+// ValidateDelete validates the deletion of a
+// PostgreSQLFlexibleServerConfiguration.
+func (c *PostgreSQLFlexibleServerConfiguration) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateConfigurationUpdate(t *testing.T) {
+	path := field.NewPath("spec", "forProvider")
+
+	cases := map[string]struct {
+		p, old ConfigurationParameters
+		want   []string
+	}{
+		"ValueChanged": {
+			p:    ConfigurationParameters{Name: "max_connections", Value: "200"},
+			old:  ConfigurationParameters{Name: "max_connections", Value: "100"},
+			want: []string{},
+		},
+		"NameChanged": {
+			p:    ConfigurationParameters{Name: "work_mem", Value: "100"},
+			old:  ConfigurationParameters{Name: "max_connections", Value: "100"},
+			want: []string{path.Child("name").String()},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			for _, err := range validateConfigurationUpdate(tc.p, tc.old) {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("validateConfigurationUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
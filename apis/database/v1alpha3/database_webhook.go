@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-mysqldatabase,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mysqldatabases,versions=v1alpha3,name=mysqldatabases.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-postgresqldatabase,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=postgresqldatabases,versions=v1alpha3,name=postgresqldatabases.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// validateDatabaseUpdate returns an error for each immutable parameter of a
+// database that was changed.
+func validateDatabaseUpdate(p, old DatabaseParameters) field.ErrorList {
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.Charset, old.Charset, path.Child("charset"))...)
+	errs = append(errs, validation.Immutable(p.Collation, old.Collation, path.Child("collation"))...)
+	return errs
+}
+
+// ValidateCreate validates a new MySQLDatabase.
+func (d *MySQLDatabase) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a MySQLDatabase.
+func (d *MySQLDatabase) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*MySQLDatabase)
+	if !ok || validation.Deleting(d) {
+		return nil
+	}
+	return validation.Invalid(MySQLDatabaseGroupVersionKind.GroupKind(), d.GetName(), validateDatabaseUpdate(d.Spec.ForProvider, o.Spec.ForProvider))
+}
+
+// ValidateDelete validates the deletion of a MySQLDatabase.
+func (d *MySQLDatabase) ValidateDelete() error {
+	return nil
+}
+
+// ValidateCreate validates a new PostgreSQLDatabase.
+func (d *PostgreSQLDatabase) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a PostgreSQLDatabase.
+func (d *PostgreSQLDatabase) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*PostgreSQLDatabase)
+	if !ok || validation.Deleting(d) {
+		return nil
+	}
+	return validation.Invalid(PostgreSQLDatabaseGroupVersionKind.GroupKind(), d.GetName(), validateDatabaseUpdate(d.Spec.ForProvider, o.Spec.ForProvider))
+}
+
+// ValidateDelete validates the deletion of a PostgreSQLDatabase.
+func (d *PostgreSQLDatabase) ValidateDelete() error {
+	return nil
+}
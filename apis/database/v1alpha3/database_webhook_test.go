@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateDatabaseUpdate(t *testing.T) {
+	utf8, latin1 := "UTF8", "LATIN1"
+	collation := "English_United States.1252"
+	path := field.NewPath("spec", "forProvider")
+
+	cases := map[string]struct {
+		p, old DatabaseParameters
+		want   []string
+	}{
+		"Unchanged": {
+			p:    DatabaseParameters{Charset: &utf8, Collation: &collation},
+			old:  DatabaseParameters{Charset: &utf8, Collation: &collation},
+			want: []string{},
+		},
+		"PreviouslyUnset": {
+			p:    DatabaseParameters{Charset: &utf8, Collation: &collation},
+			old:  DatabaseParameters{},
+			want: []string{},
+		},
+		"CharsetChanged": {
+			p:    DatabaseParameters{Charset: &latin1},
+			old:  DatabaseParameters{Charset: &utf8},
+			want: []string{path.Child("charset").String()},
+		},
+		"CollationRemoved": {
+			p:    DatabaseParameters{},
+			old:  DatabaseParameters{Collation: &collation},
+			want: []string{path.Child("collation").String()},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			for _, err := range validateDatabaseUpdate(tc.p, tc.old) {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("validateDatabaseUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostgreSQLDatabaseValidateUpdate(t *testing.T) {
+	utf8, latin1 := "UTF8", "LATIN1"
+	now := metav1.Now()
+	database := func(charset *string, deleting bool) *PostgreSQLDatabase {
+		d := &PostgreSQLDatabase{Spec: DatabaseSpec{ForProvider: DatabaseParameters{Charset: charset}}}
+		if deleting {
+			d.SetDeletionTimestamp(&now)
+		}
+		return d
+	}
+
+	cases := map[string]struct {
+		d, old  *PostgreSQLDatabase
+		wantErr bool
+	}{
+		"CharsetChanged": {
+			d:       database(&latin1, false),
+			old:     database(&utf8, false),
+			wantErr: true,
+		},
+		"CharsetChangedButDeleting": {
+			d:       database(&latin1, true),
+			old:     database(&utf8, false),
+			wantErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.d.ValidateUpdate(tc.old)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,517 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+)
+
+// Create modes of a flexible server.
+const (
+	FlexibleServerCreateModeDefault            = "Default"
+	FlexibleServerCreateModePointInTimeRestore = "PointInTimeRestore"
+	FlexibleServerCreateModeReplica            = "Replica"
+)
+
+// Possible states of a flexible server.
+const (
+	FlexibleServerStateReady    = "Ready"
+	FlexibleServerStateDropping = "Dropping"
+)
+
+// A FlexibleServerSKU is the compute SKU of a flexible server.
+type FlexibleServerSKU struct {
+	// Name of the SKU, e.g. Standard_D2s_v3.
+	Name string `json:"name"`
+
+	// Tier of the SKU.
+	// +kubebuilder:validation:Enum=Burstable;GeneralPurpose;MemoryOptimized
+	Tier string `json:"tier"`
+}
+
+// FlexibleServerHighAvailability configures a standby server that a flexible
+// server fails over to.
+type FlexibleServerHighAvailability struct {
+	// Mode of high availability.
+	// +kubebuilder:validation:Enum=Disabled;ZoneRedundant;SameZone
+	Mode string `json:"mode"`
+
+	// StandbyAvailabilityZone - The availability zone of the standby server.
+	// +optional
+	StandbyAvailabilityZone *string `json:"standbyAvailabilityZone,omitempty"`
+}
+
+// A FlexibleServerMaintenanceWindow is the weekly window in which Azure may
+// maintain a flexible server. Azure picks the window if none is specified.
+type FlexibleServerMaintenanceWindow struct {
+	// DayOfWeek of the window, where 0 is Sunday.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=6
+	DayOfWeek int `json:"dayOfWeek"`
+
+	// StartHour of the window, in UTC.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	StartHour int `json:"startHour"`
+
+	// StartMinute of the window.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	StartMinute int `json:"startMinute"`
+}
+
+// A FlexibleServerObservation represents the observed state of an Azure
+// flexible server.
+type FlexibleServerObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// State of the server.
+	State string `json:"state,omitempty"`
+
+	// FullyQualifiedDomainName - The fully qualified domain name of the
+	// server.
+	FullyQualifiedDomainName string `json:"fullyQualifiedDomainName,omitempty"`
+
+	// AvailabilityZone the server is running in.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// HighAvailabilityState - The state of the server's standby.
+	HighAvailabilityState string `json:"highAvailabilityState,omitempty"`
+
+	// ReplicationRole of the server; None, Source or Replica.
+	ReplicationRole string `json:"replicationRole,omitempty"`
+
+	// StandbyAvailabilityZone the server's standby is running in.
+	StandbyAvailabilityZone string `json:"standbyAvailabilityZone,omitempty"`
+
+	// PublicNetworkAccess - Whether the server can be reached from public
+	// networks. Servers with a delegated subnet cannot.
+	PublicNetworkAccess string `json:"publicNetworkAccess,omitempty"`
+
+	// LastOperation represents the state of the last operation started by the
+	// controller.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+
+	// AdministratorLoginPasswordHash is a hash of the referenced administrator
+	// login password that was last sent to Azure.
+	AdministratorLoginPasswordHash string `json:"administratorLoginPasswordHash,omitempty"`
+}
+
+// A FlexibleServerStatus represents the status of an Azure flexible server.
+type FlexibleServerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FlexibleServerObservation `json:"atProvider,omitempty"`
+}
+
+// PostgreSQLFlexibleServerParameters define the desired state of an Azure
+// Database for PostgreSQL flexible server.
+type PostgreSQLFlexibleServerParameters struct {
+	// ResourceGroupName - Name of the server's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location the server resides in.
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU of the server.
+	SKU FlexibleServerSKU `json:"sku"`
+
+	// AdministratorLogin - The administrator's login name of a server. Can
+	// only be specified when the server is being created, and is required
+	// unless the server is restored from another.
+	// +optional
+	// +immutable
+	AdministratorLogin string `json:"administratorLogin,omitempty"`
+
+	// AdministratorLoginPasswordSecretRef - A reference to a Secret key that
+	// holds the administrator's login password. A password is generated if
+	// none is referenced. Changes to the password are sent to Azure.
+	// +optional
+	AdministratorLoginPasswordSecretRef *xpv1.SecretKeySelector `json:"administratorLoginPasswordSecretRef,omitempty"`
+
+	// Version - Server version.
+	// +kubebuilder:validation:Enum="11";"12";"13"
+	// +optional
+	// +immutable
+	Version *string `json:"version,omitempty"`
+
+	// AvailabilityZone - The availability zone the server is created in.
+	// +optional
+	// +immutable
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// StorageSizeGB - Max storage allowed for the server. Storage can only be
+	// increased.
+	// +kubebuilder:validation:Enum=32;64;128;256;512;1024;2048;4096;8192;16384
+	// +optional
+	StorageSizeGB *int `json:"storageSizeGB,omitempty"`
+
+	// BackupRetentionDays - Backup retention days for the server.
+	// +kubebuilder:validation:Minimum=7
+	// +kubebuilder:validation:Maximum=35
+	// +optional
+	BackupRetentionDays *int `json:"backupRetentionDays,omitempty"`
+
+	// GeoRedundantBackup - Enable Geo-redundant or not for server backup.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	// +immutable
+	GeoRedundantBackup *string `json:"geoRedundantBackup,omitempty"`
+
+	// HighAvailability of the server. Disabled if omitted.
+	// +optional
+	HighAvailability *FlexibleServerHighAvailability `json:"highAvailability,omitempty"`
+
+	// DelegatedSubnetID - The ARM resource ID of a subnet delegated to
+	// Microsoft.DBforPostgreSQL/flexibleServers that the server is injected
+	// into. The server is reachable from public networks if omitted.
+	// +optional
+	// +immutable
+	DelegatedSubnetID *string `json:"delegatedSubnetId,omitempty"`
+
+	// DelegatedSubnetIDRef - A reference to a Subnet to retrieve its ID.
+	// +optional
+	// +immutable
+	DelegatedSubnetIDRef *xpv1.Reference `json:"delegatedSubnetIdRef,omitempty"`
+
+	// DelegatedSubnetIDSelector - Selects a Subnet to retrieve its ID.
+	// +optional
+	// +immutable
+	DelegatedSubnetIDSelector *xpv1.Selector `json:"delegatedSubnetIdSelector,omitempty"`
+
+	// PrivateDNSZoneID - The ARM resource ID of the private DNS zone the
+	// server's name is registered in. Only used with a delegated subnet.
+	// +optional
+	// +immutable
+	PrivateDNSZoneID *string `json:"privateDnsZoneId,omitempty"`
+
+	// MaintenanceWindow of the server.
+	// +optional
+	MaintenanceWindow *FlexibleServerMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// CreateMode - The mode to create a new server.
+	// +kubebuilder:validation:Enum=Default;PointInTimeRestore
+	// +optional
+	// +immutable
+	CreateMode *string `json:"createMode,omitempty"`
+
+	// SourceServerID - The ARM resource ID of the server to restore. Required
+	// when CreateMode is PointInTimeRestore.
+	// +optional
+	// +immutable
+	SourceServerID *string `json:"sourceServerId,omitempty"`
+
+	// PointInTimeUTC - The point in time to restore the source server to.
+	// Required when CreateMode is PointInTimeRestore.
+	// +optional
+	// +immutable
+	PointInTimeUTC *metav1.Time `json:"pointInTimeUTC,omitempty"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A PostgreSQLFlexibleServerSpec defines the desired state of a
+// PostgreSQLFlexibleServer.
+type PostgreSQLFlexibleServerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PostgreSQLFlexibleServerParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostgreSQLFlexibleServer is a managed resource that represents an Azure
+// Database for PostgreSQL flexible server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PostgreSQLFlexibleServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgreSQLFlexibleServerSpec `json:"spec"`
+	Status FlexibleServerStatus         `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLFlexibleServerList contains a list of PostgreSQLFlexibleServer.
+type PostgreSQLFlexibleServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLFlexibleServer `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostgreSQLFlexibleServerFirewallRule is a managed resource that represents
+// an Azure Database for PostgreSQL flexible server firewall rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PostgreSQLFlexibleServerFirewallRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirewallRuleSpec   `json:"spec"`
+	Status FirewallRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLFlexibleServerFirewallRuleList contains a list of
+// PostgreSQLFlexibleServerFirewallRule.
+type PostgreSQLFlexibleServerFirewallRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLFlexibleServerFirewallRule `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostgreSQLFlexibleServerConfiguration is a managed resource that
+// represents an Azure Database for PostgreSQL flexible server configuration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PostgreSQLFlexibleServerConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurationSpec   `json:"spec"`
+	Status ConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLFlexibleServerConfigurationList contains a list of
+// PostgreSQLFlexibleServerConfiguration.
+type PostgreSQLFlexibleServerConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLFlexibleServerConfiguration `json:"items"`
+}
+
+// MySQLFlexibleServerParameters define the desired state of an Azure Database
+// for MySQL flexible server.
+type MySQLFlexibleServerParameters struct {
+	// ResourceGroupName - Name of the server's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location the server resides in.
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU of the server.
+	SKU FlexibleServerSKU `json:"sku"`
+
+	// AdministratorLogin - The administrator's login name of a server. Can
+	// only be specified when the server is being created, and is required
+	// unless the server is a replica or restored from another.
+	// +optional
+	// +immutable
+	AdministratorLogin string `json:"administratorLogin,omitempty"`
+
+	// AdministratorLoginPasswordSecretRef - A reference to a Secret key that
+	// holds the administrator's login password. A password is generated if
+	// none is referenced. Changes to the password are sent to Azure.
+	// +optional
+	AdministratorLoginPasswordSecretRef *xpv1.SecretKeySelector `json:"administratorLoginPasswordSecretRef,omitempty"`
+
+	// Version - Server version.
+	// +kubebuilder:validation:Enum="5.7";"8.0.21"
+	// +optional
+	// +immutable
+	Version *string `json:"version,omitempty"`
+
+	// AvailabilityZone - The availability zone the server is created in.
+	// +optional
+	// +immutable
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// StorageSizeGB - Max storage allowed for the server. Storage can only be
+	// increased.
+	// +kubebuilder:validation:Minimum=20
+	// +kubebuilder:validation:Maximum=16384
+	// +optional
+	StorageSizeGB *int `json:"storageSizeGB,omitempty"`
+
+	// StorageIOPS - The storage IOPS provisioned for the server, in addition
+	// to those included with its storage size.
+	// +kubebuilder:validation:Minimum=360
+	// +kubebuilder:validation:Maximum=20000
+	// +optional
+	StorageIOPS *int `json:"storageIops,omitempty"`
+
+	// StorageAutoGrow - Whether storage grows automatically when the server
+	// runs low on it.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	StorageAutoGrow *string `json:"storageAutoGrow,omitempty"`
+
+	// BackupRetentionDays - Backup retention days for the server.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=35
+	// +optional
+	BackupRetentionDays *int `json:"backupRetentionDays,omitempty"`
+
+	// GeoRedundantBackup - Enable Geo-redundant or not for server backup.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	// +immutable
+	GeoRedundantBackup *string `json:"geoRedundantBackup,omitempty"`
+
+	// HighAvailability of the server. Disabled if omitted.
+	// +optional
+	HighAvailability *FlexibleServerHighAvailability `json:"highAvailability,omitempty"`
+
+	// DelegatedSubnetID - The ARM resource ID of a subnet delegated to
+	// Microsoft.DBforMySQL/flexibleServers that the server is integrated
+	// with. The server is reachable from public networks if omitted.
+	// +optional
+	// +immutable
+	DelegatedSubnetID *string `json:"delegatedSubnetId,omitempty"`
+
+	// DelegatedSubnetIDRef - A reference to a Subnet to retrieve its ID.
+	// +optional
+	// +immutable
+	DelegatedSubnetIDRef *xpv1.Reference `json:"delegatedSubnetIdRef,omitempty"`
+
+	// DelegatedSubnetIDSelector - Selects a Subnet to retrieve its ID.
+	// +optional
+	// +immutable
+	DelegatedSubnetIDSelector *xpv1.Selector `json:"delegatedSubnetIdSelector,omitempty"`
+
+	// PrivateDNSZoneID - The ARM resource ID of the private DNS zone the
+	// server's name is registered in. Only used with a delegated subnet.
+	// +optional
+	// +immutable
+	PrivateDNSZoneID *string `json:"privateDnsZoneId,omitempty"`
+
+	// MaintenanceWindow of the server.
+	// +optional
+	MaintenanceWindow *FlexibleServerMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// CreateMode - The mode to create a new server.
+	// +kubebuilder:validation:Enum=Default;PointInTimeRestore;Replica
+	// +optional
+	// +immutable
+	CreateMode *string `json:"createMode,omitempty"`
+
+	// SourceServerID - The ARM resource ID of the server to restore or
+	// replicate. Required when CreateMode is PointInTimeRestore or Replica.
+	// +optional
+	// +immutable
+	SourceServerID *string `json:"sourceServerId,omitempty"`
+
+	// SourceServerIDRef - A reference to a MySQLFlexibleServer to retrieve its
+	// ID.
+	// +optional
+	// +immutable
+	SourceServerIDRef *xpv1.Reference `json:"sourceServerIdRef,omitempty"`
+
+	// SourceServerIDSelector - Selects a MySQLFlexibleServer to retrieve its
+	// ID.
+	// +optional
+	// +immutable
+	SourceServerIDSelector *xpv1.Selector `json:"sourceServerIdSelector,omitempty"`
+
+	// RestorePointInTime - The point in time to restore the source server to.
+	// Required when CreateMode is PointInTimeRestore.
+	// +optional
+	// +immutable
+	RestorePointInTime *metav1.Time `json:"restorePointInTime,omitempty"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A MySQLFlexibleServerSpec defines the desired state of a
+// MySQLFlexibleServer.
+type MySQLFlexibleServerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MySQLFlexibleServerParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MySQLFlexibleServer is a managed resource that represents an Azure
+// Database for MySQL flexible server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.version"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".status.atProvider.replicationRole"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MySQLFlexibleServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MySQLFlexibleServerSpec `json:"spec"`
+	Status FlexibleServerStatus    `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLFlexibleServerList contains a list of MySQLFlexibleServer.
+type MySQLFlexibleServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLFlexibleServer `json:"items"`
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-postgresqlflexibleserver,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=postgresqlflexibleservers,versions=v1alpha3,name=postgresqlflexibleservers.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-mysqlflexibleserver,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mysqlflexibleservers,versions=v1alpha3,name=mysqlflexibleservers.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// ValidateCreate validates a new PostgreSQLFlexibleServer.
+func (s *PostgreSQLFlexibleServer) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a PostgreSQLFlexibleServer.
+func (s *PostgreSQLFlexibleServer) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*PostgreSQLFlexibleServer)
+	if !ok || validation.Deleting(s) {
+		return nil
+	}
+	p, op := s.Spec.ForProvider, o.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, op.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, op.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, op.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.Location, op.Location, path.Child("location"))...)
+	errs = append(errs, validation.Immutable(p.AdministratorLogin, op.AdministratorLogin, path.Child("administratorLogin"))...)
+	errs = append(errs, validation.Immutable(p.Version, op.Version, path.Child("version"))...)
+	errs = append(errs, validation.Immutable(p.AvailabilityZone, op.AvailabilityZone, path.Child("availabilityZone"))...)
+	errs = append(errs, validation.Immutable(p.GeoRedundantBackup, op.GeoRedundantBackup, path.Child("geoRedundantBackup"))...)
+	errs = append(errs, validation.Immutable(p.DelegatedSubnetID, op.DelegatedSubnetID, path.Child("delegatedSubnetId"))...)
+	errs = append(errs, validation.Immutable(p.DelegatedSubnetIDRef, op.DelegatedSubnetIDRef, path.Child("delegatedSubnetIdRef"))...)
+	errs = append(errs, validation.Immutable(p.DelegatedSubnetIDSelector, op.DelegatedSubnetIDSelector, path.Child("delegatedSubnetIdSelector"))...)
+	errs = append(errs, validation.Immutable(p.PrivateDNSZoneID, op.PrivateDNSZoneID, path.Child("privateDnsZoneId"))...)
+	errs = append(errs, validation.Immutable(p.CreateMode, op.CreateMode, path.Child("createMode"))...)
+	errs = append(errs, validation.Immutable(p.SourceServerID, op.SourceServerID, path.Child("sourceServerId"))...)
+	errs = append(errs, validation.Immutable(p.PointInTimeUTC, op.PointInTimeUTC, path.Child("pointInTimeUTC"))...)
+	return validation.Invalid(PostgreSQLFlexibleServerGroupVersionKind.GroupKind(), s.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of a PostgreSQLFlexibleServer.
+func (s *PostgreSQLFlexibleServer) ValidateDelete() error {
+	return nil
+}
+
+// ValidateCreate validates a new MySQLFlexibleServer.
+func (s *MySQLFlexibleServer) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a MySQLFlexibleServer.
+func (s *MySQLFlexibleServer) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*MySQLFlexibleServer)
+	if !ok || validation.Deleting(s) {
+		return nil
+	}
+	p, op := s.Spec.ForProvider, o.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, op.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, op.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, op.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.Location, op.Location, path.Child("location"))...)
+	errs = append(errs, validation.Immutable(p.AdministratorLogin, op.AdministratorLogin, path.Child("administratorLogin"))...)
+	errs = append(errs, validation.Immutable(p.Version, op.Version, path.Child("version"))...)
+	errs = append(errs, validation.Immutable(p.AvailabilityZone, op.AvailabilityZone, path.Child("availabilityZone"))...)
+	errs = append(errs, validation.Immutable(p.GeoRedundantBackup, op.GeoRedundantBackup, path.Child("geoRedundantBackup"))...)
+	errs = append(errs, validation.Immutable(p.DelegatedSubnetID, op.DelegatedSubnetID, path.Child("delegatedSubnetId"))...)
+	errs = append(errs, validation.Immutable(p.DelegatedSubnetIDRef, op.DelegatedSubnetIDRef, path.Child("delegatedSubnetIdRef"))...)
+	errs = append(errs, validation.Immutable(p.DelegatedSubnetIDSelector, op.DelegatedSubnetIDSelector, path.Child("delegatedSubnetIdSelector"))...)
+	errs = append(errs, validation.Immutable(p.PrivateDNSZoneID, op.PrivateDNSZoneID, path.Child("privateDnsZoneId"))...)
+	errs = append(errs, validation.Immutable(p.CreateMode, op.CreateMode, path.Child("createMode"))...)
+	errs = append(errs, validation.Immutable(p.SourceServerID, op.SourceServerID, path.Child("sourceServerId"))...)
+	errs = append(errs, validation.Immutable(p.SourceServerIDRef, op.SourceServerIDRef, path.Child("sourceServerIdRef"))...)
+	errs = append(errs, validation.Immutable(p.SourceServerIDSelector, op.SourceServerIDSelector, path.Child("sourceServerIdSelector"))...)
+	errs = append(errs, validation.Immutable(p.RestorePointInTime, op.RestorePointInTime, path.Child("restorePointInTime"))...)
+	return validation.Invalid(MySQLFlexibleServerGroupVersionKind.GroupKind(), s.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of a MySQLFlexibleServer.
+func (s *MySQLFlexibleServer) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,494 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+)
+
+// MSSQLServerPort is the port an Azure SQL server listens on.
+const MSSQLServerPort = "1433"
+
+// Possible states of an Azure SQL resource.
+const (
+	MSSQLServerStateReady      = "Ready"
+	MSSQLDatabaseStatusOnline  = "Online"
+	MSSQLElasticPoolStateReady = "Ready"
+)
+
+// An MSSQLSKU is the SKU of an Azure SQL database or elastic pool. Both DTU
+// based SKUs, e.g. S0 or StandardPool, and vCore based SKUs, e.g. GP_Gen5, are
+// supported.
+type MSSQLSKU struct {
+	// Name of the SKU, e.g. S0, P1, StandardPool or GP_Gen5.
+	Name string `json:"name"`
+
+	// Tier of the SKU, e.g. Standard or GeneralPurpose.
+	// +optional
+	Tier *string `json:"tier,omitempty"`
+
+	// Family of the hardware of vCore based SKUs, e.g. Gen5.
+	// +optional
+	Family *string `json:"family,omitempty"`
+
+	// Capacity of the SKU; DTUs, eDTUs or vCores depending on the SKU.
+	// +optional
+	Capacity *int `json:"capacity,omitempty"`
+}
+
+// An MSSQLServerAzureADAdministrator is the Azure Active Directory user or
+// group that administers an Azure SQL server.
+type MSSQLServerAzureADAdministrator struct {
+	// Login name of the administrator.
+	Login string `json:"login"`
+
+	// ObjectID of the administrator in Azure Active Directory.
+	ObjectID string `json:"objectId"`
+
+	// TenantID of the administrator. The tenant of the server is used if
+	// omitted.
+	// +optional
+	TenantID *string `json:"tenantId,omitempty"`
+
+	// AzureADOnlyAuthentication disables SQL authentication if true.
+	// +optional
+	AzureADOnlyAuthentication *bool `json:"azureADOnlyAuthentication,omitempty"`
+}
+
+// MSSQLServerParameters define the desired state of an Azure SQL server.
+type MSSQLServerParameters struct {
+	// ResourceGroupName - Name of the server's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location the server resides in.
+	// +immutable
+	Location string `json:"location"`
+
+	// AdministratorLogin - The administrator's login name of a server. Can
+	// only be specified when the server is being created.
+	// +immutable
+	AdministratorLogin string `json:"administratorLogin"`
+
+	// AdministratorLoginPasswordSecretRef - A reference to a Secret key that
+	// holds the administrator's login password. A password is generated if
+	// none is referenced. Changes to the password are sent to Azure.
+	// +optional
+	AdministratorLoginPasswordSecretRef *xpv1.SecretKeySelector `json:"administratorLoginPasswordSecretRef,omitempty"`
+
+	// Version - Server version.
+	// +kubebuilder:validation:Enum="12.0"
+	// +optional
+	// +immutable
+	Version *string `json:"version,omitempty"`
+
+	// MinimalTLSVersion - The minimal TLS version clients must use.
+	// +kubebuilder:validation:Enum="1.0";"1.1";"1.2"
+	// +optional
+	MinimalTLSVersion *string `json:"minimalTlsVersion,omitempty"`
+
+	// PublicNetworkAccess - Whether the server can be reached from public
+	// networks.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+
+	// AzureADAdministrator of the server. The administrator of the server is
+	// only observed and updated while this is set, so removing it from the
+	// spec leaves the server's administrator as is.
+	// +optional
+	AzureADAdministrator *MSSQLServerAzureADAdministrator `json:"azureADAdministrator,omitempty"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An MSSQLServerObservation represents the observed state of an Azure SQL
+// server.
+type MSSQLServerObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// State of the server.
+	State string `json:"state,omitempty"`
+
+	// FullyQualifiedDomainName - The fully qualified domain name of the
+	// server.
+	FullyQualifiedDomainName string `json:"fullyQualifiedDomainName,omitempty"`
+
+	// LastOperation represents the state of the last operation started by the
+	// controller.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+
+	// AdministratorLoginPasswordHash is a hash of the referenced administrator
+	// login password that was last sent to Azure.
+	AdministratorLoginPasswordHash string `json:"administratorLoginPasswordHash,omitempty"`
+}
+
+// A MSSQLServerSpec defines the desired state of an MSSQLServer.
+type MSSQLServerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MSSQLServerParameters `json:"forProvider"`
+}
+
+// A MSSQLServerStatus represents the observed state of an MSSQLServer.
+type MSSQLServerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MSSQLServerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLServer is a managed resource that represents an Azure SQL server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MSSQLServerSpec   `json:"spec"`
+	Status MSSQLServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLServerList contains a list of MSSQLServer.
+type MSSQLServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLServer `json:"items"`
+}
+
+// MSSQLDatabaseParameters define the desired state of an Azure SQL database.
+type MSSQLDatabaseParameters struct {
+	// ServerName - Name of the database's MSSQLServer.
+	// +immutable
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the database's MSSQLServer.
+	// +immutable
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects an MSSQLServer to reference.
+	// +immutable
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the database's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location the database resides in. Must be the location
+	// of its server.
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU of the database. Databases in an elastic pool use the ElasticPool
+	// SKU. Azure picks a SKU if none is specified.
+	// +optional
+	SKU *MSSQLSKU `json:"sku,omitempty"`
+
+	// ElasticPoolID - The ARM resource ID of the elastic pool the database is
+	// placed in.
+	// +optional
+	ElasticPoolID *string `json:"elasticPoolId,omitempty"`
+
+	// ElasticPoolIDRef - A reference to an MSSQLElasticPool to retrieve its
+	// ID.
+	// +optional
+	ElasticPoolIDRef *xpv1.Reference `json:"elasticPoolIdRef,omitempty"`
+
+	// ElasticPoolIDSelector - Selects an MSSQLElasticPool to retrieve its ID.
+	// +optional
+	ElasticPoolIDSelector *xpv1.Selector `json:"elasticPoolIdSelector,omitempty"`
+
+	// Collation of the database, e.g. SQL_Latin1_General_CP1_CI_AS.
+	// +optional
+	// +immutable
+	Collation *string `json:"collation,omitempty"`
+
+	// MaxSizeBytes - The max size of the database in bytes.
+	// +optional
+	MaxSizeBytes *int64 `json:"maxSizeBytes,omitempty"`
+
+	// ZoneRedundant - Whether the database's replicas are spread across
+	// availability zones.
+	// +optional
+	ZoneRedundant *bool `json:"zoneRedundant,omitempty"`
+
+	// LicenseType of vCore based databases. Use BasePrice to bring your own
+	// SQL Server license.
+	// +kubebuilder:validation:Enum=LicenseIncluded;BasePrice
+	// +optional
+	LicenseType *string `json:"licenseType,omitempty"`
+
+	// TransparentDataEncryption of the database.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	TransparentDataEncryption *string `json:"transparentDataEncryption,omitempty"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An MSSQLDatabaseObservation represents the observed state of an Azure SQL
+// database.
+type MSSQLDatabaseObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// Status of the database.
+	Status string `json:"status,omitempty"`
+
+	// DatabaseID - The ID of the database.
+	DatabaseID string `json:"databaseId,omitempty"`
+
+	// CurrentServiceObjectiveName - The current service level objective name
+	// of the database.
+	CurrentServiceObjectiveName string `json:"currentServiceObjectiveName,omitempty"`
+
+	// TransparentDataEncryption status of the database.
+	TransparentDataEncryption string `json:"transparentDataEncryption,omitempty"`
+}
+
+// A MSSQLDatabaseSpec defines the desired state of an MSSQLDatabase.
+type MSSQLDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MSSQLDatabaseParameters `json:"forProvider"`
+}
+
+// A MSSQLDatabaseStatus represents the observed state of an MSSQLDatabase.
+type MSSQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MSSQLDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLDatabase is a managed resource that represents an Azure SQL
+// database.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="SERVER",type="string",JSONPath=".spec.forProvider.serverName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MSSQLDatabaseSpec   `json:"spec"`
+	Status MSSQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLDatabaseList contains a list of MSSQLDatabase.
+type MSSQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLDatabase `json:"items"`
+}
+
+// MSSQLElasticPoolPerDatabaseSettings bound the capacity each database in an
+// elastic pool may use. Capacities are decimal numbers, e.g. 0.25 vCores.
+type MSSQLElasticPoolPerDatabaseSettings struct {
+	// MinCapacity each database is guaranteed.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MinCapacity *string `json:"minCapacity,omitempty"`
+
+	// MaxCapacity each database may use.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MaxCapacity *string `json:"maxCapacity,omitempty"`
+}
+
+// MSSQLElasticPoolParameters define the desired state of an Azure SQL elastic
+// pool.
+type MSSQLElasticPoolParameters struct {
+	// ServerName - Name of the elastic pool's MSSQLServer.
+	// +immutable
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the elastic pool's MSSQLServer.
+	// +immutable
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects an MSSQLServer to reference.
+	// +immutable
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the elastic pool's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location the elastic pool resides in. Must be the
+	// location of its server.
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU of the elastic pool, e.g. StandardPool or GP_Gen5.
+	SKU MSSQLSKU `json:"sku"`
+
+	// MaxSizeBytes - The storage limit of the elastic pool in bytes.
+	// +optional
+	MaxSizeBytes *int64 `json:"maxSizeBytes,omitempty"`
+
+	// PerDatabaseSettings of the elastic pool.
+	// +optional
+	PerDatabaseSettings *MSSQLElasticPoolPerDatabaseSettings `json:"perDatabaseSettings,omitempty"`
+
+	// ZoneRedundant - Whether the elastic pool's replicas are spread across
+	// availability zones.
+	// +optional
+	ZoneRedundant *bool `json:"zoneRedundant,omitempty"`
+
+	// LicenseType of vCore based elastic pools. Use BasePrice to bring your
+	// own SQL Server license.
+	// +kubebuilder:validation:Enum=LicenseIncluded;BasePrice
+	// +optional
+	LicenseType *string `json:"licenseType,omitempty"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An MSSQLElasticPoolObservation represents the observed state of an Azure
+// SQL elastic pool.
+type MSSQLElasticPoolObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// State of the elastic pool.
+	State string `json:"state,omitempty"`
+}
+
+// A MSSQLElasticPoolSpec defines the desired state of an MSSQLElasticPool.
+type MSSQLElasticPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MSSQLElasticPoolParameters `json:"forProvider"`
+}
+
+// A MSSQLElasticPoolStatus represents the observed state of an
+// MSSQLElasticPool.
+type MSSQLElasticPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MSSQLElasticPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLElasticPool is a managed resource that represents an Azure SQL
+// elastic pool.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="SKU",type="string",JSONPath=".spec.forProvider.sku.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLElasticPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MSSQLElasticPoolSpec   `json:"spec"`
+	Status MSSQLElasticPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLElasticPoolList contains a list of MSSQLElasticPool.
+type MSSQLElasticPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLElasticPool `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLServerFirewallRule is a managed resource that represents an Azure
+// SQL server firewall rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLServerFirewallRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirewallRuleSpec   `json:"spec"`
+	Status FirewallRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLServerFirewallRuleList contains a list of MSSQLServerFirewallRule.
+type MSSQLServerFirewallRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLServerFirewallRule `json:"items"`
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-mssqlserver,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mssqlservers,versions=v1alpha3,name=mssqlservers.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-mssqldatabase,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mssqldatabases,versions=v1alpha3,name=mssqldatabases.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-mssqlelasticpool,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mssqlelasticpools,versions=v1alpha3,name=mssqlelasticpools.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// ValidateCreate validates a new MSSQLServer.
+func (s *MSSQLServer) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to an MSSQLServer.
+func (s *MSSQLServer) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*MSSQLServer)
+	if !ok || validation.Deleting(s) {
+		return nil
+	}
+	p, op := s.Spec.ForProvider, o.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, op.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, op.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, op.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.Location, op.Location, path.Child("location"))...)
+	errs = append(errs, validation.Immutable(p.AdministratorLogin, op.AdministratorLogin, path.Child("administratorLogin"))...)
+	errs = append(errs, validation.Immutable(p.Version, op.Version, path.Child("version"))...)
+	return validation.Invalid(MSSQLServerGroupVersionKind.GroupKind(), s.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of an MSSQLServer.
+func (s *MSSQLServer) ValidateDelete() error {
+	return nil
+}
+
+// ValidateCreate validates a new MSSQLDatabase.
+func (d *MSSQLDatabase) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to an MSSQLDatabase.
+func (d *MSSQLDatabase) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*MSSQLDatabase)
+	if !ok || validation.Deleting(d) {
+		return nil
+	}
+	p, op := d.Spec.ForProvider, o.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.ServerName, op.ServerName, path.Child("serverName"))...)
+	errs = append(errs, validation.Immutable(p.ServerNameRef, op.ServerNameRef, path.Child("serverNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ServerNameSelector, op.ServerNameSelector, path.Child("serverNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, op.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, op.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, op.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.Location, op.Location, path.Child("location"))...)
+	errs = append(errs, validation.Immutable(p.Collation, op.Collation, path.Child("collation"))...)
+	return validation.Invalid(MSSQLDatabaseGroupVersionKind.GroupKind(), d.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of an MSSQLDatabase.
+func (d *MSSQLDatabase) ValidateDelete() error {
+	return nil
+}
+
+// ValidateCreate validates a new MSSQLElasticPool.
+func (ep *MSSQLElasticPool) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to an MSSQLElasticPool.
+func (ep *MSSQLElasticPool) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*MSSQLElasticPool)
+	if !ok || validation.Deleting(ep) {
+		return nil
+	}
+	p, op := ep.Spec.ForProvider, o.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.ServerName, op.ServerName, path.Child("serverName"))...)
+	errs = append(errs, validation.Immutable(p.ServerNameRef, op.ServerNameRef, path.Child("serverNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ServerNameSelector, op.ServerNameSelector, path.Child("serverNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, op.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, op.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, op.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.Location, op.Location, path.Child("location"))...)
+	return validation.Invalid(MSSQLElasticPoolGroupVersionKind.GroupKind(), ep.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of an MSSQLElasticPool.
+func (ep *MSSQLElasticPool) ValidateDelete() error {
+	return nil
+}
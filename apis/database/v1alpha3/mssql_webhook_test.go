@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMSSQLServerValidateUpdate(t *testing.T) {
+	v12, v11 := "12.0", "11.0"
+	now := metav1.Now()
+	server := func(p MSSQLServerParameters, deleting bool) *MSSQLServer {
+		s := &MSSQLServer{Spec: MSSQLServerSpec{ForProvider: p}}
+		if deleting {
+			s.SetDeletionTimestamp(&now)
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		s, old  *MSSQLServer
+		wantErr bool
+	}{
+		"PreviouslyUnset": {
+			s:       server(MSSQLServerParameters{Location: "westus", Version: &v12}, false),
+			old:     server(MSSQLServerParameters{Location: "westus"}, false),
+			wantErr: false,
+		},
+		"VersionChanged": {
+			s:       server(MSSQLServerParameters{Location: "westus", Version: &v11}, false),
+			old:     server(MSSQLServerParameters{Location: "westus", Version: &v12}, false),
+			wantErr: true,
+		},
+		"AdministratorLoginChanged": {
+			s:       server(MSSQLServerParameters{Location: "westus", AdministratorLogin: "otheradmin"}, false),
+			old:     server(MSSQLServerParameters{Location: "westus", AdministratorLogin: "cooladmin"}, false),
+			wantErr: true,
+		},
+		"VersionChangedButDeleting": {
+			s:       server(MSSQLServerParameters{Location: "westus", Version: &v11}, true),
+			old:     server(MSSQLServerParameters{Location: "westus", Version: &v12}, false),
+			wantErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.s.ValidateUpdate(tc.old)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
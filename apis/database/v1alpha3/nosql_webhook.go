@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=update,path=/validate-database-azure-crossplane-io-v1alpha3-cosmosdbaccount,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=cosmosdbaccounts,versions=v1alpha3,name=cosmosdbaccounts.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// ValidateCreate validates a new CosmosDBAccount.
+func (a *CosmosDBAccount) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a CosmosDBAccount.
+func (a *CosmosDBAccount) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*CosmosDBAccount)
+	if !ok || validation.Deleting(a) {
+		return nil
+	}
+	p, op := a.Spec.ForProvider, o.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, op.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, op.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, op.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	return validation.Invalid(CosmosDBAccountGroupVersionKind.GroupKind(), a.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of a CosmosDBAccount.
+func (a *CosmosDBAccount) ValidateDelete() error {
+	return nil
+}
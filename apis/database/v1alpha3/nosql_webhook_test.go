@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+func TestCosmosDBAccountValidateUpdate(t *testing.T) {
+	now := metav1.Now()
+	account := func(p CosmosDBAccountParameters, deleting bool) *CosmosDBAccount {
+		a := &CosmosDBAccount{Spec: CosmosDBAccountSpec{ForProvider: p}}
+		if deleting {
+			a.SetDeletionTimestamp(&now)
+		}
+		return a
+	}
+
+	cases := map[string]struct {
+		a, old  *CosmosDBAccount
+		wantErr bool
+	}{
+		"Unchanged": {
+			a:       account(CosmosDBAccountParameters{ResourceGroupName: "coolgroup"}, false),
+			old:     account(CosmosDBAccountParameters{ResourceGroupName: "coolgroup"}, false),
+			wantErr: false,
+		},
+		"ResolvedReference": {
+			a: account(CosmosDBAccountParameters{
+				ResourceGroupName:    "coolgroup",
+				ResourceGroupNameRef: &xpv1.Reference{Name: "coolgroup"},
+			}, false),
+			old:     account(CosmosDBAccountParameters{ResourceGroupNameRef: &xpv1.Reference{Name: "coolgroup"}}, false),
+			wantErr: false,
+		},
+		"ResourceGroupNameChanged": {
+			a:       account(CosmosDBAccountParameters{ResourceGroupName: "othergroup"}, false),
+			old:     account(CosmosDBAccountParameters{ResourceGroupName: "coolgroup"}, false),
+			wantErr: true,
+		},
+		"ResourceGroupNameRefChanged": {
+			a:       account(CosmosDBAccountParameters{ResourceGroupNameRef: &xpv1.Reference{Name: "othergroup"}}, false),
+			old:     account(CosmosDBAccountParameters{ResourceGroupNameRef: &xpv1.Reference{Name: "coolgroup"}}, false),
+			wantErr: true,
+		},
+		"ResourceGroupNameChangedButDeleting": {
+			a:       account(CosmosDBAccountParameters{ResourceGroupName: "othergroup"}, true),
+			old:     account(CosmosDBAccountParameters{ResourceGroupName: "coolgroup"}, false),
+			wantErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.a.ValidateUpdate(tc.old)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
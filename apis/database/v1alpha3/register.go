@@ -69,6 +69,126 @@ var (
 	PostgreSQLServerFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerFirewallRuleKind)
 )
 
+// MySQLServerConfiguration type metadata.
+var (
+	MySQLServerConfigurationKind             = reflect.TypeOf(MySQLServerConfiguration{}).Name()
+	MySQLServerConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLServerConfigurationKind}.String()
+	MySQLServerConfigurationKindAPIVersion   = MySQLServerConfigurationKind + "." + SchemeGroupVersion.String()
+	MySQLServerConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(MySQLServerConfigurationKind)
+)
+
+// PostgreSQLServerConfiguration type metadata.
+var (
+	PostgreSQLServerConfigurationKind             = reflect.TypeOf(PostgreSQLServerConfiguration{}).Name()
+	PostgreSQLServerConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLServerConfigurationKind}.String()
+	PostgreSQLServerConfigurationKindAPIVersion   = PostgreSQLServerConfigurationKind + "." + SchemeGroupVersion.String()
+	PostgreSQLServerConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerConfigurationKind)
+)
+
+// MySQLDatabase type metadata.
+var (
+	MySQLDatabaseKind             = reflect.TypeOf(MySQLDatabase{}).Name()
+	MySQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLDatabaseKind}.String()
+	MySQLDatabaseKindAPIVersion   = MySQLDatabaseKind + "." + SchemeGroupVersion.String()
+	MySQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(MySQLDatabaseKind)
+)
+
+// PostgreSQLDatabase type metadata.
+var (
+	PostgreSQLDatabaseKind             = reflect.TypeOf(PostgreSQLDatabase{}).Name()
+	PostgreSQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLDatabaseKind}.String()
+	PostgreSQLDatabaseKindAPIVersion   = PostgreSQLDatabaseKind + "." + SchemeGroupVersion.String()
+	PostgreSQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLDatabaseKind)
+)
+
+// PostgreSQLFlexibleServer type metadata.
+var (
+	PostgreSQLFlexibleServerKind             = reflect.TypeOf(PostgreSQLFlexibleServer{}).Name()
+	PostgreSQLFlexibleServerGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLFlexibleServerKind}.String()
+	PostgreSQLFlexibleServerKindAPIVersion   = PostgreSQLFlexibleServerKind + "." + SchemeGroupVersion.String()
+	PostgreSQLFlexibleServerGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLFlexibleServerKind)
+)
+
+// PostgreSQLFlexibleServerFirewallRule type metadata.
+var (
+	PostgreSQLFlexibleServerFirewallRuleKind             = reflect.TypeOf(PostgreSQLFlexibleServerFirewallRule{}).Name()
+	PostgreSQLFlexibleServerFirewallRuleGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLFlexibleServerFirewallRuleKind}.String()
+	PostgreSQLFlexibleServerFirewallRuleKindAPIVersion   = PostgreSQLFlexibleServerFirewallRuleKind + "." + SchemeGroupVersion.String()
+	PostgreSQLFlexibleServerFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLFlexibleServerFirewallRuleKind)
+)
+
+// PostgreSQLFlexibleServerConfiguration type metadata.
+var (
+	PostgreSQLFlexibleServerConfigurationKind             = reflect.TypeOf(PostgreSQLFlexibleServerConfiguration{}).Name()
+	PostgreSQLFlexibleServerConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLFlexibleServerConfigurationKind}.String()
+	PostgreSQLFlexibleServerConfigurationKindAPIVersion   = PostgreSQLFlexibleServerConfigurationKind + "." + SchemeGroupVersion.String()
+	PostgreSQLFlexibleServerConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLFlexibleServerConfigurationKind)
+)
+
+// MySQLFlexibleServer type metadata.
+var (
+	MySQLFlexibleServerKind             = reflect.TypeOf(MySQLFlexibleServer{}).Name()
+	MySQLFlexibleServerGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLFlexibleServerKind}.String()
+	MySQLFlexibleServerKindAPIVersion   = MySQLFlexibleServerKind + "." + SchemeGroupVersion.String()
+	MySQLFlexibleServerGroupVersionKind = SchemeGroupVersion.WithKind(MySQLFlexibleServerKind)
+)
+
+// MariaDBServerFirewallRule type metadata.
+var (
+	MariaDBServerFirewallRuleKind             = reflect.TypeOf(MariaDBServerFirewallRule{}).Name()
+	MariaDBServerFirewallRuleGroupKind        = schema.GroupKind{Group: Group, Kind: MariaDBServerFirewallRuleKind}.String()
+	MariaDBServerFirewallRuleKindAPIVersion   = MariaDBServerFirewallRuleKind + "." + SchemeGroupVersion.String()
+	MariaDBServerFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(MariaDBServerFirewallRuleKind)
+)
+
+// MariaDBServerVirtualNetworkRule type metadata.
+var (
+	MariaDBServerVirtualNetworkRuleKind             = reflect.TypeOf(MariaDBServerVirtualNetworkRule{}).Name()
+	MariaDBServerVirtualNetworkRuleGroupKind        = schema.GroupKind{Group: Group, Kind: MariaDBServerVirtualNetworkRuleKind}.String()
+	MariaDBServerVirtualNetworkRuleKindAPIVersion   = MariaDBServerVirtualNetworkRuleKind + "." + SchemeGroupVersion.String()
+	MariaDBServerVirtualNetworkRuleGroupVersionKind = SchemeGroupVersion.WithKind(MariaDBServerVirtualNetworkRuleKind)
+)
+
+// MSSQLServer type metadata.
+var (
+	MSSQLServerKind             = reflect.TypeOf(MSSQLServer{}).Name()
+	MSSQLServerGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLServerKind}.String()
+	MSSQLServerKindAPIVersion   = MSSQLServerKind + "." + SchemeGroupVersion.String()
+	MSSQLServerGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLServerKind)
+)
+
+// MSSQLDatabase type metadata.
+var (
+	MSSQLDatabaseKind             = reflect.TypeOf(MSSQLDatabase{}).Name()
+	MSSQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLDatabaseKind}.String()
+	MSSQLDatabaseKindAPIVersion   = MSSQLDatabaseKind + "." + SchemeGroupVersion.String()
+	MSSQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLDatabaseKind)
+)
+
+// MSSQLElasticPool type metadata.
+var (
+	MSSQLElasticPoolKind             = reflect.TypeOf(MSSQLElasticPool{}).Name()
+	MSSQLElasticPoolGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLElasticPoolKind}.String()
+	MSSQLElasticPoolKindAPIVersion   = MSSQLElasticPoolKind + "." + SchemeGroupVersion.String()
+	MSSQLElasticPoolGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLElasticPoolKind)
+)
+
+// MSSQLServerFirewallRule type metadata.
+var (
+	MSSQLServerFirewallRuleKind             = reflect.TypeOf(MSSQLServerFirewallRule{}).Name()
+	MSSQLServerFirewallRuleGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLServerFirewallRuleKind}.String()
+	MSSQLServerFirewallRuleKindAPIVersion   = MSSQLServerFirewallRuleKind + "." + SchemeGroupVersion.String()
+	MSSQLServerFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLServerFirewallRuleKind)
+)
+
+// MSSQLServerVirtualNetworkRule type metadata.
+var (
+	MSSQLServerVirtualNetworkRuleKind             = reflect.TypeOf(MSSQLServerVirtualNetworkRule{}).Name()
+	MSSQLServerVirtualNetworkRuleGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLServerVirtualNetworkRuleKind}.String()
+	MSSQLServerVirtualNetworkRuleKindAPIVersion   = MSSQLServerVirtualNetworkRuleKind + "." + SchemeGroupVersion.String()
+	MSSQLServerVirtualNetworkRuleGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLServerVirtualNetworkRuleKind)
+)
+
 // CosmosDBAccount type metadata.
 var (
 	CosmosDBAccountKind             = reflect.TypeOf(CosmosDBAccount{}).Name()
@@ -82,5 +202,20 @@ func init() {
 	SchemeBuilder.Register(&PostgreSQLServerVirtualNetworkRule{}, &PostgreSQLServerVirtualNetworkRuleList{})
 	SchemeBuilder.Register(&MySQLServerFirewallRule{}, &MySQLServerFirewallRuleList{})
 	SchemeBuilder.Register(&PostgreSQLServerFirewallRule{}, &PostgreSQLServerFirewallRuleList{})
+	SchemeBuilder.Register(&MySQLServerConfiguration{}, &MySQLServerConfigurationList{})
+	SchemeBuilder.Register(&PostgreSQLServerConfiguration{}, &PostgreSQLServerConfigurationList{})
+	SchemeBuilder.Register(&MySQLDatabase{}, &MySQLDatabaseList{})
+	SchemeBuilder.Register(&PostgreSQLDatabase{}, &PostgreSQLDatabaseList{})
+	SchemeBuilder.Register(&PostgreSQLFlexibleServer{}, &PostgreSQLFlexibleServerList{})
+	SchemeBuilder.Register(&PostgreSQLFlexibleServerFirewallRule{}, &PostgreSQLFlexibleServerFirewallRuleList{})
+	SchemeBuilder.Register(&PostgreSQLFlexibleServerConfiguration{}, &PostgreSQLFlexibleServerConfigurationList{})
+	SchemeBuilder.Register(&MySQLFlexibleServer{}, &MySQLFlexibleServerList{})
+	SchemeBuilder.Register(&MariaDBServerFirewallRule{}, &MariaDBServerFirewallRuleList{})
+	SchemeBuilder.Register(&MariaDBServerVirtualNetworkRule{}, &MariaDBServerVirtualNetworkRuleList{})
+	SchemeBuilder.Register(&MSSQLServer{}, &MSSQLServerList{})
+	SchemeBuilder.Register(&MSSQLDatabase{}, &MSSQLDatabaseList{})
+	SchemeBuilder.Register(&MSSQLElasticPool{}, &MSSQLElasticPoolList{})
+	SchemeBuilder.Register(&MSSQLServerFirewallRule{}, &MSSQLServerFirewallRuleList{})
+	SchemeBuilder.Register(&MSSQLServerVirtualNetworkRule{}, &MSSQLServerVirtualNetworkRuleList{})
 	SchemeBuilder.Register(&CosmosDBAccount{}, &CosmosDBAccountList{})
 }
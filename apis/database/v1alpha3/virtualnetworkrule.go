@@ -178,3 +178,113 @@ type MySQLServerVirtualNetworkRuleList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []MySQLServerVirtualNetworkRule `json:"items"`
 }
+
+// A MSSQLVirtualNetworkRuleSpec defines the desired state of a MSSQLVirtualNetworkRule.
+type MSSQLVirtualNetworkRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// ServerName - Name of the Virtual Network Rule's server.
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the Virtual Network Rule's MSSQLServer.
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects an MSSQLServer to reference.
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the Virtual Network Rule's resource group.
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// VirtualNetworkRuleProperties - Resource properties.
+	VirtualNetworkRuleProperties `json:"properties"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLServerVirtualNetworkRule is a managed resource that represents an
+// Azure SQL server virtual network rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLServerVirtualNetworkRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MSSQLVirtualNetworkRuleSpec `json:"spec"`
+	Status VirtualNetworkRuleStatus    `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLServerVirtualNetworkRuleList contains a list of
+// MSSQLServerVirtualNetworkRule.
+type MSSQLServerVirtualNetworkRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLServerVirtualNetworkRule `json:"items"`
+}
+
+// A MariaDBVirtualNetworkRuleSpec defines the desired state of a MariaDBVirtualNetworkRule.
+type MariaDBVirtualNetworkRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// ServerName - Name of the Virtual Network Rule's server.
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the Virtual Network Rule's MariaDBServer.
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects a MariaDBServer to reference.
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the Virtual Network Rule's resource group.
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// VirtualNetworkRuleProperties - Resource properties.
+	VirtualNetworkRuleProperties `json:"properties"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MariaDBServerVirtualNetworkRule is a managed resource that represents an
+// Azure MariaDB Database virtual network rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MariaDBServerVirtualNetworkRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MariaDBVirtualNetworkRuleSpec `json:"spec"`
+	Status VirtualNetworkRuleStatus      `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MariaDBServerVirtualNetworkRuleList contains a list of
+// MariaDBServerVirtualNetworkRule.
+type MariaDBServerVirtualNetworkRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MariaDBServerVirtualNetworkRule `json:"items"`
+}
@@ -29,6 +29,96 @@ func (l *CosmosDBAccountList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this MSSQLDatabaseList.
+func (l *MSSQLDatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MSSQLElasticPoolList.
+func (l *MSSQLElasticPoolList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MSSQLServerList.
+func (l *MSSQLServerList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MSSQLServerFirewallRuleList.
+func (l *MSSQLServerFirewallRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MSSQLServerVirtualNetworkRuleList.
+func (l *MSSQLServerVirtualNetworkRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MariaDBServerFirewallRuleList.
+func (l *MariaDBServerFirewallRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MariaDBServerVirtualNetworkRuleList.
+func (l *MariaDBServerVirtualNetworkRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MySQLDatabaseList.
+func (l *MySQLDatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MySQLFlexibleServerList.
+func (l *MySQLFlexibleServerList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MySQLServerConfigurationList.
+func (l *MySQLServerConfigurationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this MySQLServerFirewallRuleList.
 func (l *MySQLServerFirewallRuleList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -47,6 +137,51 @@ func (l *MySQLServerVirtualNetworkRuleList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this PostgreSQLDatabaseList.
+func (l *PostgreSQLDatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PostgreSQLFlexibleServerList.
+func (l *PostgreSQLFlexibleServerList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PostgreSQLFlexibleServerConfigurationList.
+func (l *PostgreSQLFlexibleServerConfigurationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PostgreSQLFlexibleServerFirewallRuleList.
+func (l *PostgreSQLFlexibleServerFirewallRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PostgreSQLServerConfigurationList.
+func (l *PostgreSQLServerConfigurationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this PostgreSQLServerFirewallRuleList.
 func (l *PostgreSQLServerFirewallRuleList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -24,6 +24,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	managedidentityv1alpha3 "github.com/crossplane/provider-azure/apis/managedidentity/v1alpha3"
 	"github.com/crossplane/provider-azure/apis/v1alpha3"
 )
 
@@ -45,7 +46,7 @@ func (mg *MySQLServer) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
 	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
 
-	return nil
+	return errors.Wrap(resolveAzureADAdministrator(ctx, r, mg.Spec.ForProvider.AzureADAdministrator), "spec.forProvider.azureADAdministrator")
 }
 
 // ResolveReferences of this PostgreSQLServer.
@@ -66,5 +67,64 @@ func (mg *PostgreSQLServer) ResolveReferences(ctx context.Context, c client.Read
 	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
 	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
 
+	return errors.Wrap(resolveAzureADAdministrator(ctx, r, mg.Spec.ForProvider.AzureADAdministrator), "spec.forProvider.azureADAdministrator")
+}
+
+// ResolveReferences of this MariaDBServer.
+func (mg *MariaDBServer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// resolveAzureADAdministrator resolves the object and tenant IDs of the
+// supplied administrator, if any, from a UserAssignedIdentity.
+func resolveAzureADAdministrator(ctx context.Context, r *reference.APIResolver, a *AzureADAdministrator) error {
+	if a == nil {
+		return nil
+	}
+
+	// Resolve objectId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: a.ObjectID,
+		Reference:    a.ObjectIDRef,
+		Selector:     a.ObjectIDSelector,
+		To:           reference.To{Managed: &managedidentityv1alpha3.UserAssignedIdentity{}, List: &managedidentityv1alpha3.UserAssignedIdentityList{}},
+		Extract:      managedidentityv1alpha3.PrincipalID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "objectId")
+	}
+	a.ObjectID = rsp.ResolvedValue
+	a.ObjectIDRef = rsp.ResolvedReference
+
+	// Resolve tenantId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: a.TenantID,
+		Reference:    a.TenantIDRef,
+		Selector:     a.TenantIDSelector,
+		To:           reference.To{Managed: &managedidentityv1alpha3.UserAssignedIdentity{}, List: &managedidentityv1alpha3.UserAssignedIdentityList{}},
+		Extract:      managedidentityv1alpha3.TenantID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "tenantId")
+	}
+	a.TenantID = rsp.ResolvedValue
+	a.TenantIDRef = rsp.ResolvedReference
+
 	return nil
 }
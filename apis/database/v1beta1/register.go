@@ -53,7 +53,16 @@ var (
 	PostgreSQLServerGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerKind)
 )
 
+// MariaDBServer type metadata.
+var (
+	MariaDBServerKind             = reflect.TypeOf(MariaDBServer{}).Name()
+	MariaDBServerGroupKind        = schema.GroupKind{Group: Group, Kind: MariaDBServerKind}.String()
+	MariaDBServerKindAPIVersion   = MariaDBServerKind + "." + SchemeGroupVersion.String()
+	MariaDBServerGroupVersionKind = SchemeGroupVersion.WithKind(MariaDBServerKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&MySQLServer{}, &MySQLServerList{})
 	SchemeBuilder.Register(&PostgreSQLServer{}, &PostgreSQLServerList{})
+	SchemeBuilder.Register(&MariaDBServer{}, &MariaDBServerList{})
 }
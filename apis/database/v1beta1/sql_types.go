@@ -88,6 +88,33 @@ type PostgreSQLServerList struct {
 	Items           []PostgreSQLServer `json:"items"`
 }
 
+// +kubebuilder:object:root=true
+
+// A MariaDBServer is a managed resource that represents an Azure MariaDB
+// Database Server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MariaDBServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SQLServerSpec   `json:"spec"`
+	Status SQLServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MariaDBServerList contains a list of MariaDBServer.
+type MariaDBServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MariaDBServer `json:"items"`
+}
+
 // SKU billing information related properties of a server.
 type SKU struct {
 	// Tier - The tier of the particular SKU.
@@ -128,8 +155,44 @@ type StorageProfile struct {
 	StorageAutogrow *string `json:"storageAutogrow,omitempty"`
 }
 
+// An AzureADAdministrator is the Azure Active Directory user, group or
+// identity that administers a server.
+type AzureADAdministrator struct {
+	// Login name of the administrator. Clients authenticating with Azure AD
+	// tokens connect as this user.
+	Login string `json:"login"`
+
+	// ObjectID of the administrator in Azure Active Directory.
+	// +optional
+	ObjectID string `json:"objectId,omitempty"`
+
+	// ObjectIDRef - A reference to a UserAssignedIdentity to retrieve its
+	// principal ID.
+	// +optional
+	ObjectIDRef *xpv1.Reference `json:"objectIdRef,omitempty"`
+
+	// ObjectIDSelector - Selects a UserAssignedIdentity to retrieve its
+	// principal ID.
+	// +optional
+	ObjectIDSelector *xpv1.Selector `json:"objectIdSelector,omitempty"`
+
+	// TenantID of the administrator.
+	// +optional
+	TenantID string `json:"tenantId,omitempty"`
+
+	// TenantIDRef - A reference to a UserAssignedIdentity to retrieve its
+	// tenant ID.
+	// +optional
+	TenantIDRef *xpv1.Reference `json:"tenantIdRef,omitempty"`
+
+	// TenantIDSelector - Selects a UserAssignedIdentity to retrieve its tenant
+	// ID.
+	// +optional
+	TenantIDSelector *xpv1.Selector `json:"tenantIdSelector,omitempty"`
+}
+
 // SQLServerParameters define the desired state of an Azure SQL Database, either
-// PostgreSQL or MySQL.
+// PostgreSQL, MySQL or MariaDB.
 type SQLServerParameters struct {
 	// ResourceGroupName specifies the name of the resource group that should
 	// contain this SQLServer.
@@ -157,14 +220,48 @@ type SQLServerParameters struct {
 	// +immutable
 	AdministratorLogin string `json:"administratorLogin"`
 
-	// TODO(hasheddan): support AdministratorLoginPassword
+	// AdministratorLoginPasswordSecretRef references the key of a Secret
+	// that holds the administrator login password. A password is generated
+	// if this is omitted. Changes to the password are sent to Azure, and
+	// published to the connection secret once Azure has applied them, if a
+	// connection secret is written.
+	// +optional
+	AdministratorLoginPasswordSecretRef *xpv1.SecretKeySelector `json:"administratorLoginPasswordSecretRef,omitempty"`
+
+	// AdministratorLoginPasswordRotationInterval is how often the
+	// administrator login password is rotated, e.g. 720h. The new password is
+	// published to the connection secret once Azure has applied it, so a
+	// connection secret must be written. The password is never rotated if
+	// this is omitted, or if AdministratorLoginPasswordSecretRef is set.
+	// +optional
+	AdministratorLoginPasswordRotationInterval *metav1.Duration `json:"administratorLoginPasswordRotationInterval,omitempty"`
+
+	// AzureADAdministrator of the server. The administrator of the server is
+	// only observed and updated while this is set, so removing it from the
+	// spec leaves the server's administrator as is. Not supported by MariaDB
+	// servers.
+	// +optional
+	AzureADAdministrator *AzureADAdministrator `json:"azureADAdministrator,omitempty"`
 
-	// MinimalTLSVersion - control TLS connection policy
+	// MinimalTLSVersion - control TLS connection policy. Not supported by
+	// MariaDB servers.
 	MinimalTLSVersion string `json:"minimalTlsVersion,omitempty"`
 
-	// TODO(hasheddan): support InfrastructureEncryption
+	// InfrastructureEncryption - Whether the server's data is encrypted a
+	// second time, at the infrastructure level. Can only be specified when the
+	// server is being created. Not supported by MariaDB servers.
+	// Possible values include: 'Enabled', 'Disabled'
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +immutable
+	// +optional
+	InfrastructureEncryption *string `json:"infrastructureEncryption,omitempty"`
 
-	// TODO(hasheddan): support PublicNetworkAccess
+	// PublicNetworkAccess - Whether or not public network access is allowed
+	// for this server.
+	// Possible values include: 'Enabled', 'Disabled'
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
 
 	// CreateMode - Possible values include: 'CreateModeDefault', 'CreateModePointInTimeRestore', 'CreateModeGeoRestore', 'CreateModeReplica'
 	// +optional
@@ -235,6 +332,27 @@ type SQLServerObservation struct {
 	// LastOperation represents the state of the last operation started by the
 	// controller.
 	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+
+	// AdministratorLoginPasswordRotation represents the rotation of the
+	// administrator login password.
+	AdministratorLoginPasswordRotation PasswordRotationObservation `json:"administratorLoginPasswordRotation,omitempty"`
+}
+
+// A PasswordRotationObservation represents the rotation of a password.
+type PasswordRotationObservation struct {
+	// LastRotationTime is when the password was last rotated, or created.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// NextRotationTime is when the password will next be rotated.
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+
+	// LastRotation represents the state of the last operation that sent a
+	// new password to Azure.
+	LastRotation apisv1alpha3.AsyncOperation `json:"lastRotation,omitempty"`
+
+	// PasswordHash is a hash of the referenced administrator login password
+	// that was last sent to Azure.
+	PasswordHash string `json:"passwordHash,omitempty"`
 }
 
 // A SQLServerStatus represents the observed state of a SQLServer.
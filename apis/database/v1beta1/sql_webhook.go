@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-database-azure-crossplane-io-v1beta1-mysqlserver,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mysqlservers,versions=v1beta1,name=mysqlservers.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-database-azure-crossplane-io-v1beta1-postgresqlserver,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=postgresqlservers,versions=v1beta1,name=postgresqlservers.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-database-azure-crossplane-io-v1beta1-mariadbserver,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mariadbservers,versions=v1beta1,name=mariadbservers.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// ValidateCreate validates a new MySQLServer.
+func (s *MySQLServer) ValidateCreate() error {
+	return validation.Invalid(MySQLServerGroupVersionKind.GroupKind(), s.GetName(), validateSQLServerParameters(s.Spec.ForProvider))
+}
+
+// ValidateUpdate validates an update to a MySQLServer.
+func (s *MySQLServer) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*MySQLServer)
+	if !ok || validation.Deleting(s) {
+		return nil
+	}
+	return validation.Invalid(MySQLServerGroupVersionKind.GroupKind(), s.GetName(), validateSQLServerParametersUpdate(s.Spec.ForProvider, o.Spec.ForProvider))
+}
+
+// ValidateDelete validates the deletion of a MySQLServer.
+func (s *MySQLServer) ValidateDelete() error {
+	return nil
+}
+
+// ValidateCreate validates a new PostgreSQLServer.
+func (s *PostgreSQLServer) ValidateCreate() error {
+	return validation.Invalid(PostgreSQLServerGroupVersionKind.GroupKind(), s.GetName(), validateSQLServerParameters(s.Spec.ForProvider))
+}
+
+// ValidateUpdate validates an update to a PostgreSQLServer.
+func (s *PostgreSQLServer) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*PostgreSQLServer)
+	if !ok || validation.Deleting(s) {
+		return nil
+	}
+	return validation.Invalid(PostgreSQLServerGroupVersionKind.GroupKind(), s.GetName(), validateSQLServerParametersUpdate(s.Spec.ForProvider, o.Spec.ForProvider))
+}
+
+// ValidateDelete validates the deletion of a PostgreSQLServer.
+func (s *PostgreSQLServer) ValidateDelete() error {
+	return nil
+}
+
+// ValidateCreate validates a new MariaDBServer.
+func (s *MariaDBServer) ValidateCreate() error {
+	errs := validateSQLServerParameters(s.Spec.ForProvider)
+	errs = append(errs, validateMariaDBServerParameters(s.Spec.ForProvider)...)
+	return validation.Invalid(MariaDBServerGroupVersionKind.GroupKind(), s.GetName(), errs)
+}
+
+// ValidateUpdate validates an update to a MariaDBServer.
+func (s *MariaDBServer) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*MariaDBServer)
+	if !ok || validation.Deleting(s) {
+		return nil
+	}
+	errs := validateSQLServerParametersUpdate(s.Spec.ForProvider, o.Spec.ForProvider)
+	if validation.Changed(s.Spec.ForProvider, o.Spec.ForProvider) {
+		errs = append(errs, validateMariaDBServerParameters(s.Spec.ForProvider)...)
+	}
+	return validation.Invalid(MariaDBServerGroupVersionKind.GroupKind(), s.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of a MariaDBServer.
+func (s *MariaDBServer) ValidateDelete() error {
+	return nil
+}
+
+func validateSQLServerParameters(p SQLServerParameters) field.ErrorList {
+	path := field.NewPath("spec", "forProvider")
+	errs := validateAzureADAdministrator(p.AzureADAdministrator, path.Child("azureADAdministrator"))
+	if p.CreateMode == nil {
+		return errs
+	}
+	switch *p.CreateMode {
+	case CreateModePointInTimeRestore:
+		if p.RestorePointInTime == nil {
+			errs = append(errs, field.Required(path.Child("restorePointInTime"), "required when createMode is PointInTimeRestore"))
+		}
+		fallthrough
+	case CreateModeGeoRestore, CreateModeReplica:
+		if p.SourceServerID == nil {
+			errs = append(errs, field.Required(path.Child("sourceServerID"), "required when createMode is "+string(*p.CreateMode)))
+		}
+	}
+	return errs
+}
+
+// validateAzureADAdministrator requires the object and tenant IDs of the
+// supplied administrator, if any, to be either set or resolvable.
+func validateAzureADAdministrator(a *AzureADAdministrator, path *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	if a == nil {
+		return errs
+	}
+	if a.ObjectID == "" && a.ObjectIDRef == nil && a.ObjectIDSelector == nil {
+		errs = append(errs, field.Required(path.Child("objectId"), "objectId, objectIdRef or objectIdSelector is required"))
+	}
+	if a.TenantID == "" && a.TenantIDRef == nil && a.TenantIDSelector == nil {
+		errs = append(errs, field.Required(path.Child("tenantId"), "tenantId, tenantIdRef or tenantIdSelector is required"))
+	}
+	return errs
+}
+
+// validateMariaDBServerParameters rejects the fields of SQLServerParameters
+// that the MariaDB API does not support.
+func validateMariaDBServerParameters(p SQLServerParameters) field.ErrorList {
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	if p.MinimalTLSVersion != "" {
+		errs = append(errs, field.Forbidden(path.Child("minimalTlsVersion"), "not supported by MariaDB servers"))
+	}
+	if p.InfrastructureEncryption != nil {
+		errs = append(errs, field.Forbidden(path.Child("infrastructureEncryption"), "not supported by MariaDB servers"))
+	}
+	if p.AzureADAdministrator != nil {
+		errs = append(errs, field.Forbidden(path.Child("azureADAdministrator"), "not supported by MariaDB servers"))
+	}
+	return errs
+}
+
+func validateSQLServerParametersUpdate(p, old SQLServerParameters) field.ErrorList {
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	if validation.Changed(p, old) {
+		errs = validateSQLServerParameters(p)
+	}
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, old.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, old.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, old.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.Location, old.Location, path.Child("location"))...)
+	errs = append(errs, validation.Immutable(p.AdministratorLogin, old.AdministratorLogin, path.Child("administratorLogin"))...)
+	errs = append(errs, validation.Immutable(p.InfrastructureEncryption, old.InfrastructureEncryption, path.Child("infrastructureEncryption"))...)
+	return errs
+}
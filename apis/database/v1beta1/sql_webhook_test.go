@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+func TestValidateSQLServerParameters(t *testing.T) {
+	pitr := CreateModePointInTimeRestore
+	replica := CreateModeReplica
+	path := field.NewPath("spec", "forProvider")
+
+	cases := map[string]struct {
+		p    SQLServerParameters
+		want []string
+	}{
+		"DefaultCreateMode": {
+			p:    SQLServerParameters{},
+			want: []string{},
+		},
+		"PointInTimeRestoreMissingFields": {
+			p: SQLServerParameters{CreateMode: &pitr},
+			want: []string{
+				path.Child("restorePointInTime").String(),
+				path.Child("sourceServerID").String(),
+			},
+		},
+		"PointInTimeRestoreComplete": {
+			p: SQLServerParameters{
+				CreateMode:         &pitr,
+				RestorePointInTime: &metav1.Time{},
+				SourceServerID:     new(string),
+			},
+			want: []string{},
+		},
+		"ReplicaMissingSource": {
+			p:    SQLServerParameters{CreateMode: &replica},
+			want: []string{path.Child("sourceServerID").String()},
+		},
+		"AzureADAdministratorMissingIDs": {
+			p: SQLServerParameters{AzureADAdministrator: &AzureADAdministrator{Login: "admins"}},
+			want: []string{
+				path.Child("azureADAdministrator", "objectId").String(),
+				path.Child("azureADAdministrator", "tenantId").String(),
+			},
+		},
+		"AzureADAdministratorReferencedIDs": {
+			p: SQLServerParameters{AzureADAdministrator: &AzureADAdministrator{
+				Login:       "admins",
+				ObjectIDRef: &xpv1.Reference{Name: "identity"},
+				TenantIDRef: &xpv1.Reference{Name: "identity"},
+			}},
+			want: []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			for _, err := range validateSQLServerParameters(tc.p) {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("validateSQLServerParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateMariaDBServerParameters(t *testing.T) {
+	enabled := "Enabled"
+	path := field.NewPath("spec", "forProvider")
+
+	cases := map[string]struct {
+		p    SQLServerParameters
+		want []string
+	}{
+		"Supported": {
+			p:    SQLServerParameters{Version: "10.3", PublicNetworkAccess: &enabled},
+			want: []string{},
+		},
+		"Unsupported": {
+			p: SQLServerParameters{
+				MinimalTLSVersion:        "TLS1_2",
+				InfrastructureEncryption: &enabled,
+				AzureADAdministrator:     &AzureADAdministrator{Login: "admins"},
+			},
+			want: []string{
+				path.Child("minimalTlsVersion").String(),
+				path.Child("infrastructureEncryption").String(),
+				path.Child("azureADAdministrator").String(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			for _, err := range validateMariaDBServerParameters(tc.p) {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("validateMariaDBServerParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateSQLServerParametersUpdate(t *testing.T) {
+	path := field.NewPath("spec", "forProvider")
+	loc := func(s string) SQLServerParameters { return SQLServerParameters{Location: s} }
+	enabled, disabled := "Enabled", "Disabled"
+	replica := CreateModeReplica
+
+	cases := map[string]struct {
+		p, old SQLServerParameters
+		want   []string
+	}{
+		"Unchanged": {
+			p:    loc("westus"),
+			old:  loc("westus"),
+			want: []string{},
+		},
+		"PreviouslyUnset": {
+			p:    loc("westus"),
+			old:  loc(""),
+			want: []string{},
+		},
+		"LocationChanged": {
+			p:    loc("eastus"),
+			old:  loc("westus"),
+			want: []string{path.Child("location").String()},
+		},
+		"InfrastructureEncryptionChanged": {
+			p:    SQLServerParameters{InfrastructureEncryption: &enabled},
+			old:  SQLServerParameters{InfrastructureEncryption: &disabled},
+			want: []string{path.Child("infrastructureEncryption").String()},
+		},
+		"InvalidButUnchanged": {
+			p:    SQLServerParameters{CreateMode: &replica},
+			old:  SQLServerParameters{CreateMode: &replica},
+			want: []string{},
+		},
+		"InvalidAndChanged": {
+			p:    SQLServerParameters{CreateMode: &replica, Version: "11"},
+			old:  SQLServerParameters{CreateMode: &replica},
+			want: []string{path.Child("sourceServerID").String()},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			for _, err := range validateSQLServerParametersUpdate(tc.p, tc.old) {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("validateSQLServerParametersUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMySQLServerValidateUpdate(t *testing.T) {
+	replica := CreateModeReplica
+	now := metav1.Now()
+	server := func(version string, deleting bool) *MySQLServer {
+		s := &MySQLServer{Spec: SQLServerSpec{ForProvider: SQLServerParameters{CreateMode: &replica, Version: version}}}
+		if deleting {
+			s.SetDeletionTimestamp(&now)
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		s, old  *MySQLServer
+		wantErr bool
+	}{
+		"Invalid": {
+			s:       server("5.7", false),
+			old:     server("5.6", false),
+			wantErr: true,
+		},
+		"InvalidButDeleting": {
+			s:       server("5.7", true),
+			old:     server("5.6", false),
+			wantErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.s.ValidateUpdate(tc.old)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -22,9 +22,104 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureADAdministrator) DeepCopyInto(out *AzureADAdministrator) {
+	*out = *in
+	if in.ObjectIDRef != nil {
+		in, out := &in.ObjectIDRef, &out.ObjectIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ObjectIDSelector != nil {
+		in, out := &in.ObjectIDSelector, &out.ObjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TenantIDRef != nil {
+		in, out := &in.TenantIDRef, &out.TenantIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TenantIDSelector != nil {
+		in, out := &in.TenantIDSelector, &out.TenantIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureADAdministrator.
+func (in *AzureADAdministrator) DeepCopy() *AzureADAdministrator {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureADAdministrator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBServer) DeepCopyInto(out *MariaDBServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBServer.
+func (in *MariaDBServer) DeepCopy() *MariaDBServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MariaDBServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBServerList) DeepCopyInto(out *MariaDBServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MariaDBServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBServerList.
+func (in *MariaDBServerList) DeepCopy() *MariaDBServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MariaDBServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLServer) DeepCopyInto(out *MySQLServer) {
 	*out = *in
@@ -84,6 +179,30 @@ func (in *MySQLServerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotationObservation) DeepCopyInto(out *PasswordRotationObservation) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	out.LastRotation = in.LastRotation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotationObservation.
+func (in *PasswordRotationObservation) DeepCopy() *PasswordRotationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PostgreSQLServer) DeepCopyInto(out *PostgreSQLServer) {
 	*out = *in
@@ -167,6 +286,7 @@ func (in *SKU) DeepCopy() *SKU {
 func (in *SQLServerObservation) DeepCopyInto(out *SQLServerObservation) {
 	*out = *in
 	out.LastOperation = in.LastOperation
+	in.AdministratorLoginPasswordRotation.DeepCopyInto(&out.AdministratorLoginPasswordRotation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLServerObservation.
@@ -193,6 +313,31 @@ func (in *SQLServerParameters) DeepCopyInto(out *SQLServerParameters) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.SKU.DeepCopyInto(&out.SKU)
+	if in.AdministratorLoginPasswordSecretRef != nil {
+		in, out := &in.AdministratorLoginPasswordSecretRef, &out.AdministratorLoginPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.AdministratorLoginPasswordRotationInterval != nil {
+		in, out := &in.AdministratorLoginPasswordRotationInterval, &out.AdministratorLoginPasswordRotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AzureADAdministrator != nil {
+		in, out := &in.AzureADAdministrator, &out.AzureADAdministrator
+		*out = new(AzureADAdministrator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InfrastructureEncryption != nil {
+		in, out := &in.InfrastructureEncryption, &out.InfrastructureEncryption
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicNetworkAccess != nil {
+		in, out := &in.PublicNetworkAccess, &out.PublicNetworkAccess
+		*out = new(string)
+		**out = **in
+	}
 	if in.CreateMode != nil {
 		in, out := &in.CreateMode, &out.CreateMode
 		*out = new(CreateMode)
@@ -248,7 +393,7 @@ func (in *SQLServerSpec) DeepCopy() *SQLServerSpec {
 func (in *SQLServerStatus) DeepCopyInto(out *SQLServerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLServerStatus.
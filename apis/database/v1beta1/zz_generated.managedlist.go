@@ -20,6 +20,15 @@ package v1beta1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this MariaDBServerList.
+func (l *MariaDBServerList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this MySQLServerList.
 func (l *MySQLServerList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
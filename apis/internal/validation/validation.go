@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation contains helpers for validating managed resources.
+package validation
+
+import (
+	"reflect"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Immutable returns an error if the supplied field was set and has changed.
+// Fields that were unset may be set, for example by late initialization or
+// by resolving references.
+func Immutable(newVal, oldVal interface{}, path *field.Path) field.ErrorList {
+	if oldVal == nil || reflect.ValueOf(oldVal).IsZero() {
+		return nil
+	}
+	return apivalidation.ValidateImmutableField(newVal, oldVal, path)
+}
+
+// Deleting returns true if the supplied object is being deleted. Updates to
+// such objects, for example removing their finalizers, should not be
+// validated so that objects that became invalid can still be deleted.
+func Deleting(o metav1.Object) bool {
+	return o.GetDeletionTimestamp() != nil
+}
+
+// Changed returns true if the supplied parameters differ. Cross-field
+// constraints should only be validated on update if the parameters they
+// constrain changed, so that existing objects that violate constraints that
+// were added later can still be updated.
+func Changed(newVal, oldVal interface{}) bool {
+	return !reflect.DeepEqual(newVal, oldVal)
+}
+
+// Invalid returns an Invalid API error for the named resource of the supplied
+// kind if there are any errors.
+func Invalid(gk schema.GroupKind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return kerrors.NewInvalid(gk, name, errs)
+}
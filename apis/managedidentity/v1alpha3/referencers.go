@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+)
+
+// PrincipalID extracts status.atProvider.principalId from the supplied managed
+// resource, which must be a UserAssignedIdentity.
+func PrincipalID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		i, ok := mg.(*UserAssignedIdentity)
+		if !ok {
+			return ""
+		}
+		return i.Status.AtProvider.PrincipalID
+	}
+}
+
+// TenantID extracts status.atProvider.tenantId from the supplied managed
+// resource, which must be a UserAssignedIdentity.
+func TenantID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		i, ok := mg.(*UserAssignedIdentity)
+		if !ok {
+			return ""
+		}
+		return i.Status.AtProvider.TenantID
+	}
+}
+
+// ResolveReferences of this UserAssignedIdentity.
+func (mg *UserAssignedIdentity) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
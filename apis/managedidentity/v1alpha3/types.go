@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// UserAssignedIdentityParameters define the desired state of an Azure
+// user-assigned managed identity.
+type UserAssignedIdentityParameters struct {
+	// ResourceGroupName - Name of the identity's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location the identity resides in.
+	// +immutable
+	Location string `json:"location"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A UserAssignedIdentityObservation represents the observed state of an Azure
+// user-assigned managed identity.
+type UserAssignedIdentityObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// TenantID of the identity.
+	TenantID string `json:"tenantId,omitempty"`
+
+	// PrincipalID - The object ID of the service principal that backs the
+	// identity in Azure Active Directory.
+	PrincipalID string `json:"principalId,omitempty"`
+
+	// ClientID - The application ID of the identity.
+	ClientID string `json:"clientId,omitempty"`
+}
+
+// A UserAssignedIdentitySpec defines the desired state of a
+// UserAssignedIdentity.
+type UserAssignedIdentitySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserAssignedIdentityParameters `json:"forProvider"`
+}
+
+// A UserAssignedIdentityStatus represents the observed state of a
+// UserAssignedIdentity.
+type UserAssignedIdentityStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserAssignedIdentityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UserAssignedIdentity is a managed resource that represents an Azure
+// user-assigned managed identity.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRINCIPAL-ID",type="string",JSONPath=".status.atProvider.principalId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type UserAssignedIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserAssignedIdentitySpec   `json:"spec"`
+	Status UserAssignedIdentityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserAssignedIdentityList contains a list of UserAssignedIdentity.
+type UserAssignedIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserAssignedIdentity `json:"items"`
+}
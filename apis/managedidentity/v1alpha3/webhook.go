@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/provider-azure/apis/internal/validation"
+)
+
+// +kubebuilder:webhook:verbs=update,path=/validate-managedidentity-azure-crossplane-io-v1alpha3-userassignedidentity,mutating=false,failurePolicy=fail,groups=managedidentity.azure.crossplane.io,resources=userassignedidentities,versions=v1alpha3,name=userassignedidentities.managedidentity.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// ValidateCreate validates a new UserAssignedIdentity.
+func (i *UserAssignedIdentity) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an update to a UserAssignedIdentity.
+func (i *UserAssignedIdentity) ValidateUpdate(old runtime.Object) error {
+	o, ok := old.(*UserAssignedIdentity)
+	if !ok || validation.Deleting(i) {
+		return nil
+	}
+	p, op := i.Spec.ForProvider, o.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := field.ErrorList{}
+	errs = append(errs, validation.Immutable(p.ResourceGroupName, op.ResourceGroupName, path.Child("resourceGroupName"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameRef, op.ResourceGroupNameRef, path.Child("resourceGroupNameRef"))...)
+	errs = append(errs, validation.Immutable(p.ResourceGroupNameSelector, op.ResourceGroupNameSelector, path.Child("resourceGroupNameSelector"))...)
+	errs = append(errs, validation.Immutable(p.Location, op.Location, path.Child("location"))...)
+	return validation.Invalid(UserAssignedIdentityGroupVersionKind.GroupKind(), i.GetName(), errs)
+}
+
+// ValidateDelete validates the deletion of a UserAssignedIdentity.
+func (i *UserAssignedIdentity) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AddressSpace contains an array of IP address ranges that can be used by
+// subnets of the virtual network.
+type AddressSpace struct {
+	// AddressPrefixes - A list of address blocks reserved for this virtual
+	// network in CIDR notation.
+	AddressPrefixes []string `json:"addressPrefixes"`
+}
+
+// VirtualNetworkPropertiesFormat defines properties of a VirtualNetwork.
+type VirtualNetworkPropertiesFormat struct {
+	// AddressSpace - The AddressSpace that contains an array of IP address
+	// ranges that can be used by subnets.
+	// +optional
+	AddressSpace AddressSpace `json:"addressSpace"`
+
+	// EnableDDOSProtection - Indicates if DDoS protection is enabled for all
+	// the protected resources in the virtual network. It requires a DDoS
+	// protection plan associated with the resource.
+	// +optional
+	EnableDDOSProtection bool `json:"enableDdosProtection,omitempty"`
+
+	// EnableVMProtection - Indicates if VM protection is enabled for all the
+	// subnets in the virtual network.
+	// +optional
+	EnableVMProtection bool `json:"enableVmProtection,omitempty"`
+}
+
+// A VirtualNetworkSpec defines the desired state of a VirtualNetwork.
+type VirtualNetworkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// ResourceGroupName - Name of the Virtual Network's resource group.
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the the Virtual Network's resource
+	// group.
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the the Virtual
+	// Network's resource group.
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// VirtualNetworkPropertiesFormat - Properties of the virtual network.
+	VirtualNetworkPropertiesFormat `json:"properties"`
+
+	// Location - Resource location.
+	Location string `json:"location"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A VirtualNetworkStatus represents the observed state of a VirtualNetwork.
+type VirtualNetworkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	// State of this VirtualNetwork.
+	State string `json:"state,omitempty"`
+
+	// A Message providing detail about the state of this VirtualNetwork, if
+	// any.
+	Message string `json:"message,omitempty"`
+
+	// ID of this VirtualNetwork.
+	ID string `json:"id,omitempty"`
+
+	// Etag - A unique read-only string that changes whenever the resource is
+	// updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ResourceGUID - The GUID of this VirtualNetwork.
+	ResourceGUID string `json:"resourceGuid,omitempty"`
+
+	// Type of this VirtualNetwork.
+	Type string `json:"type,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualNetwork is a managed resource that represents an Azure Virtual
+// Network.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.location"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type VirtualNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualNetworkSpec   `json:"spec"`
+	Status VirtualNetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualNetworkList contains a list of VirtualNetwork items
+type VirtualNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualNetwork `json:"items"`
+}
+
+// ServiceEndpointPropertiesFormat defines properties of a service endpoint.
+type ServiceEndpointPropertiesFormat struct {
+	// Service - The type of the endpoint service.
+	// +optional
+	Service string `json:"service,omitempty"`
+
+	// Locations - A list of locations.
+	// +optional
+	Locations []string `json:"locations,omitempty"`
+
+	// ProvisioningState - The provisioning state of the resource.
+	// +optional
+	ProvisioningState string `json:"provisioningState,omitempty"`
+}
+
+// SubnetPropertiesFormat defines properties of a Subnet.
+type SubnetPropertiesFormat struct {
+	// AddressPrefix - The address prefix for the subnet.
+	AddressPrefix string `json:"addressPrefix"`
+
+	// ServiceEndpoints - An array of service endpoints.
+	ServiceEndpoints []ServiceEndpointPropertiesFormat `json:"serviceEndpoints,omitempty"`
+}
+
+// A SubnetSpec defines the desired state of a Subnet.
+type SubnetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// VirtualNetworkName - Name of the Subnet's virtual network.
+	VirtualNetworkName string `json:"virtualNetworkName,omitempty"`
+
+	// VirtualNetworkNameRef references to a VirtualNetwork to retrieve its name
+	VirtualNetworkNameRef *xpv1.Reference `json:"virtualNetworkNameRef,omitempty"`
+
+	// VirtualNetworkNameSelector selects a reference to a VirtualNetwork to
+	// retrieve its name
+	VirtualNetworkNameSelector *xpv1.Selector `json:"virtualNetworkNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the Subnet's resource group.
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the the Subnets's resource group.
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a reference to the the Subnets's
+	// resource group.
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// SubnetPropertiesFormat - Properties of the subnet.
+	SubnetPropertiesFormat `json:"properties"`
+}
+
+// A SubnetStatus represents the observed state of a Subnet.
+type SubnetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	// State of this Subnet.
+	State string `json:"state,omitempty"`
+
+	// A Message providing detail about the state of this Subnet, if any.
+	Message string `json:"message,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this Subnet.
+	ID string `json:"id,omitempty"`
+
+	// Purpose - A string identifying the intention of use for this subnet based
+	// on delegations and other user-defined properties.
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Subnet is a managed resource that represents an Azure Subnet.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type Subnet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetSpec   `json:"spec"`
+	Status SubnetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubnetList contains a list of Subnet items
+type SubnetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subnet `json:"items"`
+}
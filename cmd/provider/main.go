@@ -17,18 +17,33 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/apimachinery/pkg/labels"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-azure/apis"
 	"github.com/crossplane/provider-azure/pkg/controller"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+	"github.com/crossplane/provider-azure/pkg/controller/shard"
+	"github.com/crossplane/provider-azure/pkg/diagnostics"
+	"github.com/crossplane/provider-azure/pkg/doctor"
+	"github.com/crossplane/provider-azure/pkg/migration"
+	"github.com/crossplane/provider-azure/pkg/webhook"
 )
 
 func main() {
@@ -37,8 +52,39 @@ func main() {
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod     = app.Flag("sync", "Controller manager sync period duration such as 300ms, 1.5h or 2h45m").Short('s').Default("1h").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		enableGroups   = app.Flag("enable-group", "Only run controllers for the supplied API group, e.g. cache or cache.azure.crossplane.io. May be repeated.").Strings()
+		shardCount     = app.Flag("shard-count", "Split managed resources between this many replicas by a hash of their names.").Default("1").Int()
+		shardIndex     = app.Flag("shard-index", "Index of the shard of managed resources this replica is responsible for, from 0 to shard-count minus 1.").Default("0").Int()
+		shardSelector  = app.Flag("shard-provider-config-selector", "Only reconcile managed resources whose ProviderConfig matches this label selector, e.g. shard=a.").String()
+		webhookCertDir = app.Flag("webhook-tls-cert-dir", "Serve admission webhooks using the tls.crt and tls.key in this directory. Webhooks are disabled if this is unset.").String()
+		webhookPort    = app.Flag("webhook-port", "Port to serve admission webhooks on.").Default("9443").Int()
+		enableKinds    = app.Flag("enable-kind", "Only run controllers for the supplied kind, e.g. Redis or Redis.cache.azure.crossplane.io. May be repeated.").Strings()
+		migrate        = app.Flag("migrate-storage-versions", "Rewrite stored managed resources at the storage version of their CRD. Requires permission to get CRDs and update their status.").Default("false").Bool()
+
+		pollInterval            = app.Flag("poll", "How often to check whether an up to date managed resource has drifted from its desired state.").Default("1m").Duration()
+		maxConcurrentReconciles = app.Flag("max-concurrent-reconciles", "Maximum number of resources each controller reconciles at once.").Default("1").Int()
+		leaseDuration           = app.Flag("leader-election-lease-duration", "How long replicas that are not leader wait before trying to become leader.").Default("15s").Duration()
+		renewDeadline           = app.Flag("leader-election-renew-deadline", "How long the leader retries renewing its lease before giving up leadership.").Default("10s").Duration()
+		retryPeriod             = app.Flag("leader-election-retry-period", "How long replicas wait between leader election attempts.").Default("2s").Duration()
+		healthProbeAddr         = app.Flag("health-probe-bind-address", "Address to serve the /healthz and /readyz probes on. Probes are disabled if this is empty.").Default(":8081").String()
+		readinessARMEndpoint    = app.Flag("readiness-arm-endpoint", "Azure Resource Manager endpoint, e.g. https://management.azure.com/, that must be reachable for the provider to be ready. Not checked if this is empty.").String()
+		pprofAddr               = app.Flag("pprof-bind-address", "Address to serve pprof endpoints on. Profiling is disabled if this is empty.").String()
+
+		startCmd = app.Command("start", "Start the provider's controllers.").Default()
+
+		validateCmd   = app.Command("validate-credentials", "Check that Azure credentials can authenticate and access their subscription.")
+		validateCreds = validateCmd.Flag("credentials-file", "File containing the JSON credentials used by a ProviderConfig.").Required().ExistingFile()
+
+		doctorCmd   = app.Command("doctor", "Check that Azure credentials have everything every controller of the provider needs, including resource provider registrations and Graph permissions.")
+		doctorCreds = doctorCmd.Flag("credentials-file", "File containing the JSON credentials used by a ProviderConfig.").Required().ExistingFile()
 	)
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+	switch kingpin.MustParse(app.Parse(os.Args[1:])) {
+	case validateCmd.FullCommand():
+		os.Exit(diagnose(*validateCreds, doctor.ValidateChecks))
+	case doctorCmd.FullCommand():
+		os.Exit(diagnose(*doctorCreds, doctor.AllChecks))
+	case startCmd.FullCommand():
+	}
 
 	zl := zap.New(zap.UseDevMode(*debug))
 	log := logging.NewLogrLogger(zl.WithName("provider-azure"))
@@ -49,20 +95,91 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
-	log.Debug("Starting", "sync-period", syncPeriod.String())
+	log.Debug("Starting", "sync-period", syncPeriod.String(), "poll-interval", pollInterval.String(), "max-concurrent-reconciles", *maxConcurrentReconciles, "enabled-groups", *enableGroups, "enabled-kinds", *enableKinds)
+
+	sc := shard.Config{Count: *shardCount, Index: *shardIndex}
+	if *shardSelector != "" {
+		sel, err := labels.Parse(*shardSelector)
+		kingpin.FatalIfError(err, "Cannot parse ProviderConfig shard selector")
+		sc.ProviderConfigSelector = sel
+	}
+	kingpin.FatalIfError(sc.Validate(), "Invalid shard configuration")
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
+	// Each shard elects its own leader, so that a replica per shard is
+	// active at any time.
+	leaderElectionID := "crossplane-leader-election-provider-azure"
+	if sc.Enabled() {
+		leaderElectionID += "-shard-" + sc.ID()
+	}
+
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-		LeaderElection:   *leaderElection,
-		LeaderElectionID: "crossplane-leader-election-provider-azure",
-		SyncPeriod:       syncPeriod,
+		LeaderElection:         *leaderElection,
+		LeaderElectionID:       leaderElectionID,
+		LeaseDuration:          leaseDuration,
+		RenewDeadline:          renewDeadline,
+		RetryPeriod:            retryPeriod,
+		SyncPeriod:             syncPeriod,
+		CertDir:                *webhookCertDir,
+		Port:                   *webhookPort,
+		HealthProbeBindAddress: *healthProbeAddr,
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
+	if *healthProbeAddr != "" {
+		kingpin.FatalIfError(mgr.AddHealthzCheck("ping", healthz.Ping), "Cannot add health check")
+		apiServer, err := diagnostics.APIServerChecker(cfg)
+		kingpin.FatalIfError(err, "Cannot create API server readiness check")
+		kingpin.FatalIfError(mgr.AddReadyzCheck("api-server", apiServer), "Cannot add API server readiness check")
+		if *readinessARMEndpoint != "" {
+			kingpin.FatalIfError(mgr.AddReadyzCheck("azure-resource-manager", diagnostics.ARMChecker(*readinessARMEndpoint, http.DefaultClient)), "Cannot add Azure Resource Manager readiness check")
+		}
+	}
+	if *pprofAddr != "" {
+		kingpin.FatalIfError(mgr.Add(diagnostics.NewProfilingServer(*pprofAddr)), "Cannot add profiling server")
+	}
+
+	var p predicate.Predicate = predicate.Funcs{}
+	var pce func(l resource.ManagedList) handler.EventHandler
+	if sc.Enabled() {
+		log.Debug("Sharding managed resources", "shard", sc.ID())
+		p = sc.Predicate(mgr.GetClient())
+		pce = func(l resource.ManagedList) handler.EventHandler { return sc.ProviderConfigHandler(mgr.GetClient(), l) }
+	}
+
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Azure APIs to scheme")
-	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewDefaultProviderRateLimiter(ratelimiter.DefaultProviderRPS)), "Cannot setup Azure controllers")
+	o := options.Options{
+		Logger:                  log,
+		GlobalRateLimiter:       ratelimiter.NewDefaultProviderRateLimiter(ratelimiter.DefaultProviderRPS),
+		Predicate:               p,
+		PollInterval:            *pollInterval,
+		MaxConcurrentReconciles: *maxConcurrentReconciles,
+		ProviderConfigEvents:    pce,
+	}
+	kingpin.FatalIfError(controller.Setup(mgr, o, controller.Filter{Groups: *enableGroups, Kinds: *enableKinds}), "Cannot setup Azure controllers")
+	if *webhookCertDir != "" {
+		kingpin.FatalIfError(webhook.Setup(mgr), "Cannot setup Azure webhooks")
+	}
+	if *migrate {
+		kingpin.FatalIfError(migration.Setup(mgr, log, migration.CustomResourceDefinitions...), "Cannot setup storage version migration")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
+}
 
+// diagnose runs the supplied checks against the credentials in the supplied
+// file, reports the results and returns an exit code.
+func diagnose(file string, checks []string) int {
+	b, err := ioutil.ReadFile(filepath.Clean(file))
+	kingpin.FatalIfError(err, "Cannot read credentials file")
+	d, err := doctor.New(b)
+	kingpin.FatalIfError(err, "Cannot parse credentials file")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	if !doctor.Report(os.Stdout, d.Run(ctx, checks...)) {
+		return 1
+	}
+	return 0
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/Azure/go-autorest/autorest/to"
@@ -43,7 +44,10 @@ const (
 	// AsyncOperationStatusInProgress is the status value for AsyncOperation type
 	// that indicates the operation is still ongoing.
 	AsyncOperationStatusInProgress = "InProgress"
-	asyncOperationPollingMethod    = "AsyncOperation"
+	// AsyncOperationStatusSucceeded is the status value for AsyncOperation
+	// type that indicates the operation has completed successfully.
+	AsyncOperationStatusSucceeded = "Succeeded"
+	asyncOperationPollingMethod   = "AsyncOperation"
 )
 
 // Error strings.
@@ -109,16 +113,7 @@ func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (con
 	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
 		return nil, nil, err
 	}
-	m := map[string]string{}
-	if err := json.Unmarshal(s.Data[ref.Key], &m); err != nil {
-		return nil, nil, errors.Wrap(err, errUnmarshalCredentialSecret)
-	}
-	cfg := auth.NewClientCredentialsConfig(m[CredentialsKeyClientID], m[CredentialsKeyClientSecret], m[CredentialsKeyTenantID])
-	cfg.AADEndpoint = m[CredentialsKeyActiveDirectoryEndpointURL]
-	cfg.Resource = m[CredentialsKeyResourceManagerEndpointURL]
-
-	a, err := cfg.Authorizer()
-	return m, a, errors.Wrap(err, errGetAuthorizer)
+	return NewAuthInfo(s.Data[ref.Key])
 }
 
 // UseProviderConfig to return the necessary information to construct an Azure
@@ -137,16 +132,41 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cannot get credentials")
 	}
+	return NewAuthInfo(data)
+}
+
+// NewAuthInfo returns the credentials and Resource Manager authorizer
+// described by the supplied JSON encoded credentials, in the format expected
+// by a ProviderConfig.
+func NewAuthInfo(credentials []byte) (content map[string]string, authorizer autorest.Authorizer, err error) {
+	m, err := UnmarshalCredentials(credentials)
+	if err != nil {
+		return nil, nil, err
+	}
+	t, err := NewServicePrincipalToken(m, m[CredentialsKeyResourceManagerEndpointURL])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGetAuthorizer)
+	}
+	return m, autorest.NewBearerAuthorizer(t), nil
+}
+
+// UnmarshalCredentials unmarshals the supplied JSON encoded credentials, in the
+// format expected by a ProviderConfig.
+func UnmarshalCredentials(credentials []byte) (map[string]string, error) {
 	m := map[string]string{}
-	if err := json.Unmarshal(data, &m); err != nil {
-		return nil, nil, errors.Wrap(err, errUnmarshalCredentialSecret)
+	if err := json.Unmarshal(credentials, &m); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalCredentialSecret)
 	}
-	cfg := auth.NewClientCredentialsConfig(m[CredentialsKeyClientID], m[CredentialsKeyClientSecret], m[CredentialsKeyTenantID])
-	cfg.AADEndpoint = m[CredentialsKeyActiveDirectoryEndpointURL]
-	cfg.Resource = m[CredentialsKeyResourceManagerEndpointURL]
+	return m, nil
+}
 
-	a, err := cfg.Authorizer()
-	return m, a, errors.Wrap(err, errGetAuthorizer)
+// NewServicePrincipalToken returns a token for the supplied resource, acquired
+// using the client credentials of the supplied credentials.
+func NewServicePrincipalToken(credentials map[string]string, resource string) (*adal.ServicePrincipalToken, error) {
+	cfg := auth.NewClientCredentialsConfig(credentials[CredentialsKeyClientID], credentials[CredentialsKeyClientSecret], credentials[CredentialsKeyTenantID])
+	cfg.AADEndpoint = credentials[CredentialsKeyActiveDirectoryEndpointURL]
+	cfg.Resource = resource
+	return cfg.ServicePrincipalToken()
 }
 
 // Client struct that represents the information needed to connect to the Azure services as a client
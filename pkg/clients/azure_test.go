@@ -57,6 +57,17 @@ func TestNewClient(t *testing.T) {
 	g.Expect(client.SubscriptionID).To(gomega.Equal("bf1b0e59-93da-42e0-82c6-5a1d94227911"))
 }
 
+func TestNewAuthInfo(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	creds, authorizer, err := NewAuthInfo([]byte(authData))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(authorizer).NotTo(gomega.BeNil())
+	g.Expect(creds[CredentialsKeySubscriptionID]).To(gomega.Equal("bf1b0e59-93da-42e0-82c6-5a1d94227911"))
+
+	_, _, err = NewAuthInfo([]byte("{"))
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
 func TestFetchAsyncOperation(t *testing.T) {
 	inprogressStatus := "inprogress"
 	inProgressResponse := fmt.Sprintf(`{"status": "%s"}`, inprogressStatus)
@@ -18,24 +18,32 @@ package compute
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
 	authorizationmgmt "github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-03-01/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
+	azureautorest "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-azure/apis/compute/v1alpha3"
+	azurev1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
@@ -49,15 +57,68 @@ const (
 	NetworkContributorRoleID = "/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7"
 
 	appCredsValidYears = 5
+
+	// powerStateAPIVersion is the first containerservice API version that
+	// supports starting and stopping managed clusters. Our containerservice
+	// SDK predates it, so we make these calls directly.
+	powerStateAPIVersion = "2020-09-01"
+
+	// privateDNSZoneAPIVersion is the first containerservice API version
+	// that supports choosing the private DNS zone of a private cluster. Our
+	// containerservice SDK predates it, so clusters that choose one are
+	// created and updated directly.
+	privateDNSZoneAPIVersion = "2020-11-01"
+
+	// observationAPIVersion is the containerservice API version at which we
+	// observe managed clusters. Our containerservice SDK predates their power
+	// state, OIDC issuer and upgrade settings, so we get clusters directly
+	// and decode both the SDK's ManagedCluster and the state it predates from
+	// the same response. It must support upgradeSettingsAPIVersion's fields.
+	observationAPIVersion = "2023-06-01"
+
+	// upgradeSettingsAPIVersion is the first containerservice API version
+	// that supports node OS upgrade channels, as well as the auto upgrade
+	// channels, maintenance configurations and agent pool upgrade settings
+	// that our SDK predates.
+	upgradeSettingsAPIVersion = "2023-06-01"
+
+	managedClusterPath           = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ContainerService/managedClusters/{resourceName}"
+	maintenanceConfigurationPath = managedClusterPath + "/maintenanceConfigurations/default"
+	agentPoolPath                = managedClusterPath + "/agentPools/" + AgentPoolProfileName
 )
 
 // An AKSClient can create, read, and delete AKS clusters and the various other
 // resources they require.
 type AKSClient interface {
-	GetManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error)
+	ObserveManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, ExtendedObservation, error)
 	EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
+	UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error
 	DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error
 	GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	GetMaintenanceWindow(ctx context.Context, ac *v1alpha3.AKSCluster) (*v1alpha3.MaintenanceWindow, error)
+	StartManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error
+	StopManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error
+	RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
+	RemoveStaleServicePrincipalSecrets(ctx context.Context, ac *v1alpha3.AKSCluster) error
+	GetRESTClient() autorest.Sender
+}
+
+// ExtendedObservation is the observed state of an AKS cluster that our
+// containerservice SDK predates. Its upgrade settings do not include the
+// maintenance window, which is a separate resource.
+type ExtendedObservation struct {
+	PowerState      string
+	OIDCIssuerURL   string
+	UpgradeSettings UpgradeSettings
+}
+
+// UpgradeSettings are the settings that govern how an AKS cluster is upgraded.
+// Our containerservice SDK predates them.
+type UpgradeSettings struct {
+	MaintenanceWindow    *v1alpha3.MaintenanceWindow
+	NodeMaxSurge         *string
+	NodeOSUpgradeChannel *string
+	AutoUpgradeChannel   *string
 }
 
 // An AggregateClient aggregates the various clients used by the AKS controller.
@@ -120,39 +181,113 @@ func (c AggregateClient) GetManagedCluster(ctx context.Context, ac *v1alpha3.AKS
 // EnsureManagedCluster ensures the supplied AKS cluster exists, including
 // ensuring any required service principals and role assignments exist.
 func (c AggregateClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error {
-	app, err := c.ensureApplication(ctx, meta.GetExternalName(ac), secret)
+	pc, err := newPasswordCredential(secret)
 	if err != nil {
 		return err
 	}
 
-	sp, err := c.ensureServicePrincipal(ctx, to.String(app.AppID))
+	app, err := c.ensureApplication(ctx, ac, pc)
 	if err != nil {
 		return err
 	}
 
-	if err := c.ensureRoleAssignment(ctx, to.String(sp.ObjectID), NetworkContributorRoleID, ac.Spec.VnetSubnetID); err != nil {
+	sp, err := c.ensureServicePrincipal(ctx, ac, to.String(app.AppID))
+	if err != nil {
+		return err
+	}
+
+	if err := c.ensureRoleAssignment(ctx, ac, to.String(sp.ObjectID), NetworkContributorRoleID, ac.Spec.VnetSubnetID); err != nil {
+		return err
+	}
+
+	if err := c.createOrUpdateManagedCluster(ctx, ac, newManagedCluster(ac, to.String(app.AppID), secret)); err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	ac.Status.ServicePrincipalSecret = v1alpha3.ServicePrincipalSecretObservation{
+		KeyID:            to.String(pc.KeyID),
+		LastRotationTime: &now,
+	}
+	return nil
+}
+
+// UpdateManagedCluster updates the fields of the supplied AKS cluster that can
+// be changed in place.
+func (c AggregateClient) UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	mc, err := c.GetManagedCluster(ctx, ac)
+	if err != nil {
 		return err
 	}
+	now := time.Now()
+	if IsManagedClusterUpToDate(ac, mc) && !IsUpgradeDue(ac, mc, now) {
+		// AKS only allows one operation on a cluster at a time, so we only
+		// update its upgrade settings once everything else is up to date.
+		return c.updateUpgradeSettings(ctx, ac)
+	}
+	updateManagedCluster(ac, &mc, now)
+	return c.createOrUpdateManagedCluster(ctx, ac, mc)
+}
 
-	mc := newManagedCluster(ac, to.String(app.AppID), secret)
-	_, err = c.ManagedClusters.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), mc)
-	return err
+// createOrUpdateManagedCluster creates or updates the supplied managed
+// cluster. Clusters that choose a private DNS zone are put directly, because
+// our containerservice SDK predates private DNS zones.
+func (c AggregateClient) createOrUpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, mc containerservice.ManagedCluster) error {
+	if ac.Spec.PrivateDNSZone == nil {
+		_, err := c.ManagedClusters.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), mc)
+		return err
+	}
+	body, err := newManagedClusterJSON(ac, mc)
+	if err != nil {
+		return err
+	}
+	return c.putJSON(ctx, ac, privateDNSZoneAPIVersion, managedClusterPath, body)
 }
 
-// DeleteManagedCluster deletes the supplied AKS cluster, including its service
-// principals and any role assignments.
+// DeleteManagedCluster deletes the supplied AKS cluster, including the
+// application, service principal, and role assignment that were created for
+// it.
 func (c AggregateClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
-	if err := c.deleteApplication(ctx, meta.GetExternalName(ac)); err != nil {
+	if err := c.observeServicePrincipalIDs(ctx, ac); err != nil {
 		return err
 	}
-	_, err := c.ManagedClusters.Delete(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
-	return err
+	if _, err := c.ManagedClusters.Delete(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac)); resource.Ignore(azure.IsNotFound, err) != nil {
+		return err
+	}
+
+	s := &ac.Status
+	if s.RoleAssignmentID != "" {
+		if _, err := c.RoleAssignments.DeleteByID(ctx, s.RoleAssignmentID); resource.Ignore(azure.IsNotFound, err) != nil {
+			return err
+		}
+		s.RoleAssignmentID = ""
+	}
+	if s.ServicePrincipalObjectID != "" {
+		if _, err := c.ServicePrincipals.Delete(ctx, s.ServicePrincipalObjectID); resource.Ignore(azure.IsNotFound, err) != nil {
+			return err
+		}
+		s.ServicePrincipalObjectID = ""
+	}
+	if s.ApplicationObjectID != "" {
+		if _, err := c.Applications.Delete(ctx, s.ApplicationObjectID); resource.Ignore(azure.IsNotFound, err) != nil {
+			return err
+		}
+		s.ApplicationObjectID = ""
+	}
+	return nil
 }
 
 // GetKubeConfig produces a kubeconfig file that configures access to the
 // supplied AKS cluster.
 func (c AggregateClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error) {
-	creds, err := c.ManagedClusters.ListClusterAdminCredentials(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
+	list := c.ManagedClusters.ListClusterAdminCredentials
+	switch ac.Spec.KubeconfigCredentialType {
+	case v1alpha3.KubeconfigCredentialTypeUser:
+		list = c.ManagedClusters.ListClusterUserCredentials
+	case v1alpha3.KubeconfigCredentialTypeMonitoring:
+		list = c.ManagedClusters.ListClusterMonitoringUserCredentials
+	}
+	creds, err := list(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
 	if err != nil {
 		return nil, err
 	}
@@ -168,144 +303,958 @@ func (c AggregateClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSClus
 	return *((*creds.Kubeconfigs)[0].Value), nil
 }
 
-func (c AggregateClient) ensureApplication(ctx context.Context, name, secret string) (graphrbac.Application, error) {
+// GetRESTClient returns the underlying REST client that the managed clusters
+// client uses.
+func (c AggregateClient) GetRESTClient() autorest.Sender {
+	return c.ManagedClusters.Client
+}
+
+// ObserveManagedCluster returns the requested Azure managed cluster, along
+// with its current power state, OIDC issuer URL and upgrade settings. The
+// cluster is only fetched once.
+func (c AggregateClient) ObserveManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, ExtendedObservation, error) {
+	body := json.RawMessage{}
+	if err := c.getJSON(ctx, ac, observationAPIVersion, managedClusterPath, &body); err != nil {
+		return containerservice.ManagedCluster{}, ExtendedObservation{}, err
+	}
+	mc := containerservice.ManagedCluster{}
+	if err := json.Unmarshal(body, &mc); err != nil {
+		return containerservice.ManagedCluster{}, ExtendedObservation{}, err
+	}
+	eo := struct {
+		Properties struct {
+			PowerState struct {
+				Code string `json:"code"`
+			} `json:"powerState"`
+			OIDCIssuerProfile struct {
+				IssuerURL string `json:"issuerURL"`
+			} `json:"oidcIssuerProfile"`
+			AutoUpgradeProfile struct {
+				NodeOSUpgradeChannel *string `json:"nodeOSUpgradeChannel"`
+				UpgradeChannel       *string `json:"upgradeChannel"`
+			} `json:"autoUpgradeProfile"`
+			AgentPoolProfiles []struct {
+				Name            string `json:"name"`
+				UpgradeSettings struct {
+					MaxSurge *string `json:"maxSurge"`
+				} `json:"upgradeSettings"`
+			} `json:"agentPoolProfiles"`
+		} `json:"properties"`
+	}{}
+	if err := json.Unmarshal(body, &eo); err != nil {
+		return containerservice.ManagedCluster{}, ExtendedObservation{}, err
+	}
+	o := ExtendedObservation{
+		PowerState:    eo.Properties.PowerState.Code,
+		OIDCIssuerURL: eo.Properties.OIDCIssuerProfile.IssuerURL,
+		UpgradeSettings: UpgradeSettings{
+			NodeOSUpgradeChannel: eo.Properties.AutoUpgradeProfile.NodeOSUpgradeChannel,
+			AutoUpgradeChannel:   eo.Properties.AutoUpgradeProfile.UpgradeChannel,
+		},
+	}
+	for _, ap := range eo.Properties.AgentPoolProfiles {
+		if ap.Name == AgentPoolProfileName {
+			o.UpgradeSettings.NodeMaxSurge = ap.UpgradeSettings.MaxSurge
+		}
+	}
+	return mc, o, nil
+}
+
+// GetMaintenanceWindow returns the maintenance window of the supplied AKS
+// cluster, or nil if it has none. It is only observed if the cluster's spec
+// specifies one, to avoid needlessly calling Azure.
+func (c AggregateClient) GetMaintenanceWindow(ctx context.Context, ac *v1alpha3.AKSCluster) (*v1alpha3.MaintenanceWindow, error) {
+	if ac.Spec.MaintenanceWindow == nil {
+		return nil, nil
+	}
+	mcfg := struct {
+		Properties v1alpha3.MaintenanceWindow `json:"properties"`
+	}{}
+	err := c.getJSON(ctx, ac, upgradeSettingsAPIVersion, maintenanceConfigurationPath, &mcfg)
+	if azure.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &mcfg.Properties, nil
+}
+
+// updateUpgradeSettings updates at most one of the supplied AKS cluster's
+// upgrade settings that is not up to date, because each update is an
+// operation on the cluster.
+func (c AggregateClient) updateUpgradeSettings(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	_, eo, err := c.ObserveManagedCluster(ctx, ac)
+	if err != nil {
+		return err
+	}
+	us := eo.UpgradeSettings
+	if us.MaintenanceWindow, err = c.GetMaintenanceWindow(ctx, ac); err != nil {
+		return err
+	}
+
+	if w := ac.Spec.MaintenanceWindow; w != nil && !isMaintenanceWindowUpToDate(w, us.MaintenanceWindow) {
+		return c.putJSON(ctx, ac, upgradeSettingsAPIVersion, maintenanceConfigurationPath, map[string]interface{}{"properties": w})
+	}
+
+	if !isAutoUpgradeProfileUpToDate(ac, us) {
+		mc := map[string]interface{}{}
+		if err := c.getJSON(ctx, ac, upgradeSettingsAPIVersion, managedClusterPath, &mc); err != nil {
+			return err
+		}
+		if ch := ac.Spec.NodeOSUpgradeChannel; ch != nil {
+			if err := unstructured.SetNestedField(mc, to.String(ch), "properties", "autoUpgradeProfile", "nodeOSUpgradeChannel"); err != nil {
+				return err
+			}
+		}
+		if ch := ac.Spec.AutoUpgradeChannel; ch != nil {
+			if err := unstructured.SetNestedField(mc, to.String(ch), "properties", "autoUpgradeProfile", "upgradeChannel"); err != nil {
+				return err
+			}
+		}
+		return c.putJSON(ctx, ac, upgradeSettingsAPIVersion, managedClusterPath, mc)
+	}
+
+	if ms := ac.Spec.NodeMaxSurge; ms != nil && to.String(ms) != to.String(us.NodeMaxSurge) {
+		ap := map[string]interface{}{}
+		if err := c.getJSON(ctx, ac, upgradeSettingsAPIVersion, agentPoolPath, &ap); err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(ap, to.String(ms), "properties", "upgradeSettings", "maxSurge"); err != nil {
+			return err
+		}
+		return c.putJSON(ctx, ac, upgradeSettingsAPIVersion, agentPoolPath, ap)
+	}
+
+	return nil
+}
+
+// getJSON gets the managed cluster subresource at the supplied path using the
+// supplied API version, and decodes it into v.
+func (c AggregateClient) getJSON(ctx context.Context, ac *v1alpha3.AKSCluster, apiVersion, path string, v interface{}) error {
+	req, err := c.newManagedClusterRequest(ctx, apiVersion, autorest.AsGet(), autorest.WithPathParameters(path, c.managedClusterPathParameters(ac)))
+	if err != nil {
+		return err
+	}
+	resp, err := c.ManagedClusters.Send(req, autorest.DoRetryForStatusCodes(c.ManagedClusters.RetryAttempts, c.ManagedClusters.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return err
+	}
+	return autorest.Respond(resp,
+		c.ManagedClusters.ByInspecting(),
+		azureautorest.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(v),
+		autorest.ByClosing())
+}
+
+// putJSON puts v to the managed cluster subresource at the supplied path using
+// the supplied API version.
+func (c AggregateClient) putJSON(ctx context.Context, ac *v1alpha3.AKSCluster, apiVersion, path string, v interface{}) error {
+	req, err := c.newManagedClusterRequest(ctx, apiVersion,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithPathParameters(path, c.managedClusterPathParameters(ac)),
+		autorest.WithJSON(v))
+	if err != nil {
+		return err
+	}
+	resp, err := c.ManagedClusters.Send(req, azureautorest.DoRetryWithRegistration(c.ManagedClusters.Client))
+	if err != nil {
+		return err
+	}
+	return autorest.Respond(resp,
+		c.ManagedClusters.ByInspecting(),
+		azureautorest.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+}
+
+// StartManagedCluster starts the supplied stopped AKS cluster, and records the
+// start operation in its status.
+func (c AggregateClient) StartManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	return c.postPowerStateAction(ctx, ac, "start")
+}
+
+// StopManagedCluster stops the supplied running AKS cluster, and records the
+// stop operation in its status.
+func (c AggregateClient) StopManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	return c.postPowerStateAction(ctx, ac, "stop")
+}
+
+func (c AggregateClient) postPowerStateAction(ctx context.Context, ac *v1alpha3.AKSCluster, action string) error {
+	req, err := c.newManagedClusterRequest(ctx, powerStateAPIVersion, autorest.AsPost(), autorest.WithPathParameters(managedClusterPath+"/"+action, c.managedClusterPathParameters(ac)))
+	if err != nil {
+		return err
+	}
+	resp, err := c.ManagedClusters.Send(req, azureautorest.DoRetryWithRegistration(c.ManagedClusters.Client))
+	if err != nil {
+		return err
+	}
+	op, err := azureautorest.NewFutureFromResponse(resp)
+	if err != nil {
+		return err
+	}
+	ac.Status.LastOperation = azurev1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPost,
+	}
+	return nil
+}
+
+// RotateServicePrincipalSecret adds the supplied secret to the service
+// principal of the supplied AKS cluster, and starts an operation to switch the
+// cluster to it. The secrets the cluster used previously are recorded as stale
+// in its status.
+func (c AggregateClient) RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error {
+	mc, err := c.GetManagedCluster(ctx, ac)
+	if err != nil {
+		return err
+	}
+	if mc.ManagedClusterProperties == nil || mc.ServicePrincipalProfile == nil {
+		return errors.New("managed cluster has no service principal profile")
+	}
+	clientID := to.String(mc.ServicePrincipalProfile.ClientID)
+
+	app, err := c.getApplication(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	existing, err := c.listPasswordCredentials(ctx, to.String(app.ObjectID))
+	if err != nil {
+		return err
+	}
+
+	s := ac.Status.ServicePrincipalSecret
+	stale := s.StaleKeyIDs
+	if s.KeyID != "" {
+		stale = append(stale, s.KeyID)
+	} else {
+		// We don't know which secret the cluster uses, so we presume it uses
+		// one of the secrets that exist today.
+		for _, pc := range existing {
+			stale = append(stale, to.String(pc.KeyID))
+		}
+	}
+
 	pc, err := newPasswordCredential(secret)
 	if err != nil {
-		return graphrbac.Application{}, err
+		return err
+	}
+	creds := append(existing, pc)
+	p := graphrbac.PasswordCredentialsUpdateParameters{Value: &creds}
+	if _, err := c.Applications.UpdatePasswordCredentials(ctx, to.String(app.ObjectID), p); err != nil {
+		return err
 	}
 
-	filter := fmt.Sprintf("displayName eq '%s'", name)
-	for l, err := c.Applications.ListComplete(ctx, filter); l.NotDone(); err = l.NextWithContext(ctx) {
-		if err != nil {
-			return graphrbac.Application{}, err
+	sp := containerservice.ManagedClusterServicePrincipalProfile{ClientID: to.StringPtr(clientID), Secret: to.StringPtr(secret)}
+	op, err := c.ManagedClusters.ResetServicePrincipalProfile(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), sp)
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	ac.Status.ServicePrincipalSecret = v1alpha3.ServicePrincipalSecretObservation{
+		KeyID:            to.String(pc.KeyID),
+		LastRotationTime: &now,
+		StaleKeyIDs:      stale,
+		LastRotation: azurev1alpha3.AsyncOperation{
+			PollingURL: op.PollingURL(),
+			Method:     http.MethodPost,
+		},
+	}
+	return nil
+}
+
+// RemoveStaleServicePrincipalSecrets removes the secrets the supplied AKS
+// cluster's status records as stale from its service principal.
+func (c AggregateClient) RemoveStaleServicePrincipalSecrets(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	mc, err := c.GetManagedCluster(ctx, ac)
+	if err != nil {
+		return err
+	}
+	if mc.ManagedClusterProperties == nil || mc.ServicePrincipalProfile == nil {
+		return errors.New("managed cluster has no service principal profile")
+	}
+
+	app, err := c.getApplication(ctx, to.String(mc.ServicePrincipalProfile.ClientID))
+	if err != nil {
+		return err
+	}
+	existing, err := c.listPasswordCredentials(ctx, to.String(app.ObjectID))
+	if err != nil {
+		return err
+	}
+
+	s := ac.Status.ServicePrincipalSecret
+	stale := map[string]bool{}
+	for _, id := range s.StaleKeyIDs {
+		stale[id] = id != s.KeyID
+	}
+	keep := make([]graphrbac.PasswordCredential, 0, len(existing))
+	for _, pc := range existing {
+		if !stale[to.String(pc.KeyID)] {
+			keep = append(keep, pc)
 		}
-		p := graphrbac.PasswordCredentialsUpdateParameters{Value: &[]graphrbac.PasswordCredential{pc}}
-		if _, err := c.Applications.UpdatePasswordCredentials(ctx, to.String(l.Value().ObjectID), p); err != nil {
-			return graphrbac.Application{}, err
+	}
+	if len(keep) != len(existing) {
+		p := graphrbac.PasswordCredentialsUpdateParameters{Value: &keep}
+		if _, err := c.Applications.UpdatePasswordCredentials(ctx, to.String(app.ObjectID), p); err != nil {
+			return err
 		}
+	}
 
-		// We really do want to stop here if we found an app with our desired
-		// display name. We presume it's one we created earlier.
-		return l.Value(), nil // nolint:staticcheck
+	ac.Status.ServicePrincipalSecret.StaleKeyIDs = nil
+	return nil
+}
+
+func (c AggregateClient) newManagedClusterRequest(ctx context.Context, apiVersion string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	// The query parameters must be added after the path, which is resolved
+	// relative to the URL built so far.
+	decorators = append([]autorest.PrepareDecorator{autorest.WithBaseURL(c.ManagedClusters.BaseURI)}, decorators...)
+	decorators = append(decorators, autorest.WithQueryParameters(map[string]interface{}{"api-version": apiVersion}))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (c AggregateClient) managedClusterPathParameters(ac *v1alpha3.AKSCluster) map[string]interface{} {
+	return map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", ac.Spec.ResourceGroupName),
+		"resourceName":      autorest.Encode("path", meta.GetExternalName(ac)),
+		"subscriptionId":    autorest.Encode("path", c.ManagedClusters.SubscriptionID),
+	}
+}
+
+// ensureApplication ensures the application for the supplied AKS cluster's
+// service principal exists and has the supplied password credential. It never
+// adopts an existing application that it did not record in the cluster's
+// status.
+func (c AggregateClient) ensureApplication(ctx context.Context, ac *v1alpha3.AKSCluster, pc graphrbac.PasswordCredential) (graphrbac.Application, error) {
+	if id := ac.Status.ApplicationObjectID; id != "" {
+		app, err := c.Applications.Get(ctx, id)
+		if resource.Ignore(azure.IsNotFound, err) != nil {
+			return graphrbac.Application{}, err
+		}
+		if err == nil {
+			p := graphrbac.PasswordCredentialsUpdateParameters{Value: &[]graphrbac.PasswordCredential{pc}}
+			_, err := c.Applications.UpdatePasswordCredentials(ctx, id, p)
+			return app, err
+		}
 	}
 
+	name := meta.GetExternalName(ac)
 	url := fmt.Sprintf("https://%s.aks.crossplane.io", name)
 	p := graphrbac.ApplicationCreateParameters{
 		AvailableToOtherTenants: to.BoolPtr(false),
 		DisplayName:             to.StringPtr(name),
 		Homepage:                to.StringPtr(url),
-		IdentifierUris:          &[]string{url},
-		PasswordCredentials:     &[]graphrbac.PasswordCredential{pc},
+		// Identifier URIs must be unique within a tenant, so we qualify ours
+		// with the UID of the AKSCluster to avoid colliding with applications
+		// created for other clusters of the same name.
+		IdentifierUris:      &[]string{fmt.Sprintf("%s/%s", url, ac.GetUID())},
+		PasswordCredentials: &[]graphrbac.PasswordCredential{pc},
 	}
+	app, err := c.Applications.Create(ctx, p)
 	if err != nil {
 		return graphrbac.Application{}, err
 	}
+	ac.Status.ApplicationObjectID = to.String(app.ObjectID)
+	return app, nil
+}
+
+func (c AggregateClient) getApplication(ctx context.Context, appID string) (graphrbac.Application, error) {
+	filter := fmt.Sprintf("appId eq '%s'", appID)
+	for l, err := c.Applications.ListComplete(ctx, filter); l.NotDone(); err = l.NextWithContext(ctx) {
+		if err != nil {
+			return graphrbac.Application{}, err
+		}
+		return l.Value(), nil // nolint:staticcheck
+	}
+	return graphrbac.Application{}, errors.Errorf("cannot find application with app ID %s", appID)
+}
 
-	return c.Applications.Create(ctx, p)
+func (c AggregateClient) listPasswordCredentials(ctx context.Context, objectID string) ([]graphrbac.PasswordCredential, error) {
+	r, err := c.Applications.ListPasswordCredentials(ctx, objectID)
+	if err != nil || r.Value == nil {
+		return nil, err
+	}
+	return *r.Value, nil
 }
 
-func (c AggregateClient) ensureServicePrincipal(ctx context.Context, appID string) (graphrbac.ServicePrincipal, error) {
-	r, err := c.Applications.GetServicePrincipalsIDByAppID(ctx, appID)
+func (c AggregateClient) ensureServicePrincipal(ctx context.Context, ac *v1alpha3.AKSCluster, appID string) (graphrbac.ServicePrincipal, error) {
+	if id := ac.Status.ServicePrincipalObjectID; id != "" {
+		sp, err := c.ServicePrincipals.Get(ctx, id)
+		if !azure.IsNotFound(err) {
+			return sp, err
+		}
+	}
+
+	// The application is ours, so any service principal for it is too.
+	sp, err := c.getServicePrincipal(ctx, appID)
 	if azure.IsNotFound(err) {
-		// Create it.
 		p := graphrbac.ServicePrincipalCreateParameters{AppID: to.StringPtr(appID), AccountEnabled: to.BoolPtr(true)}
-		return c.ServicePrincipals.Create(ctx, p)
+		sp, err = c.ServicePrincipals.Create(ctx, p)
 	}
 	if err != nil {
 		return graphrbac.ServicePrincipal{}, err
 	}
+	ac.Status.ServicePrincipalObjectID = to.String(sp.ObjectID)
+	return sp, nil
+}
 
+func (c AggregateClient) getServicePrincipal(ctx context.Context, appID string) (graphrbac.ServicePrincipal, error) {
+	r, err := c.Applications.GetServicePrincipalsIDByAppID(ctx, appID)
+	if err != nil {
+		return graphrbac.ServicePrincipal{}, err
+	}
 	return c.ServicePrincipals.Get(ctx, to.String(r.Value))
 }
 
-func (c AggregateClient) ensureRoleAssignment(ctx context.Context, principalID, roleID, scope string) error {
+func (c AggregateClient) ensureRoleAssignment(ctx context.Context, ac *v1alpha3.AKSCluster, principalID, roleID, scope string) error {
 	// If scope was the empty string we probably needed a role assignment for
 	// an optional scope, for example a subnetwork.
 	if scope == "" {
 		return nil
 	}
 
+	if id := ac.Status.RoleAssignmentID; id != "" {
+		_, err := c.RoleAssignments.GetByID(ctx, id)
+		if !azure.IsNotFound(err) {
+			return err
+		}
+	}
+
+	ra, err := c.getRoleAssignment(ctx, principalID, scope)
+	if err != nil {
+		return err
+	}
+	if ra != nil {
+		ac.Status.RoleAssignmentID = to.String(ra.ID)
+		return nil
+	}
+
 	name, err := uuid.NewRandom()
 	if err != nil {
 		return err
 	}
+	p := authorizationmgmt.RoleAssignmentCreateParameters{Properties: &authorizationmgmt.RoleAssignmentProperties{
+		RoleDefinitionID: azure.ToStringPtr(fmt.Sprintf("/subscriptions/%s%s", c.RoleAssignments.SubscriptionID, roleID)),
+		PrincipalID:      azure.ToStringPtr(principalID),
+	}}
+	created, err := c.RoleAssignments.Create(ctx, scope, name.String(), p)
+	if err != nil {
+		return err
+	}
+	ac.Status.RoleAssignmentID = to.String(created.ID)
+	return nil
+}
 
+// getRoleAssignment returns the supplied principal's role assignment for the
+// supplied scope, or nil if it has none.
+func (c AggregateClient) getRoleAssignment(ctx context.Context, principalID, scope string) (*authorization.RoleAssignment, error) {
 	filter := fmt.Sprintf("principalId eq '%s'", principalID)
 	for l, err := c.RoleAssignments.ListForScopeComplete(ctx, scope, filter); l.NotDone(); err = l.NextWithContext(ctx) {
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// We really do want to stop here if our principal already has a role
 		// definition for this scope; we presume it's one we created earlier.
-		return nil // nolint:staticcheck
+		ra := l.Value()
+		return &ra, nil // nolint:staticcheck
 	}
-
-	p := authorizationmgmt.RoleAssignmentCreateParameters{Properties: &authorizationmgmt.RoleAssignmentProperties{
-		RoleDefinitionID: azure.ToStringPtr(fmt.Sprintf("/subscriptions/%s%s", c.RoleAssignments.SubscriptionID, roleID)),
-		PrincipalID:      azure.ToStringPtr(principalID),
-	}}
-	_, err = c.RoleAssignments.Create(ctx, scope, name.String(), p)
-	return err
+	return nil, nil
 }
 
-func (c AggregateClient) deleteApplication(ctx context.Context, name string) error {
-	filter := fmt.Sprintf("displayName eq '%s'", name)
-	for l, err := c.Applications.ListComplete(ctx, filter); l.NotDone(); err = l.NextWithContext(ctx) {
+// observeServicePrincipalIDs records the IDs of the application, service
+// principal, and role assignment used by the supplied AKS cluster in its
+// status, if they are not recorded already. Clusters created before these IDs
+// were recorded are identified by the client ID of their service principal
+// profile, never by display name.
+func (c AggregateClient) observeServicePrincipalIDs(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	s := &ac.Status
+	if s.ApplicationObjectID != "" && s.ServicePrincipalObjectID != "" && (s.RoleAssignmentID != "" || ac.Spec.VnetSubnetID == "") {
+		return nil
+	}
+
+	mc, err := c.GetManagedCluster(ctx, ac)
+	if azure.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if mc.ManagedClusterProperties == nil || mc.ServicePrincipalProfile == nil {
+		return nil
+	}
+	appID := to.String(mc.ServicePrincipalProfile.ClientID)
+
+	if s.ApplicationObjectID == "" {
+		app, err := c.getApplication(ctx, appID)
 		if err != nil {
 			return err
 		}
-
-		// We really do want to delete the first matching application we find.
-		_, err := c.Applications.Delete(ctx, to.String(l.Value().ObjectID))
-		return resource.Ignore(azure.IsNotFound, err) // nolint:staticcheck
+		s.ApplicationObjectID = to.String(app.ObjectID)
+	}
+	if s.ServicePrincipalObjectID == "" {
+		sp, err := c.getServicePrincipal(ctx, appID)
+		if resource.Ignore(azure.IsNotFound, err) != nil {
+			return err
+		}
+		s.ServicePrincipalObjectID = to.String(sp.ObjectID)
+	}
+	if s.RoleAssignmentID == "" && ac.Spec.VnetSubnetID != "" && s.ServicePrincipalObjectID != "" {
+		ra, err := c.getRoleAssignment(ctx, s.ServicePrincipalObjectID, ac.Spec.VnetSubnetID)
+		if err != nil {
+			return err
+		}
+		if ra != nil {
+			s.RoleAssignmentID = to.String(ra.ID)
+		}
 	}
-
 	return nil
 }
 
 func newManagedCluster(c *v1alpha3.AKSCluster, appID, secret string) containerservice.ManagedCluster {
-	nodeCount := int32(v1alpha3.DefaultNodeCount)
-	if c.Spec.NodeCount != nil {
-		nodeCount = int32(*c.Spec.NodeCount)
-	}
-
 	p := containerservice.ManagedCluster{
 		Name:     to.StringPtr(meta.GetExternalName(c)),
 		Location: to.StringPtr(c.Spec.Location),
 		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
 			KubernetesVersion: to.StringPtr(c.Spec.Version),
 			DNSPrefix:         to.StringPtr(c.Spec.DNSNamePrefix),
-			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
-				{
-					Name:   to.StringPtr(AgentPoolProfileName),
-					Count:  &nodeCount,
-					VMSize: containerservice.VMSizeTypes(c.Spec.NodeVMSize),
-				},
-			},
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{newAgentPoolProfile(c)},
 			ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
 				ClientID: to.StringPtr(appID),
 				Secret:   to.StringPtr(secret),
 			},
-			EnableRBAC: to.BoolPtr(!c.Spec.DisableRBAC),
+			EnableRBAC:             to.BoolPtr(!c.Spec.DisableRBAC),
+			APIServerAccessProfile: newAPIServerAccessProfile(c),
+			AutoScalerProfile:      newAutoScalerProfile(c.Spec.AutoScalerProfile),
+			AadProfile:             newAADProfile(c.Spec.AADProfile),
 		},
 	}
 
 	if c.Spec.VnetSubnetID != "" {
-		p.ManagedClusterProperties.NetworkProfile = &containerservice.NetworkProfile{NetworkPlugin: containerservice.Azure}
-		p.ManagedClusterProperties.AgentPoolProfiles = &[]containerservice.ManagedClusterAgentPoolProfile{
-			{
-				Name:         to.StringPtr(AgentPoolProfileName),
-				Count:        &nodeCount,
-				VMSize:       containerservice.VMSizeTypes(c.Spec.NodeVMSize),
-				VnetSubnetID: to.StringPtr(c.Spec.VnetSubnetID),
-			},
+		p.ManagedClusterProperties.NetworkProfile = &containerservice.NetworkProfileType{NetworkPlugin: containerservice.Azure}
+	}
+
+	return p
+}
+
+func newAgentPoolProfile(c *v1alpha3.AKSCluster) containerservice.ManagedClusterAgentPoolProfile {
+	nodeCount := int32(v1alpha3.DefaultNodeCount)
+	if c.Spec.NodeCount != nil {
+		nodeCount = int32(*c.Spec.NodeCount)
+	}
+
+	p := containerservice.ManagedClusterAgentPoolProfile{
+		Name:         to.StringPtr(AgentPoolProfileName),
+		Count:        &nodeCount,
+		VMSize:       containerservice.VMSizeTypes(c.Spec.NodeVMSize),
+		VnetSubnetID: azure.ToStringPtr(c.Spec.VnetSubnetID),
+	}
+
+	if c.Spec.EnableAutoScaling {
+		p.Type = containerservice.VirtualMachineScaleSets
+		p.EnableAutoScaling = to.BoolPtr(true)
+		p.MinCount = azure.ToInt32(c.Spec.MinNodeCount)
+		p.MaxCount = azure.ToInt32(c.Spec.MaxNodeCount)
+	}
+
+	return p
+}
+
+func newAPIServerAccessProfile(c *v1alpha3.AKSCluster) *containerservice.ManagedClusterAPIServerAccessProfile {
+	if !c.Spec.EnablePrivateCluster && len(c.Spec.APIServerAuthorizedIPRanges) == 0 {
+		return nil
+	}
+	return &containerservice.ManagedClusterAPIServerAccessProfile{
+		EnablePrivateCluster: azure.ToBoolPtr(c.Spec.EnablePrivateCluster),
+		AuthorizedIPRanges:   azure.ToStringArrayPtr(c.Spec.APIServerAuthorizedIPRanges),
+	}
+}
+
+// newManagedClusterJSON returns the JSON representation of the supplied
+// managed cluster, including the fields of the supplied AKS cluster that our
+// containerservice SDK predates.
+func newManagedClusterJSON(c *v1alpha3.AKSCluster, mc containerservice.ManagedCluster) (map[string]interface{}, error) {
+	b, err := json.Marshal(mc)
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		return nil, err
+	}
+	if c.Spec.PrivateDNSZone != nil {
+		if err := unstructured.SetNestedField(body, to.String(c.Spec.PrivateDNSZone), "properties", "apiServerAccessProfile", "privateDNSZone"); err != nil {
+			return nil, err
+		}
+	}
+	return body, nil
+}
+
+func newAADProfile(p *v1alpha3.AADProfile) *containerservice.ManagedClusterAADProfile {
+	if p == nil {
+		return nil
+	}
+	return &containerservice.ManagedClusterAADProfile{
+		Managed:             to.BoolPtr(true),
+		AdminGroupObjectIDs: azure.ToStringArrayPtr(p.AdminGroupObjectIDs),
+		TenantID:            p.TenantID,
+	}
+}
+
+func newAutoScalerProfile(p *v1alpha3.AutoScalerProfile) *containerservice.ManagedClusterPropertiesAutoScalerProfile {
+	if p == nil {
+		return nil
+	}
+	return &containerservice.ManagedClusterPropertiesAutoScalerProfile{
+		BalanceSimilarNodeGroups:      p.BalanceSimilarNodeGroups,
+		ScanInterval:                  p.ScanInterval,
+		ScaleDownDelayAfterAdd:        p.ScaleDownDelayAfterAdd,
+		ScaleDownDelayAfterDelete:     p.ScaleDownDelayAfterDelete,
+		ScaleDownDelayAfterFailure:    p.ScaleDownDelayAfterFailure,
+		ScaleDownUnneededTime:         p.ScaleDownUnneededTime,
+		ScaleDownUnreadyTime:          p.ScaleDownUnreadyTime,
+		ScaleDownUtilizationThreshold: p.ScaleDownUtilizationThreshold,
+		MaxGracefulTerminationSec:     p.MaxGracefulTerminationSec,
+	}
+}
+
+// defaultAgentPoolProfile returns the agent pool profile of the supplied
+// managed cluster that was created for the default node pool, if any.
+func defaultAgentPoolProfile(mc containerservice.ManagedCluster) *containerservice.ManagedClusterAgentPoolProfile {
+	if mc.ManagedClusterProperties == nil || mc.AgentPoolProfiles == nil {
+		return nil
+	}
+	for i := range *mc.AgentPoolProfiles {
+		if to.String((*mc.AgentPoolProfiles)[i].Name) == AgentPoolProfileName {
+			return &(*mc.AgentPoolProfiles)[i]
 		}
 	}
+	return nil
+}
+
+// updateManagedCluster sets the fields of the supplied observed managed
+// cluster that can be updated in place to their desired values.
+func updateManagedCluster(c *v1alpha3.AKSCluster, mc *containerservice.ManagedCluster, now time.Time) {
+	if mc.ManagedClusterProperties == nil {
+		mc.ManagedClusterProperties = &containerservice.ManagedClusterProperties{}
+	}
+	upgrade := IsUpgradeDue(c, *mc, now)
+	if upgrade {
+		mc.KubernetesVersion = to.StringPtr(c.Spec.Version)
+	}
+	if mc.APIServerAccessProfile == nil {
+		mc.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{}
+	}
+	// An empty list, rather than a nil one, is required to remove all
+	// authorized IP ranges.
+	ranges := make([]string, len(c.Spec.APIServerAuthorizedIPRanges))
+	copy(ranges, c.Spec.APIServerAuthorizedIPRanges)
+	mc.APIServerAccessProfile.AuthorizedIPRanges = &ranges
 
+	if c.Spec.AutoScalerProfile != nil {
+		mc.AutoScalerProfile = mergeAutoScalerProfile(c.Spec.AutoScalerProfile, mc.AutoScalerProfile)
+	}
+
+	// AAD integration can be enabled on existing clusters, but not disabled.
+	if c.Spec.AADProfile != nil {
+		p := newAADProfile(c.Spec.AADProfile)
+		if p.TenantID == nil && mc.AadProfile != nil {
+			p.TenantID = mc.AadProfile.TenantID
+		}
+		mc.AadProfile = p
+	}
+
+	ap := defaultAgentPoolProfile(*mc)
+	if ap == nil {
+		return
+	}
+	if upgrade {
+		ap.OrchestratorVersion = to.StringPtr(c.Spec.Version)
+	}
+	ap.EnableAutoScaling = to.BoolPtr(c.Spec.EnableAutoScaling)
+	if c.Spec.EnableAutoScaling {
+		ap.MinCount = azure.ToInt32(c.Spec.MinNodeCount)
+		ap.MaxCount = azure.ToInt32(c.Spec.MaxNodeCount)
+		return
+	}
+	ap.MinCount = nil
+	ap.MaxCount = nil
+	if c.Spec.NodeCount != nil {
+		ap.Count = azure.ToInt32(c.Spec.NodeCount)
+	}
+}
+
+// mergeAutoScalerProfile overrides the fields of the observed autoscaler
+// profile that are set in the desired one.
+func mergeAutoScalerProfile(want *v1alpha3.AutoScalerProfile, got *containerservice.ManagedClusterPropertiesAutoScalerProfile) *containerservice.ManagedClusterPropertiesAutoScalerProfile {
+	p := newAutoScalerProfile(want)
+	if got == nil {
+		return p
+	}
+	p.BalanceSimilarNodeGroups = azure.LateInitializeStringPtrFromPtr(p.BalanceSimilarNodeGroups, got.BalanceSimilarNodeGroups)
+	p.ScanInterval = azure.LateInitializeStringPtrFromPtr(p.ScanInterval, got.ScanInterval)
+	p.ScaleDownDelayAfterAdd = azure.LateInitializeStringPtrFromPtr(p.ScaleDownDelayAfterAdd, got.ScaleDownDelayAfterAdd)
+	p.ScaleDownDelayAfterDelete = azure.LateInitializeStringPtrFromPtr(p.ScaleDownDelayAfterDelete, got.ScaleDownDelayAfterDelete)
+	p.ScaleDownDelayAfterFailure = azure.LateInitializeStringPtrFromPtr(p.ScaleDownDelayAfterFailure, got.ScaleDownDelayAfterFailure)
+	p.ScaleDownUnneededTime = azure.LateInitializeStringPtrFromPtr(p.ScaleDownUnneededTime, got.ScaleDownUnneededTime)
+	p.ScaleDownUnreadyTime = azure.LateInitializeStringPtrFromPtr(p.ScaleDownUnreadyTime, got.ScaleDownUnreadyTime)
+	p.ScaleDownUtilizationThreshold = azure.LateInitializeStringPtrFromPtr(p.ScaleDownUtilizationThreshold, got.ScaleDownUtilizationThreshold)
+	p.MaxGracefulTerminationSec = azure.LateInitializeStringPtrFromPtr(p.MaxGracefulTerminationSec, got.MaxGracefulTerminationSec)
 	return p
 }
 
+// IsManagedClusterUpToDate returns true if the fields of the supplied AKS
+// cluster that can be updated in place match the supplied managed cluster.
+func IsManagedClusterUpToDate(c *v1alpha3.AKSCluster, mc containerservice.ManagedCluster) bool {
+	if mc.ManagedClusterProperties == nil {
+		return false
+	}
+
+	var ranges []string
+	if mc.APIServerAccessProfile != nil && mc.APIServerAccessProfile.AuthorizedIPRanges != nil {
+		ranges = *mc.APIServerAccessProfile.AuthorizedIPRanges
+	}
+	if !equalStringSets(c.Spec.APIServerAuthorizedIPRanges, ranges) {
+		return false
+	}
+
+	// The observed autoscaler profile is fully populated with defaults, so we
+	// only compare the fields we specified.
+	if c.Spec.AutoScalerProfile != nil && !reflect.DeepEqual(mergeAutoScalerProfile(c.Spec.AutoScalerProfile, mc.AutoScalerProfile), mc.AutoScalerProfile) {
+		return false
+	}
+
+	if !isAADProfileUpToDate(c.Spec.AADProfile, mc.AadProfile) {
+		return false
+	}
+
+	return isAgentPoolProfileUpToDate(c, defaultAgentPoolProfile(mc))
+}
+
+// IsUpgradeDue returns true if the supplied AKS cluster's version differs from
+// that of the supplied managed cluster, and the cluster may be upgraded at the
+// supplied time. Clusters that AKS upgrades automatically are never due.
+func IsUpgradeDue(c *v1alpha3.AKSCluster, mc containerservice.ManagedCluster, now time.Time) bool {
+	if mc.ManagedClusterProperties == nil || c.Spec.Version == "" || c.Spec.Version == to.String(mc.KubernetesVersion) {
+		return false
+	}
+	if ch := c.Spec.AutoUpgradeChannel; ch != nil && *ch != v1alpha3.AutoUpgradeChannelNone {
+		return false
+	}
+	return InMaintenanceWindow(c.Spec.MaintenanceWindow, now)
+}
+
+// InMaintenanceWindow returns true if the supplied time falls within the
+// supplied maintenance window. Any time falls within a nil window.
+func InMaintenanceWindow(w *v1alpha3.MaintenanceWindow, now time.Time) bool {
+	if w == nil {
+		return true
+	}
+	for _, ts := range w.NotAllowedTime {
+		if !now.Before(ts.Start.Time) && now.Before(ts.End.Time) {
+			return false
+		}
+	}
+	if len(w.TimeInWeek) == 0 {
+		return true
+	}
+	now = now.UTC()
+	for _, tw := range w.TimeInWeek {
+		if tw.Day != now.Weekday().String() {
+			continue
+		}
+		for _, h := range tw.HourSlots {
+			if int(h) == now.Hour() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsUpgradeSettingsUpToDate returns true if the supplied upgrade settings
+// match those of the supplied AKS cluster.
+func IsUpgradeSettingsUpToDate(c *v1alpha3.AKSCluster, us UpgradeSettings) bool {
+	if c.Spec.MaintenanceWindow != nil && !isMaintenanceWindowUpToDate(c.Spec.MaintenanceWindow, us.MaintenanceWindow) {
+		return false
+	}
+	if !isAutoUpgradeProfileUpToDate(c, us) {
+		return false
+	}
+	return c.Spec.NodeMaxSurge == nil || to.String(c.Spec.NodeMaxSurge) == to.String(us.NodeMaxSurge)
+}
+
+func isAutoUpgradeProfileUpToDate(c *v1alpha3.AKSCluster, us UpgradeSettings) bool {
+	if c.Spec.NodeOSUpgradeChannel != nil && to.String(c.Spec.NodeOSUpgradeChannel) != to.String(us.NodeOSUpgradeChannel) {
+		return false
+	}
+	return c.Spec.AutoUpgradeChannel == nil || to.String(c.Spec.AutoUpgradeChannel) == to.String(us.AutoUpgradeChannel)
+}
+
+func isMaintenanceWindowUpToDate(want, got *v1alpha3.MaintenanceWindow) bool {
+	if got == nil {
+		return false
+	}
+	if len(want.TimeInWeek) != len(got.TimeInWeek) || len(want.NotAllowedTime) != len(got.NotAllowedTime) {
+		return false
+	}
+	for i := range want.TimeInWeek {
+		if want.TimeInWeek[i].Day != got.TimeInWeek[i].Day || !reflect.DeepEqual(want.TimeInWeek[i].HourSlots, got.TimeInWeek[i].HourSlots) {
+			return false
+		}
+	}
+	for i := range want.NotAllowedTime {
+		if !want.NotAllowedTime[i].Start.Equal(&got.NotAllowedTime[i].Start) || !want.NotAllowedTime[i].End.Equal(&got.NotAllowedTime[i].End) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAADProfileUpToDate(p *v1alpha3.AADProfile, ap *containerservice.ManagedClusterAADProfile) bool {
+	if p == nil {
+		return true
+	}
+	if ap == nil || !to.Bool(ap.Managed) {
+		return false
+	}
+	var groups []string
+	if ap.AdminGroupObjectIDs != nil {
+		groups = *ap.AdminGroupObjectIDs
+	}
+	if !equalStringSets(p.AdminGroupObjectIDs, groups) {
+		return false
+	}
+	// The observed tenant ID defaults to the subscription's tenant.
+	return p.TenantID == nil || to.String(p.TenantID) == to.String(ap.TenantID)
+}
+
+func isAgentPoolProfileUpToDate(c *v1alpha3.AKSCluster, ap *containerservice.ManagedClusterAgentPoolProfile) bool {
+	if ap == nil {
+		return true
+	}
+	if c.Spec.EnableAutoScaling != to.Bool(ap.EnableAutoScaling) {
+		return false
+	}
+	if c.Spec.EnableAutoScaling {
+		// The cluster autoscaler owns the node count, so we don't consider
+		// it to be drift.
+		return reflect.DeepEqual(azure.ToInt32(c.Spec.MinNodeCount), ap.MinCount) &&
+			reflect.DeepEqual(azure.ToInt32(c.Spec.MaxNodeCount), ap.MaxCount)
+	}
+	return c.Spec.NodeCount == nil || int32(*c.Spec.NodeCount) == to.Int32(ap.Count)
+}
+
+// UpdateObservation updates the supplied AKS cluster status with the observed
+// state of the supplied managed cluster.
+func UpdateObservation(s *v1alpha3.AKSClusterStatus, mc containerservice.ManagedCluster) {
+	s.ProviderID = to.String(mc.ID)
+	if mc.ManagedClusterProperties == nil {
+		return
+	}
+	s.State = to.String(mc.ProvisioningState)
+	s.KubernetesVersion = to.String(mc.KubernetesVersion)
+	s.NodeResourceGroup = to.String(mc.NodeResourceGroup)
+	s.FQDN = to.String(mc.Fqdn)
+	s.PrivateFQDN = to.String(mc.PrivateFQDN)
+	s.Endpoint = s.FQDN
+	if IsPrivateCluster(mc) {
+		s.Endpoint = s.PrivateFQDN
+	}
+	s.AgentPoolProfiles = nil
+	if mc.AgentPoolProfiles == nil {
+		return
+	}
+	s.AgentPoolProfiles = make([]v1alpha3.AgentPoolProfileObservation, len(*mc.AgentPoolProfiles))
+	for i, ap := range *mc.AgentPoolProfiles {
+		s.AgentPoolProfiles[i] = v1alpha3.AgentPoolProfileObservation{
+			Name:                to.String(ap.Name),
+			Count:               azure.ToInt(ap.Count),
+			VMSize:              string(ap.VMSize),
+			OrchestratorVersion: to.String(ap.OrchestratorVersion),
+			ProvisioningState:   to.String(ap.ProvisioningState),
+		}
+	}
+}
+
+// LateInitialize fills the spec values that user did not fill with their
+// corresponding value in the Azure, if there is any.
+func LateInitialize(p *v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) {
+	if mc.ManagedClusterProperties == nil {
+		return
+	}
+	if p.DNSNamePrefix == "" {
+		p.DNSNamePrefix = to.String(mc.DNSPrefix)
+	}
+	ap := defaultAgentPoolProfile(mc)
+	if ap == nil {
+		return
+	}
+	if p.NodeVMSize == "" {
+		p.NodeVMSize = string(ap.VMSize)
+	}
+	p.NodeCount = azure.LateInitializeIntPtrFromInt32Ptr(p.NodeCount, ap.Count)
+}
+
+// UpdateServicePrincipalSecretObservation updates the supplied AKS cluster's
+// status with when its service principal secret is next due to be rotated.
+// Secrets that were never rotated by the controller are due immediately.
+func UpdateServicePrincipalSecretObservation(ac *v1alpha3.AKSCluster, now time.Time) {
+	s := &ac.Status.ServicePrincipalSecret
+	s.NextRotationTime = nil
+	if ac.Spec.ServicePrincipalSecretRotationInterval == nil {
+		return
+	}
+	next := metav1.NewTime(now)
+	if s.LastRotationTime != nil {
+		next = metav1.NewTime(s.LastRotationTime.Add(ac.Spec.ServicePrincipalSecretRotationInterval.Duration))
+	}
+	s.NextRotationTime = &next
+}
+
+// IsServicePrincipalSecretRotationDue returns true if the supplied AKS
+// cluster's service principal secret should be rotated at the supplied time.
+func IsServicePrincipalSecretRotationDue(ac *v1alpha3.AKSCluster, now time.Time) bool {
+	next := ac.Status.ServicePrincipalSecret.NextRotationTime
+	return next != nil && !now.Before(next.Time)
+}
+
+// HasStaleServicePrincipalSecrets returns true if the supplied AKS cluster has
+// switched to a new service principal secret, and the secrets it used
+// previously can be removed.
+func HasStaleServicePrincipalSecrets(ac *v1alpha3.AKSCluster) bool {
+	s := ac.Status.ServicePrincipalSecret
+	return len(s.StaleKeyIDs) > 0 && s.LastRotation.Status == azure.AsyncOperationStatusSucceeded
+}
+
+// IsPrivateCluster returns true if the supplied managed cluster exposes its API
+// server only via a private endpoint.
+func IsPrivateCluster(mc containerservice.ManagedCluster) bool {
+	if mc.ManagedClusterProperties == nil || mc.APIServerAccessProfile == nil {
+		return false
+	}
+	return to.Bool(mc.APIServerAccessProfile.EnablePrivateCluster)
+}
+
+func equalStringSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := make([]string, len(a))
+	copy(sa, a)
+	sort.Strings(sa)
+	sb := make([]string, len(b))
+	copy(sb, b)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func newPasswordCredential(secret string) (graphrbac.PasswordCredential, error) {
 	keyID, err := uuid.NewRandom()
 	return graphrbac.PasswordCredential{
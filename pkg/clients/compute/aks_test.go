@@ -0,0 +1,517 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-03-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-azure/apis/compute/v1alpha3"
+)
+
+var (
+	nodeCount    = 3
+	minNodeCount = 1
+	maxNodeCount = 5
+	ipRanges     = []string{"10.0.0.0/16", "192.168.0.0/24"}
+	adminGroups  = []string{"cool-group"}
+)
+
+func TestIsManagedClusterUpToDate(t *testing.T) {
+	cases := []struct {
+		name string
+		spec v1alpha3.AKSClusterParameters
+		mc   containerservice.ManagedCluster
+		want bool
+	}{
+		{
+			name: "NoProperties",
+			mc:   containerservice.ManagedCluster{},
+			want: false,
+		},
+		{
+			name: "AuthorizedIPRangesInDifferentOrder",
+			spec: v1alpha3.AKSClusterParameters{
+				APIServerAuthorizedIPRanges: ipRanges,
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					APIServerAccessProfile: &containerservice.ManagedClusterAPIServerAccessProfile{
+						AuthorizedIPRanges: &[]string{ipRanges[1], ipRanges[0]},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "AuthorizedIPRangesChanged",
+			spec: v1alpha3.AKSClusterParameters{
+				APIServerAuthorizedIPRanges: ipRanges[:1],
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					APIServerAccessProfile: &containerservice.ManagedClusterAPIServerAccessProfile{
+						AuthorizedIPRanges: &ipRanges,
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "NodeCountChanged",
+			spec: v1alpha3.AKSClusterParameters{
+				NodeCount: &nodeCount,
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{{
+						Name:  to.StringPtr(AgentPoolProfileName),
+						Count: to.Int32Ptr(int32(nodeCount + 1)),
+					}},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "NodeCountChangedByAutoscaler",
+			spec: v1alpha3.AKSClusterParameters{
+				NodeCount:         &nodeCount,
+				EnableAutoScaling: true,
+				MinNodeCount:      &minNodeCount,
+				MaxNodeCount:      &maxNodeCount,
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{{
+						Name:              to.StringPtr(AgentPoolProfileName),
+						Count:             to.Int32Ptr(int32(nodeCount + 1)),
+						EnableAutoScaling: to.BoolPtr(true),
+						MinCount:          to.Int32Ptr(int32(minNodeCount)),
+						MaxCount:          to.Int32Ptr(int32(maxNodeCount)),
+					}},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "MaxNodeCountChanged",
+			spec: v1alpha3.AKSClusterParameters{
+				EnableAutoScaling: true,
+				MinNodeCount:      &minNodeCount,
+				MaxNodeCount:      &maxNodeCount,
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{{
+						Name:              to.StringPtr(AgentPoolProfileName),
+						EnableAutoScaling: to.BoolPtr(true),
+						MinCount:          to.Int32Ptr(int32(minNodeCount)),
+						MaxCount:          to.Int32Ptr(int32(maxNodeCount + 1)),
+					}},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "AutoScalerProfileDefaultsIgnored",
+			spec: v1alpha3.AKSClusterParameters{
+				AutoScalerProfile: &v1alpha3.AutoScalerProfile{
+					ScanInterval: to.StringPtr("20s"),
+				},
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					AutoScalerProfile: &containerservice.ManagedClusterPropertiesAutoScalerProfile{
+						ScanInterval:           to.StringPtr("20s"),
+						ScaleDownDelayAfterAdd: to.StringPtr("10m"),
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "AutoScalerProfileChanged",
+			spec: v1alpha3.AKSClusterParameters{
+				AutoScalerProfile: &v1alpha3.AutoScalerProfile{
+					ScanInterval: to.StringPtr("20s"),
+				},
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					AutoScalerProfile: &containerservice.ManagedClusterPropertiesAutoScalerProfile{
+						ScanInterval: to.StringPtr("10s"),
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "AADNotEnabled",
+			spec: v1alpha3.AKSClusterParameters{
+				AADProfile: &v1alpha3.AADProfile{AdminGroupObjectIDs: adminGroups},
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{},
+			},
+			want: false,
+		},
+		{
+			name: "AADDefaultTenantIgnored",
+			spec: v1alpha3.AKSClusterParameters{
+				AADProfile: &v1alpha3.AADProfile{AdminGroupObjectIDs: adminGroups},
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					AadProfile: &containerservice.ManagedClusterAADProfile{
+						Managed:             to.BoolPtr(true),
+						AdminGroupObjectIDs: &adminGroups,
+						TenantID:            to.StringPtr("cool-tenant"),
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "AADAdminGroupsChanged",
+			spec: v1alpha3.AKSClusterParameters{
+				AADProfile: &v1alpha3.AADProfile{AdminGroupObjectIDs: adminGroups},
+			},
+			mc: containerservice.ManagedCluster{
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					AadProfile: &containerservice.ManagedClusterAADProfile{
+						Managed: to.BoolPtr(true),
+					},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ac := &v1alpha3.AKSCluster{Spec: v1alpha3.AKSClusterSpec{AKSClusterParameters: tc.spec}}
+			got := IsManagedClusterUpToDate(ac, tc.mc)
+			if got != tc.want {
+				t.Errorf("IsManagedClusterUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewManagedClusterJSON(t *testing.T) {
+	cases := []struct {
+		name string
+		spec v1alpha3.AKSClusterParameters
+		want interface{}
+	}{
+		{
+			name: "NoPrivateDNSZone",
+			spec: v1alpha3.AKSClusterParameters{EnablePrivateCluster: true},
+			want: map[string]interface{}{"enablePrivateCluster": true},
+		},
+		{
+			name: "PrivateDNSZone",
+			spec: v1alpha3.AKSClusterParameters{EnablePrivateCluster: true, PrivateDNSZone: to.StringPtr("None")},
+			want: map[string]interface{}{"enablePrivateCluster": true, "privateDNSZone": "None"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &v1alpha3.AKSCluster{Spec: v1alpha3.AKSClusterSpec{AKSClusterParameters: tc.spec}}
+			got, err := newManagedClusterJSON(c, newManagedCluster(c, "app", "secret"))
+			if err != nil {
+				t.Fatalf("newManagedClusterJSON(...): %s", err)
+			}
+			p, _, _ := unstructured.NestedFieldNoCopy(got, "properties", "apiServerAccessProfile")
+			if diff := cmp.Diff(tc.want, p); diff != "" {
+				t.Errorf("newManagedClusterJSON(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsServicePrincipalSecretRotationDue(t *testing.T) {
+	now := time.Now()
+	interval := &metav1.Duration{Duration: 24 * time.Hour}
+	lastRotated := func(ago time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(-ago))
+		return &t
+	}
+
+	cases := []struct {
+		name   string
+		spec   v1alpha3.AKSClusterParameters
+		status v1alpha3.ServicePrincipalSecretObservation
+		want   bool
+	}{
+		{
+			name:   "NoRotationInterval",
+			status: v1alpha3.ServicePrincipalSecretObservation{LastRotationTime: lastRotated(48 * time.Hour)},
+			want:   false,
+		},
+		{
+			name: "NeverRotated",
+			spec: v1alpha3.AKSClusterParameters{ServicePrincipalSecretRotationInterval: interval},
+			want: true,
+		},
+		{
+			name:   "NotYetDue",
+			spec:   v1alpha3.AKSClusterParameters{ServicePrincipalSecretRotationInterval: interval},
+			status: v1alpha3.ServicePrincipalSecretObservation{LastRotationTime: lastRotated(time.Hour)},
+			want:   false,
+		},
+		{
+			name:   "Due",
+			spec:   v1alpha3.AKSClusterParameters{ServicePrincipalSecretRotationInterval: interval},
+			status: v1alpha3.ServicePrincipalSecretObservation{LastRotationTime: lastRotated(48 * time.Hour)},
+			want:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ac := &v1alpha3.AKSCluster{
+				Spec:   v1alpha3.AKSClusterSpec{AKSClusterParameters: tc.spec},
+				Status: v1alpha3.AKSClusterStatus{ServicePrincipalSecret: tc.status},
+			}
+			UpdateServicePrincipalSecretObservation(ac, now)
+			got := IsServicePrincipalSecretRotationDue(ac, now)
+			if got != tc.want {
+				t.Errorf("IsServicePrincipalSecretRotationDue(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsUpgradeDue(t *testing.T) {
+	now := time.Now()
+	mc := containerservice.ManagedCluster{
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{KubernetesVersion: to.StringPtr("1.20.5")},
+	}
+
+	cases := []struct {
+		name string
+		spec v1alpha3.AKSClusterParameters
+		want bool
+	}{
+		{
+			name: "SameVersion",
+			spec: v1alpha3.AKSClusterParameters{Version: "1.20.5"},
+			want: false,
+		},
+		{
+			name: "NewVersion",
+			spec: v1alpha3.AKSClusterParameters{Version: "1.21.1"},
+			want: true,
+		},
+		{
+			name: "AutoUpgradeDisabled",
+			spec: v1alpha3.AKSClusterParameters{Version: "1.21.1", AutoUpgradeChannel: to.StringPtr(v1alpha3.AutoUpgradeChannelNone)},
+			want: true,
+		},
+		{
+			name: "AutoUpgradeEnabled",
+			spec: v1alpha3.AKSClusterParameters{Version: "1.19.9", AutoUpgradeChannel: to.StringPtr("stable")},
+			want: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &v1alpha3.AKSCluster{Spec: v1alpha3.AKSClusterSpec{AKSClusterParameters: tc.spec}}
+			got := IsUpgradeDue(c, mc, now)
+			if got != tc.want {
+				t.Errorf("IsUpgradeDue(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetMaintenanceWindow(t *testing.T) {
+	cases := []struct {
+		name  string
+		spec  v1alpha3.AKSClusterParameters
+		paths []string
+	}{
+		{
+			name: "NoMaintenanceWindow",
+		},
+		{
+			name:  "MaintenanceWindow",
+			spec:  v1alpha3.AKSClusterParameters{MaintenanceWindow: &v1alpha3.MaintenanceWindow{}},
+			paths: []string{"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/cool/maintenanceConfigurations/default"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var paths []string
+			mcc := containerservice.NewManagedClustersClient("sub")
+			mcc.Sender = autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+				paths = append(paths, req.URL.Path)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader("{}")),
+					Request:    req,
+				}, nil
+			})
+			c := AggregateClient{ManagedClusters: mcc}
+
+			tc.spec.ResourceGroupName = "rg"
+			ac := &v1alpha3.AKSCluster{Spec: v1alpha3.AKSClusterSpec{AKSClusterParameters: tc.spec}}
+			meta.SetExternalName(ac, "cool")
+			if _, err := c.GetMaintenanceWindow(context.Background(), ac); err != nil {
+				t.Fatalf("GetMaintenanceWindow(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.paths, paths); diff != "" {
+				t.Errorf("GetMaintenanceWindow(...): -want paths, +got paths:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveManagedCluster(t *testing.T) {
+	var queries []string
+	mcc := containerservice.NewManagedClustersClient("sub")
+	mcc.Sender = autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		queries = append(queries, req.URL.Path+"?"+req.URL.RawQuery)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: ioutil.NopCloser(strings.NewReader(`{"properties": {
+				"dnsPrefix": "cool",
+				"powerState": {"code": "Running"},
+				"oidcIssuerProfile": {"issuerURL": "https://oidc.example.org/cool/"},
+				"autoUpgradeProfile": {"nodeOSUpgradeChannel": "NodeImage", "upgradeChannel": "stable"},
+				"agentPoolProfiles": [{"name": "other"}, {"name": "agentpool", "upgradeSettings": {"maxSurge": "33%"}}]
+			}}`)),
+			Request: req,
+		}, nil
+	})
+	c := AggregateClient{ManagedClusters: mcc}
+
+	ac := &v1alpha3.AKSCluster{Spec: v1alpha3.AKSClusterSpec{AKSClusterParameters: v1alpha3.AKSClusterParameters{ResourceGroupName: "rg"}}}
+	meta.SetExternalName(ac, "cool")
+	mc, eo, err := c.ObserveManagedCluster(context.Background(), ac)
+	if err != nil {
+		t.Fatalf("ObserveManagedCluster(...): %s", err)
+	}
+
+	wantQueries := []string{"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/cool?api-version=" + observationAPIVersion}
+	if diff := cmp.Diff(wantQueries, queries); diff != "" {
+		t.Errorf("ObserveManagedCluster(...): -want requests, +got requests:\n%s", diff)
+	}
+	if diff := cmp.Diff("cool", to.String(mc.DNSPrefix)); diff != "" {
+		t.Errorf("ObserveManagedCluster(...): -want DNS prefix, +got DNS prefix:\n%s", diff)
+	}
+	wantEO := ExtendedObservation{
+		PowerState:    "Running",
+		OIDCIssuerURL: "https://oidc.example.org/cool/",
+		UpgradeSettings: UpgradeSettings{
+			NodeMaxSurge:         to.StringPtr("33%"),
+			NodeOSUpgradeChannel: to.StringPtr("NodeImage"),
+			AutoUpgradeChannel:   to.StringPtr("stable"),
+		},
+	}
+	if diff := cmp.Diff(wantEO, eo); diff != "" {
+		t.Errorf("ObserveManagedCluster(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestNewManagedClusterRequest(t *testing.T) {
+	c := AggregateClient{ManagedClusters: containerservice.NewManagedClustersClient("sub")}
+	ac := &v1alpha3.AKSCluster{Spec: v1alpha3.AKSClusterSpec{AKSClusterParameters: v1alpha3.AKSClusterParameters{ResourceGroupName: "rg"}}}
+	meta.SetExternalName(ac, "cool")
+
+	req, err := c.newManagedClusterRequest(context.Background(), "2020-09-01", autorest.AsGet(), autorest.WithPathParameters(managedClusterPath, c.managedClusterPathParameters(ac)))
+	if err != nil {
+		t.Fatalf("newManagedClusterRequest(...): %s", err)
+	}
+	want := "https://management.azure.com/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/cool?api-version=2020-09-01"
+	if diff := cmp.Diff(want, req.URL.String()); diff != "" {
+		t.Errorf("newManagedClusterRequest(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	// A Monday.
+	now := time.Date(2021, time.March, 1, 2, 30, 0, 0, time.UTC)
+	span := func(start, end time.Duration) v1alpha3.TimeSpan {
+		return v1alpha3.TimeSpan{Start: metav1.NewTime(now.Add(start)), End: metav1.NewTime(now.Add(end))}
+	}
+
+	cases := []struct {
+		name string
+		w    *v1alpha3.MaintenanceWindow
+		want bool
+	}{
+		{
+			name: "NoWindow",
+			want: true,
+		},
+		{
+			name: "InHourSlot",
+			w:    &v1alpha3.MaintenanceWindow{TimeInWeek: []v1alpha3.TimeInWeek{{Day: "Monday", HourSlots: []int32{1, 2}}}},
+			want: true,
+		},
+		{
+			name: "OutsideHourSlot",
+			w:    &v1alpha3.MaintenanceWindow{TimeInWeek: []v1alpha3.TimeInWeek{{Day: "Monday", HourSlots: []int32{3}}}},
+			want: false,
+		},
+		{
+			name: "OtherDay",
+			w:    &v1alpha3.MaintenanceWindow{TimeInWeek: []v1alpha3.TimeInWeek{{Day: "Sunday", HourSlots: []int32{2}}}},
+			want: false,
+		},
+		{
+			name: "NotAllowed",
+			w: &v1alpha3.MaintenanceWindow{
+				TimeInWeek:     []v1alpha3.TimeInWeek{{Day: "Monday", HourSlots: []int32{2}}},
+				NotAllowedTime: []v1alpha3.TimeSpan{span(-time.Hour, time.Hour)},
+			},
+			want: false,
+		},
+		{
+			name: "NotAllowedEnded",
+			w:    &v1alpha3.MaintenanceWindow{NotAllowedTime: []v1alpha3.TimeSpan{span(-2*time.Hour, -time.Hour)}},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := InMaintenanceWindow(tc.w, now)
+			if got != tc.want {
+				t.Errorf("InMaintenanceWindow(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
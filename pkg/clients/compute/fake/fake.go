@@ -19,22 +19,32 @@ package fake
 import (
 	"context"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-03-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
 
 	"github.com/crossplane/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane/provider-azure/pkg/clients/compute"
 )
 
 // AKSClient is a fake AKS client.
 type AKSClient struct {
-	MockGetManagedCluster    func(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error)
-	MockEnsureManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
-	MockDeleteManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster) error
-	MockGetKubeConfig        func(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	MockObserveManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error)
+	MockEnsureManagedCluster  func(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
+	MockUpdateManagedCluster  func(ctx context.Context, ac *v1alpha3.AKSCluster) error
+	MockDeleteManagedCluster  func(ctx context.Context, ac *v1alpha3.AKSCluster) error
+	MockGetKubeConfig         func(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	MockStartManagedCluster   func(ctx context.Context, ac *v1alpha3.AKSCluster) error
+	MockStopManagedCluster    func(ctx context.Context, ac *v1alpha3.AKSCluster) error
+	MockGetRESTClient         func() autorest.Sender
+	MockGetMaintenanceWindow  func(ctx context.Context, ac *v1alpha3.AKSCluster) (*v1alpha3.MaintenanceWindow, error)
+
+	MockRotateServicePrincipalSecret       func(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
+	MockRemoveStaleServicePrincipalSecrets func(ctx context.Context, ac *v1alpha3.AKSCluster) error
 }
 
-// GetManagedCluster calls MockGetManagedCluster.
-func (c AKSClient) GetManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
-	return c.MockGetManagedCluster(ctx, ac)
+// ObserveManagedCluster calls MockObserveManagedCluster.
+func (c AKSClient) ObserveManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
+	return c.MockObserveManagedCluster(ctx, ac)
 }
 
 // EnsureManagedCluster calls MockEnsureManagedCluster.
@@ -42,6 +52,11 @@ func (c AKSClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSClu
 	return c.MockEnsureManagedCluster(ctx, ac, secret)
 }
 
+// UpdateManagedCluster calls MockUpdateManagedCluster.
+func (c AKSClient) UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	return c.MockUpdateManagedCluster(ctx, ac)
+}
+
 // DeleteManagedCluster calls DeleteManagedCluster.
 func (c AKSClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
 	return c.MockDeleteManagedCluster(ctx, ac)
@@ -51,3 +66,34 @@ func (c AKSClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSClu
 func (c AKSClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error) {
 	return c.MockGetKubeConfig(ctx, ac)
 }
+
+// GetMaintenanceWindow calls MockGetMaintenanceWindow.
+func (c AKSClient) GetMaintenanceWindow(ctx context.Context, ac *v1alpha3.AKSCluster) (*v1alpha3.MaintenanceWindow, error) {
+	return c.MockGetMaintenanceWindow(ctx, ac)
+}
+
+// StartManagedCluster calls MockStartManagedCluster.
+func (c AKSClient) StartManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	return c.MockStartManagedCluster(ctx, ac)
+}
+
+// StopManagedCluster calls MockStopManagedCluster.
+func (c AKSClient) StopManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	return c.MockStopManagedCluster(ctx, ac)
+}
+
+// RotateServicePrincipalSecret calls MockRotateServicePrincipalSecret.
+func (c AKSClient) RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error {
+	return c.MockRotateServicePrincipalSecret(ctx, ac, secret)
+}
+
+// RemoveStaleServicePrincipalSecrets calls
+// MockRemoveStaleServicePrincipalSecrets.
+func (c AKSClient) RemoveStaleServicePrincipalSecrets(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	return c.MockRemoveStaleServicePrincipalSecrets(ctx, ac)
+}
+
+// GetRESTClient calls MockGetRESTClient.
+func (c AKSClient) GetRESTClient() autorest.Sender {
+	return c.MockGetRESTClient()
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package arm is a minimal client for Azure Resource Manager APIs that the
+// version of azure-sdk-for-go used by this provider does not cover. It follows
+// the conventions of the SDK's generated clients, so that the clients built on
+// it can be replaced by the SDK's once it is updated.
+package arm
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	xpazure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// DefaultBaseURI is the default URI used for Azure Resource Manager.
+const DefaultBaseURI = "https://management.azure.com"
+
+// A BaseClient sends requests for the resources of a single Azure Resource
+// Manager API version.
+type BaseClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+	APIVersion     string
+}
+
+// New creates a BaseClient for the supplied subscription and API version.
+func New(subscriptionID, apiVersion string) BaseClient {
+	return BaseClient{
+		Client:         autorest.NewClientWithUserAgent(xpazure.UserAgent),
+		BaseURI:        DefaultBaseURI,
+		SubscriptionID: subscriptionID,
+		APIVersion:     apiVersion,
+	}
+}
+
+// Get the resource at the supplied path into result. The path may contain the
+// {subscriptionId} parameter and any of the supplied path parameters.
+func (c BaseClient) Get(ctx context.Context, path string, pathParameters map[string]interface{}, result interface{}) error {
+	req, err := c.prepare(ctx, path, pathParameters, autorest.AsGet())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "arm.BaseClient", "Get", nil, "Failure preparing request")
+	}
+	resp, err := c.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "arm.BaseClient", "Get", resp, "Failure sending request")
+	}
+	err = autorest.Respond(
+		resp,
+		c.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "arm.BaseClient", "Get", resp, "Failure responding to request")
+	}
+	return nil
+}
+
+// Put the supplied resource at the supplied path, returning the long running
+// operation that creates or updates it.
+func (c BaseClient) Put(ctx context.Context, path string, pathParameters map[string]interface{}, parameters interface{}) (azure.Future, error) {
+	return c.send(ctx, "Put", path, pathParameters,
+		autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(parameters))
+}
+
+// Patch the resource at the supplied path with the supplied parameters,
+// returning the long running operation that updates it.
+func (c BaseClient) Patch(ctx context.Context, path string, pathParameters map[string]interface{}, parameters interface{}) (azure.Future, error) {
+	return c.send(ctx, "Patch", path, pathParameters,
+		autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPatch(), autorest.WithJSON(parameters))
+}
+
+// Delete the resource at the supplied path, returning the long running
+// operation that deletes it.
+func (c BaseClient) Delete(ctx context.Context, path string, pathParameters map[string]interface{}) (azure.Future, error) {
+	return c.send(ctx, "Delete", path, pathParameters, autorest.AsDelete())
+}
+
+func (c BaseClient) send(ctx context.Context, method, path string, pathParameters map[string]interface{}, decorators ...autorest.PrepareDecorator) (azure.Future, error) {
+	req, err := c.prepare(ctx, path, pathParameters, decorators...)
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "arm.BaseClient", method, nil, "Failure preparing request")
+	}
+	resp, err := c.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "arm.BaseClient", method, resp, "Failure sending request")
+	}
+	f, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return f, autorest.NewErrorWithError(err, "arm.BaseClient", method, resp, "Failure sending request")
+	}
+	return f, nil
+}
+
+func (c BaseClient) prepare(ctx context.Context, path string, pathParameters map[string]interface{}, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	p := map[string]interface{}{"subscriptionId": autorest.Encode("path", c.SubscriptionID)}
+	for k, v := range pathParameters {
+		p[k] = autorest.Encode("path", v)
+	}
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.BaseURI),
+		autorest.WithPathParameters(path, p),
+		autorest.WithQueryParameters(map[string]interface{}{"api-version": c.APIVersion}))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
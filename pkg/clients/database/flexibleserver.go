@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// Custom maintenance window settings of a flexible server.
+const (
+	customWindowEnabled  = "Enabled"
+	customWindowDisabled = "Disabled"
+)
+
+// isFlexibleHighAvailabilityUpToDate returns true if the supplied observed
+// high availability mode and standby zone match the supplied spec. An omitted
+// spec or standby zone is always up to date.
+func isFlexibleHighAvailabilityUpToDate(ha *azuredbv1alpha3.FlexibleServerHighAvailability, mode, standbyZone *string) bool {
+	if ha == nil {
+		return true
+	}
+	if ha.Mode != azure.ToString(mode) {
+		return false
+	}
+	return ha.StandbyAvailabilityZone == nil || *ha.StandbyAvailabilityZone == azure.ToString(standbyZone)
+}
+
+// isFlexibleMaintenanceWindowUpToDate returns true if the supplied observed
+// maintenance window matches the supplied spec. An omitted spec is up to date
+// only while no custom window is enabled.
+func isFlexibleMaintenanceWindowUpToDate(mw *azuredbv1alpha3.FlexibleServerMaintenanceWindow, customWindow *string, dayOfWeek, startHour, startMinute *int32) bool {
+	enabled := azure.ToString(customWindow) == customWindowEnabled
+	if mw == nil {
+		return !enabled
+	}
+	return enabled &&
+		mw.DayOfWeek == azure.ToInt(dayOfWeek) &&
+		mw.StartHour == azure.ToInt(startHour) &&
+		mw.StartMinute == azure.ToInt(startMinute)
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// NOTE: MariaDB servers share SQLServerParameters and SQLServerObservation with
+// MySQL and PostgreSQL servers, but the MariaDB API supports neither
+// MinimalTLSVersion nor InfrastructureEncryption. Both are ignored here.
+
+// MariaDBServerAPI represents the API interface for a MariaDB Server client
+type MariaDBServerAPI interface {
+	GetServer(ctx context.Context, s *azuredbv1beta1.MariaDBServer) (mariadb.Server, error)
+	CreateServer(ctx context.Context, s *azuredbv1beta1.MariaDBServer, adminPassword string) error
+	UpdateServer(ctx context.Context, s *azuredbv1beta1.MariaDBServer) error
+	RotateAdministratorLoginPassword(ctx context.Context, s *azuredbv1beta1.MariaDBServer, password string) error
+	DeleteServer(ctx context.Context, s *azuredbv1beta1.MariaDBServer) error
+	GetRESTClient() autorest.Sender
+}
+
+// MariaDBServerClient is the concrete implementation of the MariaDBServerAPI
+// interface for MariaDB that calls Azure API.
+type MariaDBServerClient struct {
+	mariadb.ServersClient
+}
+
+// NewMariaDBServerClient creates and initializes a MariaDBServerClient instance.
+func NewMariaDBServerClient(cl mariadb.ServersClient) *MariaDBServerClient {
+	return &MariaDBServerClient{
+		ServersClient: cl,
+	}
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *MariaDBServerClient) GetRESTClient() autorest.Sender {
+	return c.ServersClient.Client
+}
+
+// GetServer retrieves the requested MariaDB Server
+func (c *MariaDBServerClient) GetServer(ctx context.Context, cr *azuredbv1beta1.MariaDBServer) (mariadb.Server, error) {
+	return c.ServersClient.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+}
+
+// toMariaDBProperties converts the CrossPlane ForProvider object to a MariaDB Azure properties object
+func toMariaDBProperties(s v1beta1.SQLServerParameters, adminPassword string) mariadb.BasicServerPropertiesForCreate {
+	createMode := pointerToCreateMode(s.CreateMode)
+	switch createMode {
+	case azuredbv1beta1.CreateModePointInTimeRestore:
+		return &mariadb.ServerPropertiesForRestore{
+			Version:             mariadb.ServerVersion(s.Version),
+			SslEnforcement:      mariadb.SslEnforcementEnum(s.SSLEnforcement),
+			PublicNetworkAccess: mariadb.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:          mariadb.CreateModePointInTimeRestore,
+			RestorePointInTime:  safeDate(s.RestorePointInTime),
+			SourceServerID:      s.SourceServerID,
+			StorageProfile: &mariadb.StorageProfile{
+				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
+				GeoRedundantBackup:  mariadb.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
+				StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
+				StorageAutogrow:     mariadb.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
+			},
+		}
+	case azuredbv1beta1.CreateModeGeoRestore:
+		return &mariadb.ServerPropertiesForGeoRestore{
+			Version:             mariadb.ServerVersion(s.Version),
+			SslEnforcement:      mariadb.SslEnforcementEnum(s.SSLEnforcement),
+			PublicNetworkAccess: mariadb.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:          mariadb.CreateModeGeoRestore,
+			SourceServerID:      s.SourceServerID,
+			StorageProfile: &mariadb.StorageProfile{
+				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
+				GeoRedundantBackup:  mariadb.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
+				StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
+				StorageAutogrow:     mariadb.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
+			},
+		}
+	case azuredbv1beta1.CreateModeReplica:
+		return &mariadb.ServerPropertiesForReplica{
+			Version:             mariadb.ServerVersion(s.Version),
+			SslEnforcement:      mariadb.SslEnforcementEnum(s.SSLEnforcement),
+			PublicNetworkAccess: mariadb.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:          mariadb.CreateModeReplica,
+			SourceServerID:      s.SourceServerID,
+			StorageProfile: &mariadb.StorageProfile{
+				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
+				GeoRedundantBackup:  mariadb.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
+				StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
+				StorageAutogrow:     mariadb.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
+			},
+		}
+	case azuredbv1beta1.CreateModeDefault:
+		fallthrough
+	default:
+		return &mariadb.ServerPropertiesForDefaultCreate{
+			AdministratorLogin:         azure.ToStringPtr(s.AdministratorLogin),
+			AdministratorLoginPassword: &adminPassword,
+			Version:                    mariadb.ServerVersion(s.Version),
+			SslEnforcement:             mariadb.SslEnforcementEnum(s.SSLEnforcement),
+			PublicNetworkAccess:        mariadb.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:                 mariadb.CreateModeDefault,
+			StorageProfile: &mariadb.StorageProfile{
+				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
+				GeoRedundantBackup:  mariadb.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
+				StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
+				StorageAutogrow:     mariadb.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
+			},
+		}
+	}
+}
+
+// CreateServer creates a MariaDB Server.
+func (c *MariaDBServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1.MariaDBServer, adminPassword string) error {
+	s := cr.Spec.ForProvider
+	sku, err := ToMariaDBSKU(s.SKU)
+	if err != nil {
+		return err
+	}
+	createParams := mariadb.ServerForCreate{
+		Sku:        sku,
+		Properties: toMariaDBProperties(s, adminPassword),
+		Location:   &s.Location,
+		Tags:       azure.ToStringPtrMap(s.Tags),
+	}
+	op, err := c.Create(ctx, s.ResourceGroupName, meta.GetExternalName(cr), createParams)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return nil
+}
+
+// RotateAdministratorLoginPassword sends the supplied administrator login
+// password to a MariaDB Server.
+func (c *MariaDBServerClient) RotateAdministratorLoginPassword(ctx context.Context, cr *azuredbv1beta1.MariaDBServer, password string) error {
+	updateParams := mariadb.ServerUpdateParameters{
+		ServerUpdateParametersProperties: &mariadb.ServerUpdateParametersProperties{
+			AdministratorLoginPassword: &password,
+		},
+	}
+	op, err := c.Update(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), updateParams)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.AdministratorLoginPasswordRotation.LastRotation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return nil
+}
+
+// UpdateServer updates a MariaDB Server.
+func (c *MariaDBServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.MariaDBServer) error {
+	// NOTE: The administrator login password is sent separately, by
+	// RotateAdministratorLoginPassword.
+	s := cr.Spec.ForProvider
+	properties := &mariadb.ServerUpdateParametersProperties{
+		Version:             mariadb.ServerVersion(s.Version),
+		SslEnforcement:      mariadb.SslEnforcementEnum(s.SSLEnforcement),
+		PublicNetworkAccess: mariadb.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+		StorageProfile: &mariadb.StorageProfile{
+			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
+			GeoRedundantBackup:  mariadb.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
+			StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
+			StorageAutogrow:     mariadb.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
+		},
+	}
+	sku, err := ToMariaDBSKU(s.SKU)
+	if err != nil {
+		return err
+	}
+	updateParams := mariadb.ServerUpdateParameters{
+		Sku:                              sku,
+		ServerUpdateParametersProperties: properties,
+		Tags:                             azure.ToStringPtrMap(s.Tags),
+	}
+	op, err := c.Update(ctx, s.ResourceGroupName, meta.GetExternalName(cr), updateParams)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return nil
+}
+
+// DeleteServer deletes the given MariaDBServer resource.
+func (c *MariaDBServerClient) DeleteServer(ctx context.Context, cr *azuredbv1beta1.MariaDBServer) error {
+	op, err := c.ServersClient.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodDelete,
+	}
+	return nil
+}
+
+// NewMariaDBVirtualNetworkRuleParameters returns an Azure VirtualNetworkRule object from a virtual network spec
+func NewMariaDBVirtualNetworkRuleParameters(v *azuredbv1alpha3.MariaDBServerVirtualNetworkRule) mariadb.VirtualNetworkRule {
+	return mariadb.VirtualNetworkRule{
+		Name: azure.ToStringPtr(meta.GetExternalName(v)),
+		VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+			VirtualNetworkSubnetID:           azure.ToStringPtr(v.Spec.VirtualNetworkRuleProperties.VirtualNetworkSubnetID),
+			IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(v.Spec.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint, azure.FieldRequired),
+		},
+	}
+}
+
+// MariaDBServerVirtualNetworkRuleNeedsUpdate determines if a virtual network rule needs to be updated
+func MariaDBServerVirtualNetworkRuleNeedsUpdate(kube *azuredbv1alpha3.MariaDBServerVirtualNetworkRule, az mariadb.VirtualNetworkRule) bool {
+	up := NewMariaDBVirtualNetworkRuleParameters(kube)
+
+	switch {
+	case !reflect.DeepEqual(up.VirtualNetworkRuleProperties.VirtualNetworkSubnetID, az.VirtualNetworkRuleProperties.VirtualNetworkSubnetID):
+		return true
+	case !reflect.DeepEqual(up.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint, az.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint):
+		return true
+	}
+
+	return false
+}
+
+// UpdateMariaDBVirtualNetworkRuleStatusFromAzure updates the status related to the external
+// Azure MariaDBVirtualNetworkRule in the VirtualNetworkStatus
+func UpdateMariaDBVirtualNetworkRuleStatusFromAzure(v *azuredbv1alpha3.MariaDBServerVirtualNetworkRule, az mariadb.VirtualNetworkRule) {
+	v.Status.State = string(az.VirtualNetworkRuleProperties.State)
+	v.Status.ID = azure.ToString(az.ID)
+	v.Status.Type = azure.ToString(az.Type)
+}
+
+// NewMariaDBFirewallRuleParameters returns an Azure FirewallRule object from a
+// firewall spec.
+func NewMariaDBFirewallRuleParameters(r *azuredbv1alpha3.MariaDBServerFirewallRule) mariadb.FirewallRule {
+	return mariadb.FirewallRule{
+		Name: azure.ToStringPtr(meta.GetExternalName(r)),
+		FirewallRuleProperties: &mariadb.FirewallRuleProperties{
+			StartIPAddress: azure.ToStringPtr(r.Spec.ForProvider.StartIPAddress),
+			EndIPAddress:   azure.ToStringPtr(r.Spec.ForProvider.EndIPAddress),
+		},
+	}
+}
+
+// MariaDBServerFirewallRuleIsUpToDate returns true if the supplied FirewallRule
+// appears to be up to date with the supplied MariaDBServerFirewallRule.
+func MariaDBServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.MariaDBServerFirewallRule, az mariadb.FirewallRule) bool {
+	up := NewMariaDBFirewallRuleParameters(kube)
+	return cmp.Equal(up.FirewallRuleProperties, az.FirewallRuleProperties)
+}
+
+// The name must match the specification of the SKU, so, we don't allow user
+// to specify an arbitrary name. The format is tier + family + cores, e.g. B_Gen4_1, GP_Gen5_8.
+
+// ToMariaDBSKU returns a *mariadb.Sku object that can be used in Azure API calls.
+func ToMariaDBSKU(skuSpec azuredbv1beta1.SKU) (*mariadb.Sku, error) {
+	t, ok := skuShortTiers[mysql.SkuTier(skuSpec.Tier)]
+	if !ok {
+		return nil, fmt.Errorf("tier '%s' is not one of the supported values: %+v", skuSpec.Tier, mariadb.PossibleSkuTierValues())
+	}
+	return &mariadb.Sku{
+		Name:     azure.ToStringPtr(fmt.Sprintf("%s_%s_%s", t, skuSpec.Family, strconv.Itoa(skuSpec.Capacity))),
+		Tier:     mariadb.SkuTier(skuSpec.Tier),
+		Capacity: azure.ToInt32Ptr(skuSpec.Capacity),
+		Family:   azure.ToStringPtr(skuSpec.Family),
+		Size:     skuSpec.Size,
+	}, nil
+}
+
+// UpdateMariaDBObservation produces SQLServerObservation from mariadb.Server.
+func UpdateMariaDBObservation(o *azuredbv1beta1.SQLServerObservation, in mariadb.Server) {
+	o.ID = azure.ToString(in.ID)
+	o.Name = azure.ToString(in.Name)
+	o.Type = azure.ToString(in.Type)
+	o.UserVisibleState = string(in.UserVisibleState)
+	o.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
+	o.MasterServerID = azure.ToString(in.MasterServerID)
+}
+
+// LateInitializeMariaDB fills the empty values of SQLServerParameters with the
+// ones that are retrieved from the Azure API.
+func LateInitializeMariaDB(p *azuredbv1beta1.SQLServerParameters, in mariadb.Server) {
+	if in.Sku != nil {
+		p.SKU.Size = azure.LateInitializeStringPtrFromPtr(p.SKU.Size, in.Sku.Size)
+	}
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	if in.StorageProfile != nil {
+		p.StorageProfile.BackupRetentionDays = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageProfile.BackupRetentionDays, in.StorageProfile.BackupRetentionDays)
+		p.StorageProfile.GeoRedundantBackup = azure.LateInitializeStringPtrFromVal(p.StorageProfile.GeoRedundantBackup, string(in.StorageProfile.GeoRedundantBackup))
+		p.StorageProfile.StorageAutogrow = azure.LateInitializeStringPtrFromVal(p.StorageProfile.StorageAutogrow, string(in.StorageProfile.StorageAutogrow))
+	}
+	if p.SSLEnforcement == "" {
+		p.SSLEnforcement = string(in.SslEnforcement)
+	}
+	p.PublicNetworkAccess = azure.LateInitializeStringPtrFromVal(p.PublicNetworkAccess, string(in.PublicNetworkAccess))
+}
+
+// IsMariaDBUpToDate is used to report whether given mariadb.Server is in
+// sync with the SQLServerParameters that user desires.
+func IsMariaDBUpToDate(p azuredbv1beta1.SQLServerParameters, in mariadb.Server) bool { // nolint:gocyclo
+	if in.StorageProfile == nil || in.Sku == nil {
+		return false
+	}
+	switch {
+	case p.SSLEnforcement != string(in.SslEnforcement):
+		return false
+	case azure.ToString(p.PublicNetworkAccess) != string(in.PublicNetworkAccess):
+		return false
+	case p.Version != string(in.Version):
+		return false
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+		return false
+	case p.SKU.Tier != string(in.Sku.Tier):
+		return false
+	case p.SKU.Capacity != azure.ToInt(in.Sku.Capacity):
+		return false
+	case p.SKU.Family != azure.ToString(in.Sku.Family):
+		return false
+	case !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.StorageProfile.BackupRetentionDays), in.StorageProfile.BackupRetentionDays):
+		return false
+	case azure.ToString(p.StorageProfile.GeoRedundantBackup) != string(in.StorageProfile.GeoRedundantBackup):
+		return false
+	case p.StorageProfile.StorageMB != azure.ToInt(in.StorageProfile.StorageMB):
+		return false
+	case azure.ToString(p.StorageProfile.StorageAutogrow) != string(in.StorageProfile.StorageAutogrow):
+		return false
+	}
+	return true
+}
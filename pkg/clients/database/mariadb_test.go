@@ -0,0 +1,506 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+type mariaDBVirtualNetworkRuleModifier func(*v1alpha3.MariaDBServerVirtualNetworkRule)
+
+func mariaDBWithSubnetID(id string) mariaDBVirtualNetworkRuleModifier {
+	return func(r *v1alpha3.MariaDBServerVirtualNetworkRule) {
+		r.Spec.VirtualNetworkSubnetID = id
+	}
+}
+
+func mariaDBWithIgnoreMissing(ignore bool) mariaDBVirtualNetworkRuleModifier {
+	return func(r *v1alpha3.MariaDBServerVirtualNetworkRule) {
+		r.Spec.IgnoreMissingVnetServiceEndpoint = ignore
+	}
+}
+
+func mariaDBServerParameters(createMode *v1beta1.CreateMode) v1beta1.SQLServerParameters {
+	fp := v1beta1.SQLServerParameters{
+		CreateMode: createMode,
+	}
+	return fp
+}
+
+func mariaDBServerPropertiesForDefaultCreate() mariadb.BasicServerPropertiesForCreate {
+	adminPassword := "admin"
+	return &mariadb.ServerPropertiesForDefaultCreate{
+		AdministratorLoginPassword: &adminPassword,
+		CreateMode:                 mariadb.CreateModeDefault,
+		StorageProfile:             &mariadb.StorageProfile{},
+	}
+}
+
+func mariaDBServerPropertiesForRestore() mariadb.BasicServerPropertiesForCreate {
+	return &mariadb.ServerPropertiesForRestore{
+		CreateMode:     mariadb.CreateModePointInTimeRestore,
+		StorageProfile: &mariadb.StorageProfile{},
+	}
+}
+
+func mariaDBServerPropertiesForGeoRestore() mariadb.BasicServerPropertiesForCreate {
+	return &mariadb.ServerPropertiesForGeoRestore{
+		CreateMode:     mariadb.CreateModeGeoRestore,
+		StorageProfile: &mariadb.StorageProfile{},
+	}
+}
+
+func mariaDBServerPropertiesForReplica() mariadb.BasicServerPropertiesForCreate {
+	return &mariadb.ServerPropertiesForReplica{
+		CreateMode:     mariadb.CreateModeReplica,
+		StorageProfile: &mariadb.StorageProfile{},
+	}
+}
+
+func mariaDBVirtualNetworkRule(sm ...mariaDBVirtualNetworkRuleModifier) *v1alpha3.MariaDBServerVirtualNetworkRule {
+	r := &v1alpha3.MariaDBServerVirtualNetworkRule{
+		Spec: v1alpha3.MariaDBVirtualNetworkRuleSpec{
+			ServerName:        serverName,
+			ResourceGroupName: rgName,
+		},
+	}
+
+	meta.SetExternalName(r, vnetRuleName)
+
+	for _, m := range sm {
+		m(r)
+	}
+
+	return r
+}
+
+func TestToMariaDBProperties(t *testing.T) {
+	cases := []struct {
+		name string
+		fp   v1beta1.SQLServerParameters
+		want mariadb.BasicServerPropertiesForCreate
+	}{
+		{
+			name: "CreateModeDefault",
+			fp:   mariaDBServerParameters(pointerFromCreateMode(v1beta1.CreateModeDefault)),
+			want: mariaDBServerPropertiesForDefaultCreate(),
+		},
+		{
+			name: "CreateModePointInTimeRestore",
+			fp:   mariaDBServerParameters(pointerFromCreateMode(v1beta1.CreateModePointInTimeRestore)),
+			want: mariaDBServerPropertiesForRestore(),
+		},
+		{
+			name: "CreateModeGeoRestore",
+			fp:   mariaDBServerParameters(pointerFromCreateMode(v1beta1.CreateModeGeoRestore)),
+			want: mariaDBServerPropertiesForGeoRestore(),
+		},
+		{
+			name: "CreateModeReplica",
+			fp:   mariaDBServerParameters(pointerFromCreateMode(v1beta1.CreateModeReplica)),
+			want: mariaDBServerPropertiesForReplica(),
+		},
+		{
+			name: "ServerPropertiesForInvalidString",
+			fp:   mariaDBServerParameters(pointerFromCreateMode("")),
+			want: mariaDBServerPropertiesForDefaultCreate(),
+		},
+		{
+			name: "ServerPropertiesForDefaultCreate",
+			fp:   mariaDBServerParameters(nil),
+			want: mariaDBServerPropertiesForDefaultCreate(),
+		},
+		{
+			name: "UnsupportedFieldsIgnored",
+			fp: v1beta1.SQLServerParameters{
+				MinimalTLSVersion:        "TLS1_2",
+				InfrastructureEncryption: azure.ToStringPtr("Enabled"),
+				PublicNetworkAccess:      azure.ToStringPtr(string(mariadb.PublicNetworkAccessEnumDisabled)),
+			},
+			want: &mariadb.ServerPropertiesForDefaultCreate{
+				AdministratorLoginPassword: to.StringPtr("admin"),
+				PublicNetworkAccess:        mariadb.PublicNetworkAccessEnumDisabled,
+				CreateMode:                 mariadb.CreateModeDefault,
+				StorageProfile:             &mariadb.StorageProfile{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := toMariaDBProperties(tc.fp, "admin")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("TestToMariaDBProperties(%s): -want, +got\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestNewMariaDBVirtualNetworkRuleParameters(t *testing.T) {
+	cases := []struct {
+		name string
+		r    *v1alpha3.MariaDBServerVirtualNetworkRule
+		want mariadb.VirtualNetworkRule
+	}{
+		{
+			name: "Successful",
+			r: mariaDBVirtualNetworkRule(
+				mariaDBWithSubnetID(vnetSubnetID),
+				mariaDBWithIgnoreMissing(ignoreMissing),
+			),
+			want: mariadb.VirtualNetworkRule{
+				Name: azure.ToStringPtr(vnetRuleName),
+				VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+					VirtualNetworkSubnetID:           to.StringPtr(vnetSubnetID),
+					IgnoreMissingVnetServiceEndpoint: to.BoolPtr(ignoreMissing),
+				},
+			},
+		},
+		{
+			name: "SuccessfulPartial",
+			r: mariaDBVirtualNetworkRule(
+				mariaDBWithSubnetID(vnetSubnetID),
+			),
+			want: mariadb.VirtualNetworkRule{
+				Name: azure.ToStringPtr(vnetRuleName),
+				VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+					VirtualNetworkSubnetID:           to.StringPtr(vnetSubnetID),
+					IgnoreMissingVnetServiceEndpoint: to.BoolPtr(false),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewMariaDBVirtualNetworkRuleParameters(tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewMariaDBVirtualNetworkRuleParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMariaDBServerVirtualNetworkRuleNeedsUpdate(t *testing.T) {
+	cases := []struct {
+		name string
+		kube *v1alpha3.MariaDBServerVirtualNetworkRule
+		az   mariadb.VirtualNetworkRule
+		want bool
+	}{
+		{
+			name: "NoUpdateNeeded",
+			kube: mariaDBVirtualNetworkRule(
+				mariaDBWithSubnetID(vnetSubnetID),
+				mariaDBWithIgnoreMissing(ignoreMissing),
+			),
+			az: mariadb.VirtualNetworkRule{
+				Name: azure.ToStringPtr(vnetRuleName),
+				VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+					VirtualNetworkSubnetID:           azure.ToStringPtr(vnetSubnetID),
+					IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(ignoreMissing),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "UpdateNeededVirtualNetworkSubnetID",
+			kube: mariaDBVirtualNetworkRule(
+				mariaDBWithSubnetID(vnetSubnetID),
+				mariaDBWithIgnoreMissing(ignoreMissing),
+			),
+			az: mariadb.VirtualNetworkRule{
+				Name: azure.ToStringPtr(vnetRuleName),
+				VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+					VirtualNetworkSubnetID:           azure.ToStringPtr("some/other/subnet"),
+					IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(ignoreMissing),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "UpdateNeededIgnoreMissingVnetServiceEndpoint",
+			kube: mariaDBVirtualNetworkRule(
+				mariaDBWithSubnetID(vnetSubnetID),
+				mariaDBWithIgnoreMissing(ignoreMissing),
+			),
+			az: mariadb.VirtualNetworkRule{
+				Name: azure.ToStringPtr(vnetRuleName),
+				VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+					VirtualNetworkSubnetID:           azure.ToStringPtr(vnetSubnetID),
+					IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(!ignoreMissing),
+				},
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MariaDBServerVirtualNetworkRuleNeedsUpdate(tc.kube, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MariaDBServerVirtualNetworkRuleNeedsUpdate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateMariaDBVirtualNetworkRuleStatusFromAzure(t *testing.T) {
+
+	mockCondition := xpv1.Condition{Message: "mockMessage"}
+	resourceStatus := xpv1.ResourceStatus{
+		ConditionedStatus: xpv1.ConditionedStatus{
+			Conditions: []xpv1.Condition{mockCondition},
+		},
+	}
+
+	cases := []struct {
+		name string
+		r    mariadb.VirtualNetworkRule
+		want v1alpha3.VirtualNetworkRuleStatus
+	}{
+		{
+			name: "SuccessfulFull",
+			r: mariadb.VirtualNetworkRule{
+				Name: azure.ToStringPtr(vnetRuleName),
+				ID:   azure.ToStringPtr(id),
+				Type: azure.ToStringPtr(resourceType),
+				VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+					VirtualNetworkSubnetID:           azure.ToStringPtr(vnetSubnetID),
+					IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(ignoreMissing),
+					State:                            mariadb.VirtualNetworkRuleStateReady,
+				},
+			},
+			want: v1alpha3.VirtualNetworkRuleStatus{
+				State: "Ready",
+				ID:    id,
+				Type:  resourceType,
+			},
+		},
+		{
+			name: "SuccessfulPartial",
+			r: mariadb.VirtualNetworkRule{
+				Name: azure.ToStringPtr(vnetRuleName),
+				ID:   azure.ToStringPtr(id),
+				VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+					VirtualNetworkSubnetID:           azure.ToStringPtr(vnetSubnetID),
+					IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(ignoreMissing),
+					State:                            mariadb.VirtualNetworkRuleStateReady,
+				},
+			},
+			want: v1alpha3.VirtualNetworkRuleStatus{
+				State: "Ready",
+				ID:    id,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &v1alpha3.MariaDBServerVirtualNetworkRule{
+				Status: v1alpha3.VirtualNetworkRuleStatus{
+					ResourceStatus: resourceStatus,
+				},
+			}
+
+			UpdateMariaDBVirtualNetworkRuleStatusFromAzure(v, tc.r)
+
+			// make sure that internal resource status hasn't changed
+			if diff := cmp.Diff(mockCondition, v.Status.ResourceStatus.Conditions[0]); diff != "" {
+				t.Errorf("UpdateMariaDBVirtualNetworkRuleStatusFromAzure(...): -want, +got\n%s", diff)
+			}
+
+			// make sure that other resource parameters are updated
+			tc.want.ResourceStatus = resourceStatus
+			if diff := cmp.Diff(tc.want, v.Status); diff != "" {
+				t.Errorf("UpdateMariaDBVirtualNetworkRuleStatusFromAzure(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewMariaDBFirewallRuleParameters(t *testing.T) {
+	name := "coolrule"
+	start := "127.0.0.1."
+	end := "It was just a dream Bender - there's no such thing as two."
+
+	cases := map[string]struct {
+		r    *v1alpha3.MariaDBServerFirewallRule
+		want mariadb.FirewallRule
+	}{
+		"Successful": {
+			r: func() *v1alpha3.MariaDBServerFirewallRule {
+				r := &v1alpha3.MariaDBServerFirewallRule{
+					Spec: v1alpha3.FirewallRuleSpec{
+						ForProvider: v1alpha3.FirewallRuleParameters{
+							FirewallRuleProperties: v1alpha3.FirewallRuleProperties{
+								StartIPAddress: start,
+								EndIPAddress:   end,
+							},
+						},
+					},
+				}
+				meta.SetExternalName(r, name)
+				return r
+			}(),
+			want: mariadb.FirewallRule{
+				Name: azure.ToStringPtr(name),
+				FirewallRuleProperties: &mariadb.FirewallRuleProperties{
+					StartIPAddress: azure.ToStringPtr(start),
+					EndIPAddress:   azure.ToStringPtr(end),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewMariaDBFirewallRuleParameters(tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewMariaDBFirewallRuleParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMariaDBServerFirewallRuleIsUpToDate(t *testing.T) {
+	start := "127.0.0.1."
+	end := "256"
+
+	cases := map[string]struct {
+		kube *v1alpha3.MariaDBServerFirewallRule
+		az   mariadb.FirewallRule
+		want bool
+	}{
+		"UpToDate": {
+			kube: &v1alpha3.MariaDBServerFirewallRule{},
+			az: mariadb.FirewallRule{
+				Name:                   azure.ToStringPtr(vnetRuleName),
+				FirewallRuleProperties: &mariadb.FirewallRuleProperties{},
+			},
+			want: true,
+		},
+		"StartNeedsUpdate": {
+			kube: &v1alpha3.MariaDBServerFirewallRule{
+				Spec: v1alpha3.FirewallRuleSpec{ForProvider: v1alpha3.FirewallRuleParameters{FirewallRuleProperties: v1alpha3.FirewallRuleProperties{
+					StartIPAddress: start,
+					EndIPAddress:   end,
+				}}},
+			},
+			az: mariadb.FirewallRule{
+				FirewallRuleProperties: &mariadb.FirewallRuleProperties{
+					StartIPAddress: azure.ToStringPtr("255.255.255.254"),
+					EndIPAddress:   azure.ToStringPtr(end),
+				},
+			},
+			want: false,
+		},
+		"EndNeedsUpdate": {
+			kube: &v1alpha3.MariaDBServerFirewallRule{
+				Spec: v1alpha3.FirewallRuleSpec{ForProvider: v1alpha3.FirewallRuleParameters{FirewallRuleProperties: v1alpha3.FirewallRuleProperties{
+					StartIPAddress: start,
+					EndIPAddress:   end,
+				}}},
+			},
+			az: mariadb.FirewallRule{
+				FirewallRuleProperties: &mariadb.FirewallRuleProperties{
+					StartIPAddress: azure.ToStringPtr(start),
+					EndIPAddress:   azure.ToStringPtr("192.168.0.1"),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := MariaDBServerFirewallRuleIsUpToDate(tc.kube, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MariaDBServerFirewallRuleIsUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMariaDBUpToDate(t *testing.T) {
+	server := mariadb.Server{
+		Sku: &mariadb.Sku{
+			Tier:     mariadb.GeneralPurpose,
+			Capacity: azure.ToInt32Ptr(2),
+			Family:   azure.ToStringPtr("Gen5"),
+		},
+		ServerProperties: &mariadb.ServerProperties{
+			Version:        "10.3",
+			SslEnforcement: mariadb.SslEnforcementEnumEnabled,
+			StorageProfile: &mariadb.StorageProfile{StorageMB: azure.ToInt32Ptr(5120)},
+		},
+	}
+	params := func(capacity int) v1beta1.SQLServerParameters {
+		return v1beta1.SQLServerParameters{
+			SKU:            v1beta1.SKU{Tier: string(mariadb.GeneralPurpose), Capacity: capacity, Family: "Gen5"},
+			Version:        "10.3",
+			SSLEnforcement: string(mariadb.SslEnforcementEnumEnabled),
+			StorageProfile: v1beta1.StorageProfile{StorageMB: 5120},
+		}
+	}
+
+	cases := map[string]struct {
+		p    v1beta1.SQLServerParameters
+		az   mariadb.Server
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(2),
+			az:   server,
+			want: true,
+		},
+		"UnsupportedFieldsIgnored": {
+			p: func() v1beta1.SQLServerParameters {
+				p := params(2)
+				p.MinimalTLSVersion = "TLS1_2"
+				return p
+			}(),
+			az:   server,
+			want: true,
+		},
+		"SKUNeedsUpdate": {
+			p:    params(4),
+			az:   server,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMariaDBUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMariaDBUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,591 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// AdministratorTypeActiveDirectory is the only type of Azure SQL, PostgreSQL
+// and MySQL server administrator.
+const AdministratorTypeActiveDirectory = "ActiveDirectory"
+
+// Error strings.
+const (
+	errParseObjectID = "cannot parse Azure AD administrator object ID"
+	errParseTenantID = "cannot parse Azure AD administrator tenant ID"
+)
+
+// MSSQLServerAPI represents the API interface for an Azure SQL server client.
+type MSSQLServerAPI interface {
+	GetServer(ctx context.Context, s *azuredbv1alpha3.MSSQLServer) (sql.Server, error)
+	CreateServer(ctx context.Context, s *azuredbv1alpha3.MSSQLServer, adminPassword string) error
+	UpdateServer(ctx context.Context, s *azuredbv1alpha3.MSSQLServer, adminPassword string) error
+	DeleteServer(ctx context.Context, s *azuredbv1alpha3.MSSQLServer) error
+	GetAzureADAdministrator(ctx context.Context, s *azuredbv1alpha3.MSSQLServer) (sql.ServerAzureADAdministrator, error)
+	UpdateAzureADAdministrator(ctx context.Context, s *azuredbv1alpha3.MSSQLServer) error
+	GetRESTClient() autorest.Sender
+}
+
+// MSSQLServerClient is the concrete implementation of the MSSQLServerAPI
+// interface that calls Azure API.
+type MSSQLServerClient struct {
+	sql.ServersClient
+	Administrators sql.ServerAzureADAdministratorsClient
+}
+
+// NewMSSQLServerClient creates and initializes a MSSQLServerClient instance.
+func NewMSSQLServerClient(servers sql.ServersClient, admins sql.ServerAzureADAdministratorsClient) *MSSQLServerClient {
+	return &MSSQLServerClient{
+		ServersClient:  servers,
+		Administrators: admins,
+	}
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *MSSQLServerClient) GetRESTClient() autorest.Sender {
+	return c.ServersClient.Client
+}
+
+// GetServer retrieves the requested Azure SQL server.
+func (c *MSSQLServerClient) GetServer(ctx context.Context, cr *azuredbv1alpha3.MSSQLServer) (sql.Server, error) {
+	return c.ServersClient.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+}
+
+// CreateServer creates an Azure SQL server.
+func (c *MSSQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1alpha3.MSSQLServer, adminPassword string) error {
+	s := cr.Spec.ForProvider
+	op, err := c.CreateOrUpdate(ctx, s.ResourceGroupName, meta.GetExternalName(cr), NewMSSQLServerParameters(s, adminPassword))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return nil
+}
+
+// UpdateServer updates an Azure SQL server. The administrator login password
+// is only sent if one is supplied.
+func (c *MSSQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1alpha3.MSSQLServer, adminPassword string) error {
+	s := cr.Spec.ForProvider
+	op, err := c.Update(ctx, s.ResourceGroupName, meta.GetExternalName(cr), NewMSSQLServerUpdateParameters(s, adminPassword))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return nil
+}
+
+// DeleteServer deletes the supplied Azure SQL server.
+func (c *MSSQLServerClient) DeleteServer(ctx context.Context, cr *azuredbv1alpha3.MSSQLServer) error {
+	op, err := c.ServersClient.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodDelete,
+	}
+	return nil
+}
+
+// GetAzureADAdministrator retrieves the Azure AD administrator of the supplied
+// Azure SQL server.
+func (c *MSSQLServerClient) GetAzureADAdministrator(ctx context.Context, cr *azuredbv1alpha3.MSSQLServer) (sql.ServerAzureADAdministrator, error) {
+	return c.Administrators.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+}
+
+// UpdateAzureADAdministrator sets the Azure AD administrator of the supplied
+// Azure SQL server.
+func (c *MSSQLServerClient) UpdateAzureADAdministrator(ctx context.Context, cr *azuredbv1alpha3.MSSQLServer) error {
+	s := cr.Spec.ForProvider
+	if s.AzureADAdministrator == nil {
+		return nil
+	}
+	p, err := NewMSSQLServerAzureADAdministratorParameters(*s.AzureADAdministrator)
+	if err != nil {
+		return err
+	}
+	op, err := c.Administrators.CreateOrUpdate(ctx, s.ResourceGroupName, meta.GetExternalName(cr), p)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return nil
+}
+
+// NewMSSQLServerParameters returns the Azure Server an Azure SQL server should
+// be created with.
+func NewMSSQLServerParameters(s azuredbv1alpha3.MSSQLServerParameters, adminPassword string) sql.Server {
+	return sql.Server{
+		Location: azure.ToStringPtr(s.Location),
+		ServerProperties: &sql.ServerProperties{
+			AdministratorLogin:         azure.ToStringPtr(s.AdministratorLogin),
+			AdministratorLoginPassword: azure.ToStringPtr(adminPassword),
+			Version:                    s.Version,
+			MinimalTLSVersion:          s.MinimalTLSVersion,
+			PublicNetworkAccess:        sql.ServerPublicNetworkAccess(azure.ToString(s.PublicNetworkAccess)),
+		},
+		Tags: azure.ToStringPtrMap(s.Tags),
+	}
+}
+
+// NewMSSQLServerUpdateParameters returns the Azure ServerUpdate an Azure SQL
+// server should be updated with.
+func NewMSSQLServerUpdateParameters(s azuredbv1alpha3.MSSQLServerParameters, adminPassword string) sql.ServerUpdate {
+	return sql.ServerUpdate{
+		ServerProperties: &sql.ServerProperties{
+			AdministratorLoginPassword: azure.ToStringPtr(adminPassword),
+			MinimalTLSVersion:          s.MinimalTLSVersion,
+			PublicNetworkAccess:        sql.ServerPublicNetworkAccess(azure.ToString(s.PublicNetworkAccess)),
+		},
+		Tags: azure.ToStringPtrMap(s.Tags),
+	}
+}
+
+// LateInitializeMSSQLServer fills the empty fields of the supplied Azure SQL
+// server spec with the values of the supplied Azure Server.
+func LateInitializeMSSQLServer(p *azuredbv1alpha3.MSSQLServerParameters, in sql.Server) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	if in.ServerProperties == nil {
+		return
+	}
+	p.Version = azure.LateInitializeStringPtrFromPtr(p.Version, in.Version)
+	p.MinimalTLSVersion = azure.LateInitializeStringPtrFromPtr(p.MinimalTLSVersion, in.MinimalTLSVersion)
+	if in.PublicNetworkAccess != "" {
+		p.PublicNetworkAccess = azure.LateInitializeStringPtrFromVal(p.PublicNetworkAccess, string(in.PublicNetworkAccess))
+	}
+}
+
+// IsMSSQLServerUpToDate returns true if the supplied Azure Server matches the
+// supplied Azure SQL server spec.
+func IsMSSQLServerUpToDate(p azuredbv1alpha3.MSSQLServerParameters, in sql.Server) bool {
+	if in.ServerProperties == nil {
+		return false
+	}
+	switch {
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+		return false
+	case p.MinimalTLSVersion != nil && azure.ToString(p.MinimalTLSVersion) != azure.ToString(in.MinimalTLSVersion):
+		return false
+	case p.PublicNetworkAccess != nil && azure.ToString(p.PublicNetworkAccess) != string(in.PublicNetworkAccess):
+		return false
+	}
+	return true
+}
+
+// UpdateMSSQLServerObservation updates the supplied observation with the
+// values of the supplied Azure Server.
+func UpdateMSSQLServerObservation(o *azuredbv1alpha3.MSSQLServerObservation, in sql.Server) {
+	o.ID = azure.ToString(in.ID)
+	o.Type = azure.ToString(in.Type)
+	if in.ServerProperties == nil {
+		return
+	}
+	o.State = azure.ToString(in.State)
+	o.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
+}
+
+// NewMSSQLServerAzureADAdministratorParameters returns the Azure
+// ServerAzureADAdministrator that corresponds to the supplied administrator.
+func NewMSSQLServerAzureADAdministratorParameters(a azuredbv1alpha3.MSSQLServerAzureADAdministrator) (sql.ServerAzureADAdministrator, error) {
+	sid, err := uuid.FromString(a.ObjectID)
+	if err != nil {
+		return sql.ServerAzureADAdministrator{}, errors.Wrap(err, errParseObjectID)
+	}
+	p := &sql.AdministratorProperties{
+		AdministratorType:         azure.ToStringPtr(AdministratorTypeActiveDirectory),
+		Login:                     azure.ToStringPtr(a.Login),
+		Sid:                       &sid,
+		AzureADOnlyAuthentication: a.AzureADOnlyAuthentication,
+	}
+	if a.TenantID != nil {
+		tid, err := uuid.FromString(*a.TenantID)
+		if err != nil {
+			return sql.ServerAzureADAdministrator{}, errors.Wrap(err, errParseTenantID)
+		}
+		p.TenantID = &tid
+	}
+	return sql.ServerAzureADAdministrator{AdministratorProperties: p}, nil
+}
+
+// IsMSSQLServerAzureADAdministratorUpToDate returns true if the supplied Azure
+// ServerAzureADAdministrator matches the supplied administrator. A nil
+// administrator is always up to date.
+func IsMSSQLServerAzureADAdministratorUpToDate(a *azuredbv1alpha3.MSSQLServerAzureADAdministrator, in sql.ServerAzureADAdministrator) bool {
+	if a == nil {
+		return true
+	}
+	if in.AdministratorProperties == nil || in.Sid == nil {
+		return false
+	}
+	switch {
+	case a.Login != azure.ToString(in.Login):
+		return false
+	case !strings.EqualFold(a.ObjectID, in.Sid.String()):
+		return false
+	case a.TenantID != nil && (in.TenantID == nil || !strings.EqualFold(*a.TenantID, in.TenantID.String())):
+		return false
+	case a.AzureADOnlyAuthentication != nil && *a.AzureADOnlyAuthentication != azure.ToBool(in.AzureADOnlyAuthentication):
+		return false
+	}
+	return true
+}
+
+func toMSSQLSKU(s *azuredbv1alpha3.MSSQLSKU) *sql.Sku {
+	if s == nil {
+		return nil
+	}
+	return &sql.Sku{
+		Name:     azure.ToStringPtr(s.Name),
+		Tier:     s.Tier,
+		Family:   s.Family,
+		Capacity: azure.ToInt32PtrFromIntPtr(s.Capacity),
+	}
+}
+
+func lateInitializeMSSQLSKU(s *azuredbv1alpha3.MSSQLSKU, in *sql.Sku) *azuredbv1alpha3.MSSQLSKU {
+	if in == nil {
+		return s
+	}
+	if s == nil {
+		s = &azuredbv1alpha3.MSSQLSKU{Name: azure.ToString(in.Name)}
+	}
+	s.Tier = azure.LateInitializeStringPtrFromPtr(s.Tier, in.Tier)
+	s.Family = azure.LateInitializeStringPtrFromPtr(s.Family, in.Family)
+	s.Capacity = azure.LateInitializeIntPtrFromInt32Ptr(s.Capacity, in.Capacity)
+	return s
+}
+
+// isMSSQLSKUUpToDate returns true if the supplied Azure Sku matches the
+// supplied SKU spec. Unset fields of the spec are ignored.
+func isMSSQLSKUUpToDate(s *azuredbv1alpha3.MSSQLSKU, in *sql.Sku) bool {
+	if s == nil {
+		return true
+	}
+	if in == nil {
+		return false
+	}
+	switch {
+	case s.Name != azure.ToString(in.Name):
+		return false
+	case s.Tier != nil && *s.Tier != azure.ToString(in.Tier):
+		return false
+	case s.Family != nil && *s.Family != azure.ToString(in.Family):
+		return false
+	case s.Capacity != nil && *s.Capacity != azure.ToInt(in.Capacity):
+		return false
+	}
+	return true
+}
+
+// NewMSSQLDatabaseParameters returns the Azure Database an Azure SQL database
+// should be created or updated with.
+func NewMSSQLDatabaseParameters(p azuredbv1alpha3.MSSQLDatabaseParameters) sql.Database {
+	return sql.Database{
+		Location: azure.ToStringPtr(p.Location),
+		Sku:      toMSSQLSKU(p.SKU),
+		DatabaseProperties: &sql.DatabaseProperties{
+			Collation:     p.Collation,
+			MaxSizeBytes:  p.MaxSizeBytes,
+			ElasticPoolID: p.ElasticPoolID,
+			ZoneRedundant: p.ZoneRedundant,
+			LicenseType:   sql.DatabaseLicenseType(azure.ToString(p.LicenseType)),
+		},
+		Tags: azure.ToStringPtrMap(p.Tags),
+	}
+}
+
+// LateInitializeMSSQLDatabase fills the empty fields of the supplied Azure
+// SQL database spec with the values of the supplied Azure Database.
+func LateInitializeMSSQLDatabase(p *azuredbv1alpha3.MSSQLDatabaseParameters, in sql.Database) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	p.SKU = lateInitializeMSSQLSKU(p.SKU, in.Sku)
+	if in.DatabaseProperties == nil {
+		return
+	}
+	p.Collation = azure.LateInitializeStringPtrFromPtr(p.Collation, in.Collation)
+	p.ElasticPoolID = azure.LateInitializeStringPtrFromPtr(p.ElasticPoolID, in.ElasticPoolID)
+	p.ZoneRedundant = azure.LateInitializeBoolPtrFromPtr(p.ZoneRedundant, in.ZoneRedundant)
+	if p.MaxSizeBytes == nil {
+		p.MaxSizeBytes = in.MaxSizeBytes
+	}
+	if in.LicenseType != "" {
+		p.LicenseType = azure.LateInitializeStringPtrFromVal(p.LicenseType, string(in.LicenseType))
+	}
+}
+
+// IsMSSQLDatabaseUpToDate returns true if the supplied Azure Database matches
+// the supplied Azure SQL database spec.
+func IsMSSQLDatabaseUpToDate(p azuredbv1alpha3.MSSQLDatabaseParameters, in sql.Database) bool { // nolint:gocyclo
+	if in.DatabaseProperties == nil {
+		return false
+	}
+	switch {
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+		return false
+	case !isMSSQLSKUUpToDate(p.SKU, in.Sku):
+		return false
+	case p.ElasticPoolID != nil && !strings.EqualFold(*p.ElasticPoolID, azure.ToString(in.ElasticPoolID)):
+		return false
+	case p.MaxSizeBytes != nil && !reflect.DeepEqual(p.MaxSizeBytes, in.MaxSizeBytes):
+		return false
+	case p.ZoneRedundant != nil && *p.ZoneRedundant != azure.ToBool(in.ZoneRedundant):
+		return false
+	case p.LicenseType != nil && *p.LicenseType != string(in.LicenseType):
+		return false
+	}
+	return true
+}
+
+// UpdateMSSQLDatabaseObservation updates the supplied observation with the
+// values of the supplied Azure Database.
+func UpdateMSSQLDatabaseObservation(o *azuredbv1alpha3.MSSQLDatabaseObservation, in sql.Database) {
+	o.ID = azure.ToString(in.ID)
+	o.Type = azure.ToString(in.Type)
+	if in.DatabaseProperties == nil {
+		return
+	}
+	o.Status = string(in.Status)
+	o.CurrentServiceObjectiveName = azure.ToString(in.CurrentServiceObjectiveName)
+	if in.DatabaseID != nil {
+		o.DatabaseID = in.DatabaseID.String()
+	}
+}
+
+// NewMSSQLTransparentDataEncryptionParameters returns the Azure
+// TransparentDataEncryption that sets the supplied status.
+func NewMSSQLTransparentDataEncryptionParameters(status string) sql.TransparentDataEncryption {
+	return sql.TransparentDataEncryption{
+		TransparentDataEncryptionProperties: &sql.TransparentDataEncryptionProperties{
+			Status: sql.TransparentDataEncryptionStatus(status),
+		},
+	}
+}
+
+// MSSQLTransparentDataEncryptionStatus returns the status of the supplied
+// Azure TransparentDataEncryption.
+func MSSQLTransparentDataEncryptionStatus(in sql.TransparentDataEncryption) string {
+	if in.TransparentDataEncryptionProperties == nil {
+		return ""
+	}
+	return string(in.Status)
+}
+
+// NewMSSQLElasticPoolParameters returns the Azure ElasticPool an Azure SQL
+// elastic pool should be created or updated with.
+func NewMSSQLElasticPoolParameters(p azuredbv1alpha3.MSSQLElasticPoolParameters) sql.ElasticPool {
+	return sql.ElasticPool{
+		Location: azure.ToStringPtr(p.Location),
+		Sku:      toMSSQLSKU(&p.SKU),
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			MaxSizeBytes:        p.MaxSizeBytes,
+			PerDatabaseSettings: toMSSQLElasticPoolPerDatabaseSettings(p.PerDatabaseSettings),
+			ZoneRedundant:       p.ZoneRedundant,
+			LicenseType:         sql.ElasticPoolLicenseType(azure.ToString(p.LicenseType)),
+		},
+		Tags: azure.ToStringPtrMap(p.Tags),
+	}
+}
+
+func toMSSQLElasticPoolPerDatabaseSettings(s *azuredbv1alpha3.MSSQLElasticPoolPerDatabaseSettings) *sql.ElasticPoolPerDatabaseSettings {
+	if s == nil {
+		return nil
+	}
+	return &sql.ElasticPoolPerDatabaseSettings{
+		MinCapacity: toFloat64Ptr(s.MinCapacity),
+		MaxCapacity: toFloat64Ptr(s.MaxCapacity),
+	}
+}
+
+// toFloat64Ptr parses the supplied decimal string. Strings that are not
+// decimals are treated as unset; the API server rejects them.
+func toFloat64Ptr(s *string) *float64 {
+	if s == nil {
+		return nil
+	}
+	f, err := strconv.ParseFloat(*s, 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
+
+func fromFloat64Ptr(f *float64) *string {
+	if f == nil {
+		return nil
+	}
+	s := strconv.FormatFloat(*f, 'f', -1, 64)
+	return &s
+}
+
+// LateInitializeMSSQLElasticPool fills the empty fields of the supplied Azure
+// SQL elastic pool spec with the values of the supplied Azure ElasticPool.
+func LateInitializeMSSQLElasticPool(p *azuredbv1alpha3.MSSQLElasticPoolParameters, in sql.ElasticPool) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	if in.Sku != nil {
+		p.SKU = *lateInitializeMSSQLSKU(&p.SKU, in.Sku)
+	}
+	if in.ElasticPoolProperties == nil {
+		return
+	}
+	p.ZoneRedundant = azure.LateInitializeBoolPtrFromPtr(p.ZoneRedundant, in.ZoneRedundant)
+	if p.MaxSizeBytes == nil {
+		p.MaxSizeBytes = in.MaxSizeBytes
+	}
+	if in.LicenseType != "" {
+		p.LicenseType = azure.LateInitializeStringPtrFromVal(p.LicenseType, string(in.LicenseType))
+	}
+	if in.PerDatabaseSettings != nil {
+		if p.PerDatabaseSettings == nil {
+			p.PerDatabaseSettings = &azuredbv1alpha3.MSSQLElasticPoolPerDatabaseSettings{}
+		}
+		p.PerDatabaseSettings.MinCapacity = azure.LateInitializeStringPtrFromPtr(p.PerDatabaseSettings.MinCapacity, fromFloat64Ptr(in.PerDatabaseSettings.MinCapacity))
+		p.PerDatabaseSettings.MaxCapacity = azure.LateInitializeStringPtrFromPtr(p.PerDatabaseSettings.MaxCapacity, fromFloat64Ptr(in.PerDatabaseSettings.MaxCapacity))
+	}
+}
+
+// IsMSSQLElasticPoolUpToDate returns true if the supplied Azure ElasticPool
+// matches the supplied Azure SQL elastic pool spec.
+func IsMSSQLElasticPoolUpToDate(p azuredbv1alpha3.MSSQLElasticPoolParameters, in sql.ElasticPool) bool { // nolint:gocyclo
+	if in.ElasticPoolProperties == nil {
+		return false
+	}
+	switch {
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+		return false
+	case !isMSSQLSKUUpToDate(&p.SKU, in.Sku):
+		return false
+	case p.MaxSizeBytes != nil && !reflect.DeepEqual(p.MaxSizeBytes, in.MaxSizeBytes):
+		return false
+	case p.ZoneRedundant != nil && *p.ZoneRedundant != azure.ToBool(in.ZoneRedundant):
+		return false
+	case p.LicenseType != nil && *p.LicenseType != string(in.LicenseType):
+		return false
+	case !isMSSQLElasticPoolPerDatabaseSettingsUpToDate(p.PerDatabaseSettings, in.PerDatabaseSettings):
+		return false
+	}
+	return true
+}
+
+func isMSSQLElasticPoolPerDatabaseSettingsUpToDate(s *azuredbv1alpha3.MSSQLElasticPoolPerDatabaseSettings, in *sql.ElasticPoolPerDatabaseSettings) bool {
+	if s == nil {
+		return true
+	}
+	if in == nil {
+		return false
+	}
+	switch {
+	case s.MinCapacity != nil && !reflect.DeepEqual(toFloat64Ptr(s.MinCapacity), in.MinCapacity):
+		return false
+	case s.MaxCapacity != nil && !reflect.DeepEqual(toFloat64Ptr(s.MaxCapacity), in.MaxCapacity):
+		return false
+	}
+	return true
+}
+
+// UpdateMSSQLElasticPoolObservation updates the supplied observation with the
+// values of the supplied Azure ElasticPool.
+func UpdateMSSQLElasticPoolObservation(o *azuredbv1alpha3.MSSQLElasticPoolObservation, in sql.ElasticPool) {
+	o.ID = azure.ToString(in.ID)
+	o.Type = azure.ToString(in.Type)
+	if in.ElasticPoolProperties == nil {
+		return
+	}
+	o.State = string(in.State)
+}
+
+// NewMSSQLFirewallRuleParameters returns an Azure FirewallRule object from a
+// firewall rule spec.
+func NewMSSQLFirewallRuleParameters(r *azuredbv1alpha3.MSSQLServerFirewallRule) sql.FirewallRule {
+	return sql.FirewallRule{
+		FirewallRuleProperties: &sql.FirewallRuleProperties{
+			StartIPAddress: azure.ToStringPtr(r.Spec.ForProvider.StartIPAddress),
+			EndIPAddress:   azure.ToStringPtr(r.Spec.ForProvider.EndIPAddress),
+		},
+	}
+}
+
+// MSSQLServerFirewallRuleIsUpToDate returns true if the supplied FirewallRule
+// appears to be up to date with the supplied MSSQLServerFirewallRule.
+func MSSQLServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.MSSQLServerFirewallRule, az sql.FirewallRule) bool {
+	if az.FirewallRuleProperties == nil {
+		return false
+	}
+	return kube.Spec.ForProvider.StartIPAddress == azure.ToString(az.StartIPAddress) &&
+		kube.Spec.ForProvider.EndIPAddress == azure.ToString(az.EndIPAddress)
+}
+
+// NewMSSQLVirtualNetworkRuleParameters returns an Azure VirtualNetworkRule
+// object from a virtual network spec.
+func NewMSSQLVirtualNetworkRuleParameters(v *azuredbv1alpha3.MSSQLServerVirtualNetworkRule) sql.VirtualNetworkRule {
+	return sql.VirtualNetworkRule{
+		VirtualNetworkRuleProperties: &sql.VirtualNetworkRuleProperties{
+			VirtualNetworkSubnetID:           azure.ToStringPtr(v.Spec.VirtualNetworkRuleProperties.VirtualNetworkSubnetID),
+			IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(v.Spec.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint, azure.FieldRequired),
+		},
+	}
+}
+
+// MSSQLServerVirtualNetworkRuleNeedsUpdate determines if a virtual network
+// rule needs to be updated.
+func MSSQLServerVirtualNetworkRuleNeedsUpdate(kube *azuredbv1alpha3.MSSQLServerVirtualNetworkRule, az sql.VirtualNetworkRule) bool {
+	if az.VirtualNetworkRuleProperties == nil {
+		return true
+	}
+	up := NewMSSQLVirtualNetworkRuleParameters(kube)
+	switch {
+	case !strings.EqualFold(azure.ToString(up.VirtualNetworkSubnetID), azure.ToString(az.VirtualNetworkSubnetID)):
+		return true
+	case !reflect.DeepEqual(up.IgnoreMissingVnetServiceEndpoint, az.IgnoreMissingVnetServiceEndpoint):
+		return true
+	}
+	return false
+}
+
+// UpdateMSSQLVirtualNetworkRuleStatusFromAzure updates the status related to
+// the external Azure SQL virtual network rule in the VirtualNetworkStatus.
+func UpdateMSSQLVirtualNetworkRuleStatusFromAzure(v *azuredbv1alpha3.MSSQLServerVirtualNetworkRule, az sql.VirtualNetworkRule) {
+	v.Status.ID = azure.ToString(az.ID)
+	v.Status.Type = azure.ToString(az.Type)
+	if az.VirtualNetworkRuleProperties != nil {
+		v.Status.State = string(az.State)
+	}
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestNewMSSQLServerAzureADAdministratorParameters(t *testing.T) {
+	login := "cooladmins"
+	oid := uuid.Must(uuid.FromString("0c2b6d7a-2a4c-4e5f-8c6c-3a1f2e6b9d10"))
+	tid := uuid.Must(uuid.FromString("6a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"))
+
+	type want struct {
+		a   sql.ServerAzureADAdministrator
+		err error
+	}
+
+	cases := map[string]struct {
+		a    v1alpha3.MSSQLServerAzureADAdministrator
+		want want
+	}{
+		"Successful": {
+			a: v1alpha3.MSSQLServerAzureADAdministrator{
+				Login:                     login,
+				ObjectID:                  oid.String(),
+				TenantID:                  azure.ToStringPtr(tid.String()),
+				AzureADOnlyAuthentication: azure.ToBoolPtr(true),
+			},
+			want: want{
+				a: sql.ServerAzureADAdministrator{
+					AdministratorProperties: &sql.AdministratorProperties{
+						AdministratorType:         azure.ToStringPtr(AdministratorTypeActiveDirectory),
+						Login:                     azure.ToStringPtr(login),
+						Sid:                       &oid,
+						TenantID:                  &tid,
+						AzureADOnlyAuthentication: azure.ToBoolPtr(true),
+					},
+				},
+			},
+		},
+		"InvalidObjectID": {
+			a: v1alpha3.MSSQLServerAzureADAdministrator{
+				Login:    login,
+				ObjectID: "not-a-uuid",
+			},
+			want: want{
+				err: errors.Wrap(errors.New("uuid: incorrect UUID length: not-a-uuid"), errParseObjectID),
+			},
+		},
+		"InvalidTenantID": {
+			a: v1alpha3.MSSQLServerAzureADAdministrator{
+				Login:    login,
+				ObjectID: oid.String(),
+				TenantID: azure.ToStringPtr("not-a-uuid"),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("uuid: incorrect UUID length: not-a-uuid"), errParseTenantID),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewMSSQLServerAzureADAdministratorParameters(tc.a)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("NewMSSQLServerAzureADAdministratorParameters(...): -want error, +got error\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.a, got); diff != "" {
+				t.Errorf("NewMSSQLServerAzureADAdministratorParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMSSQLServerAzureADAdministratorUpToDate(t *testing.T) {
+	login := "cooladmins"
+	oid := uuid.Must(uuid.FromString("0c2b6d7a-2a4c-4e5f-8c6c-3a1f2e6b9d10"))
+	admin := sql.ServerAzureADAdministrator{
+		AdministratorProperties: &sql.AdministratorProperties{
+			Login:                     azure.ToStringPtr(login),
+			Sid:                       &oid,
+			AzureADOnlyAuthentication: azure.ToBoolPtr(false, azure.FieldRequired),
+		},
+	}
+
+	cases := map[string]struct {
+		a    *v1alpha3.MSSQLServerAzureADAdministrator
+		az   sql.ServerAzureADAdministrator
+		want bool
+	}{
+		"NotSpecified": {
+			az:   sql.ServerAzureADAdministrator{},
+			want: true,
+		},
+		"NotFound": {
+			a:    &v1alpha3.MSSQLServerAzureADAdministrator{Login: login, ObjectID: oid.String()},
+			az:   sql.ServerAzureADAdministrator{},
+			want: false,
+		},
+		"UpToDate": {
+			a:    &v1alpha3.MSSQLServerAzureADAdministrator{Login: login, ObjectID: "0C2B6D7A-2A4C-4E5F-8C6C-3A1F2E6B9D10"},
+			az:   admin,
+			want: true,
+		},
+		"LoginNeedsUpdate": {
+			a:    &v1alpha3.MSSQLServerAzureADAdministrator{Login: "otheradmins", ObjectID: oid.String()},
+			az:   admin,
+			want: false,
+		},
+		"AzureADOnlyAuthenticationNeedsUpdate": {
+			a:    &v1alpha3.MSSQLServerAzureADAdministrator{Login: login, ObjectID: oid.String(), AzureADOnlyAuthentication: azure.ToBoolPtr(true)},
+			az:   admin,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMSSQLServerAzureADAdministratorUpToDate(tc.a, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMSSQLServerAzureADAdministratorUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMSSQLDatabaseUpToDate(t *testing.T) {
+	pool := "/subscriptions/cool/resourceGroups/rg/providers/Microsoft.Sql/servers/srv/elasticPools/pool"
+	db := func(sku *sql.Sku, elasticPoolID *string) sql.Database {
+		return sql.Database{
+			Sku: sku,
+			DatabaseProperties: &sql.DatabaseProperties{
+				ElasticPoolID: elasticPoolID,
+				MaxSizeBytes:  to.Int64Ptr(1073741824),
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.MSSQLDatabaseParameters
+		az   sql.Database
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha3.MSSQLDatabaseParameters{
+				SKU:           &v1alpha3.MSSQLSKU{Name: "ElasticPool"},
+				ElasticPoolID: azure.ToStringPtr(pool),
+			},
+			az:   db(&sql.Sku{Name: azure.ToStringPtr("ElasticPool"), Tier: azure.ToStringPtr("Standard")}, azure.ToStringPtr(pool)),
+			want: true,
+		},
+		"SKUNeedsUpdate": {
+			p: v1alpha3.MSSQLDatabaseParameters{
+				SKU: &v1alpha3.MSSQLSKU{Name: "S0", Capacity: to.IntPtr(10)},
+			},
+			az:   db(&sql.Sku{Name: azure.ToStringPtr("S0"), Capacity: azure.ToInt32Ptr(20)}, nil),
+			want: false,
+		},
+		"ElasticPoolNeedsUpdate": {
+			p: v1alpha3.MSSQLDatabaseParameters{
+				ElasticPoolID: azure.ToStringPtr(pool),
+			},
+			az:   db(nil, nil),
+			want: false,
+		},
+		"MaxSizeBytesNeedsUpdate": {
+			p: v1alpha3.MSSQLDatabaseParameters{
+				MaxSizeBytes: to.Int64Ptr(2147483648),
+			},
+			az:   db(nil, nil),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMSSQLDatabaseUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMSSQLDatabaseUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeMSSQLElasticPool(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.MSSQLElasticPoolParameters
+		az   sql.ElasticPool
+		want v1alpha3.MSSQLElasticPoolParameters
+	}{
+		"Filled": {
+			p: v1alpha3.MSSQLElasticPoolParameters{
+				SKU: v1alpha3.MSSQLSKU{Name: "StandardPool"},
+			},
+			az: sql.ElasticPool{
+				Sku: &sql.Sku{Name: azure.ToStringPtr("StandardPool"), Tier: azure.ToStringPtr("Standard"), Capacity: azure.ToInt32Ptr(50)},
+				ElasticPoolProperties: &sql.ElasticPoolProperties{
+					LicenseType: sql.ElasticPoolLicenseTypeLicenseIncluded,
+					PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{
+						MinCapacity: to.Float64Ptr(0),
+						MaxCapacity: to.Float64Ptr(0.5),
+					},
+				},
+			},
+			want: v1alpha3.MSSQLElasticPoolParameters{
+				SKU:         v1alpha3.MSSQLSKU{Name: "StandardPool", Tier: azure.ToStringPtr("Standard"), Capacity: to.IntPtr(50)},
+				LicenseType: azure.ToStringPtr(string(sql.ElasticPoolLicenseTypeLicenseIncluded)),
+				PerDatabaseSettings: &v1alpha3.MSSQLElasticPoolPerDatabaseSettings{
+					MinCapacity: azure.ToStringPtr("0"),
+					MaxCapacity: azure.ToStringPtr("0.5"),
+				},
+			},
+		},
+		"NotOverwritten": {
+			p: v1alpha3.MSSQLElasticPoolParameters{
+				SKU: v1alpha3.MSSQLSKU{Name: "StandardPool", Capacity: to.IntPtr(100)},
+				PerDatabaseSettings: &v1alpha3.MSSQLElasticPoolPerDatabaseSettings{
+					MaxCapacity: azure.ToStringPtr("10"),
+				},
+			},
+			az: sql.ElasticPool{
+				Sku: &sql.Sku{Name: azure.ToStringPtr("StandardPool"), Capacity: azure.ToInt32Ptr(50)},
+				ElasticPoolProperties: &sql.ElasticPoolProperties{
+					PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{
+						MaxCapacity: to.Float64Ptr(50),
+					},
+				},
+			},
+			want: v1alpha3.MSSQLElasticPoolParameters{
+				SKU: v1alpha3.MSSQLSKU{Name: "StandardPool", Capacity: to.IntPtr(100)},
+				PerDatabaseSettings: &v1alpha3.MSSQLElasticPoolPerDatabaseSettings{
+					MaxCapacity: azure.ToStringPtr("10"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeMSSQLElasticPool(&tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeMSSQLElasticPool(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMSSQLElasticPoolUpToDate(t *testing.T) {
+	pool := sql.ElasticPool{
+		Sku: &sql.Sku{Name: azure.ToStringPtr("StandardPool"), Capacity: azure.ToInt32Ptr(50)},
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{
+				MinCapacity: to.Float64Ptr(0),
+				MaxCapacity: to.Float64Ptr(10),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.MSSQLElasticPoolParameters
+		az   sql.ElasticPool
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha3.MSSQLElasticPoolParameters{
+				SKU: v1alpha3.MSSQLSKU{Name: "StandardPool", Capacity: to.IntPtr(50)},
+				PerDatabaseSettings: &v1alpha3.MSSQLElasticPoolPerDatabaseSettings{
+					MaxCapacity: azure.ToStringPtr("10.0"),
+				},
+			},
+			az:   pool,
+			want: true,
+		},
+		"CapacityNeedsUpdate": {
+			p: v1alpha3.MSSQLElasticPoolParameters{
+				SKU: v1alpha3.MSSQLSKU{Name: "StandardPool", Capacity: to.IntPtr(100)},
+			},
+			az:   pool,
+			want: false,
+		},
+		"PerDatabaseSettingsNeedUpdate": {
+			p: v1alpha3.MSSQLElasticPoolParameters{
+				SKU: v1alpha3.MSSQLSKU{Name: "StandardPool"},
+				PerDatabaseSettings: &v1alpha3.MSSQLElasticPoolPerDatabaseSettings{
+					MinCapacity: azure.ToStringPtr("5"),
+				},
+			},
+			az:   pool,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMSSQLElasticPoolUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMSSQLElasticPoolUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,10 +22,13 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
@@ -56,7 +59,10 @@ type MySQLServerAPI interface {
 	GetServer(ctx context.Context, s *azuredbv1beta1.MySQLServer) (mysql.Server, error)
 	CreateServer(ctx context.Context, s *azuredbv1beta1.MySQLServer, adminPassword string) error
 	UpdateServer(ctx context.Context, s *azuredbv1beta1.MySQLServer) error
+	RotateAdministratorLoginPassword(ctx context.Context, s *azuredbv1beta1.MySQLServer, password string) error
 	DeleteServer(ctx context.Context, s *azuredbv1beta1.MySQLServer) error
+	GetAzureADAdministrator(ctx context.Context, s *azuredbv1beta1.MySQLServer) (mysql.ServerAdministratorResource, error)
+	UpdateAzureADAdministrator(ctx context.Context, s *azuredbv1beta1.MySQLServer) error
 	GetRESTClient() autorest.Sender
 }
 
@@ -64,12 +70,14 @@ type MySQLServerAPI interface {
 // interface for MySQL that calls Azure API.
 type MySQLServerClient struct {
 	mysql.ServersClient
+	Administrators mysql.ServerAdministratorsClient
 }
 
 // NewMySQLServerClient creates and initializes a MySQLServerClient instance.
-func NewMySQLServerClient(cl mysql.ServersClient) *MySQLServerClient {
+func NewMySQLServerClient(servers mysql.ServersClient, admins mysql.ServerAdministratorsClient) *MySQLServerClient {
 	return &MySQLServerClient{
-		ServersClient: cl,
+		ServersClient:  servers,
+		Administrators: admins,
 	}
 }
 
@@ -89,12 +97,14 @@ func toMySQLProperties(s v1beta1.SQLServerParameters, adminPassword string) mysq
 	switch createMode {
 	case azuredbv1beta1.CreateModePointInTimeRestore:
 		return &mysql.ServerPropertiesForRestore{
-			MinimalTLSVersion:  mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-			Version:            mysql.ServerVersion(s.Version),
-			SslEnforcement:     mysql.SslEnforcementEnum(s.SSLEnforcement),
-			CreateMode:         mysql.CreateModePointInTimeRestore,
-			RestorePointInTime: safeDate(s.RestorePointInTime),
-			SourceServerID:     s.SourceServerID,
+			MinimalTLSVersion:        mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
+			Version:                  mysql.ServerVersion(s.Version),
+			SslEnforcement:           mysql.SslEnforcementEnum(s.SSLEnforcement),
+			InfrastructureEncryption: mysql.InfrastructureEncryption(azure.ToString(s.InfrastructureEncryption)),
+			PublicNetworkAccess:      mysql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:               mysql.CreateModePointInTimeRestore,
+			RestorePointInTime:       safeDate(s.RestorePointInTime),
+			SourceServerID:           s.SourceServerID,
 			StorageProfile: &mysql.StorageProfile{
 				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 				GeoRedundantBackup:  mysql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
@@ -104,11 +114,13 @@ func toMySQLProperties(s v1beta1.SQLServerParameters, adminPassword string) mysq
 		}
 	case azuredbv1beta1.CreateModeGeoRestore:
 		return &mysql.ServerPropertiesForGeoRestore{
-			MinimalTLSVersion: mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-			Version:           mysql.ServerVersion(s.Version),
-			SslEnforcement:    mysql.SslEnforcementEnum(s.SSLEnforcement),
-			CreateMode:        mysql.CreateModeGeoRestore,
-			SourceServerID:    s.SourceServerID,
+			MinimalTLSVersion:        mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
+			Version:                  mysql.ServerVersion(s.Version),
+			SslEnforcement:           mysql.SslEnforcementEnum(s.SSLEnforcement),
+			InfrastructureEncryption: mysql.InfrastructureEncryption(azure.ToString(s.InfrastructureEncryption)),
+			PublicNetworkAccess:      mysql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:               mysql.CreateModeGeoRestore,
+			SourceServerID:           s.SourceServerID,
 			StorageProfile: &mysql.StorageProfile{
 				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 				GeoRedundantBackup:  mysql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
@@ -118,11 +130,13 @@ func toMySQLProperties(s v1beta1.SQLServerParameters, adminPassword string) mysq
 		}
 	case azuredbv1beta1.CreateModeReplica:
 		return &mysql.ServerPropertiesForReplica{
-			MinimalTLSVersion: mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-			Version:           mysql.ServerVersion(s.Version),
-			SslEnforcement:    mysql.SslEnforcementEnum(s.SSLEnforcement),
-			CreateMode:        mysql.CreateModeReplica,
-			SourceServerID:    s.SourceServerID,
+			MinimalTLSVersion:        mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
+			Version:                  mysql.ServerVersion(s.Version),
+			SslEnforcement:           mysql.SslEnforcementEnum(s.SSLEnforcement),
+			InfrastructureEncryption: mysql.InfrastructureEncryption(azure.ToString(s.InfrastructureEncryption)),
+			PublicNetworkAccess:      mysql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:               mysql.CreateModeReplica,
+			SourceServerID:           s.SourceServerID,
 			StorageProfile: &mysql.StorageProfile{
 				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 				GeoRedundantBackup:  mysql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
@@ -139,6 +153,8 @@ func toMySQLProperties(s v1beta1.SQLServerParameters, adminPassword string) mysq
 			AdministratorLoginPassword: &adminPassword,
 			Version:                    mysql.ServerVersion(s.Version),
 			SslEnforcement:             mysql.SslEnforcementEnum(s.SSLEnforcement),
+			InfrastructureEncryption:   mysql.InfrastructureEncryption(azure.ToString(s.InfrastructureEncryption)),
+			PublicNetworkAccess:        mysql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
 			CreateMode:                 mysql.CreateModeDefault,
 			StorageProfile: &mysql.StorageProfile{
 				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
@@ -174,15 +190,35 @@ func (c *MySQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1
 	return nil
 }
 
+// RotateAdministratorLoginPassword sends the supplied administrator login
+// password to a MySQL Server.
+func (c *MySQLServerClient) RotateAdministratorLoginPassword(ctx context.Context, cr *azuredbv1beta1.MySQLServer, password string) error {
+	updateParams := mysql.ServerUpdateParameters{
+		ServerUpdateParametersProperties: &mysql.ServerUpdateParametersProperties{
+			AdministratorLoginPassword: &password,
+		},
+	}
+	op, err := c.Update(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), updateParams)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.AdministratorLoginPasswordRotation.LastRotation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return nil
+}
+
 // UpdateServer updates a MySQL Server.
 func (c *MySQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.MySQLServer) error {
-	// TODO(muvaf): password update via Update call is supported by Azure but
-	// we don't support that.
+	// NOTE: The administrator login password is sent separately, by
+	// RotateAdministratorLoginPassword.
 	s := cr.Spec.ForProvider
 	properties := &mysql.ServerUpdateParametersProperties{
-		Version:           mysql.ServerVersion(s.Version),
-		MinimalTLSVersion: mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-		SslEnforcement:    mysql.SslEnforcementEnum(s.SSLEnforcement),
+		Version:             mysql.ServerVersion(s.Version),
+		MinimalTLSVersion:   mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
+		SslEnforcement:      mysql.SslEnforcementEnum(s.SSLEnforcement),
+		PublicNetworkAccess: mysql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
 		StorageProfile: &mysql.StorageProfile{
 			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 			GeoRedundantBackup:  mysql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
@@ -223,6 +259,34 @@ func (c *MySQLServerClient) DeleteServer(ctx context.Context, cr *azuredbv1beta1
 	return nil
 }
 
+// GetAzureADAdministrator retrieves the Azure AD administrator of the supplied
+// MySQL Server.
+func (c *MySQLServerClient) GetAzureADAdministrator(ctx context.Context, cr *azuredbv1beta1.MySQLServer) (mysql.ServerAdministratorResource, error) {
+	return c.Administrators.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+}
+
+// UpdateAzureADAdministrator sets the Azure AD administrator of the supplied
+// MySQL Server.
+func (c *MySQLServerClient) UpdateAzureADAdministrator(ctx context.Context, cr *azuredbv1beta1.MySQLServer) error {
+	s := cr.Spec.ForProvider
+	if s.AzureADAdministrator == nil {
+		return nil
+	}
+	p, err := NewMySQLServerAzureADAdministratorParameters(*s.AzureADAdministrator)
+	if err != nil {
+		return err
+	}
+	op, err := c.Administrators.CreateOrUpdate(ctx, s.ResourceGroupName, meta.GetExternalName(cr), p)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return nil
+}
+
 // NewMySQLVirtualNetworkRuleParameters returns an Azure VirtualNetworkRule object from a virtual network spec
 func NewMySQLVirtualNetworkRuleParameters(v *azuredbv1alpha3.MySQLServerVirtualNetworkRule) mysql.VirtualNetworkRule {
 	return mysql.VirtualNetworkRule{
@@ -275,6 +339,73 @@ func MySQLServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.MySQLServerFirewall
 	return cmp.Equal(up.FirewallRuleProperties, az.FirewallRuleProperties)
 }
 
+// NewMySQLConfigurationParameters returns an Azure Configuration object that
+// sets the value of a configuration spec.
+func NewMySQLConfigurationParameters(c *azuredbv1alpha3.MySQLServerConfiguration) mysql.Configuration {
+	return mysql.Configuration{
+		ConfigurationProperties: &mysql.ConfigurationProperties{
+			Value:  azure.ToStringPtr(c.Spec.ForProvider.Value, azure.FieldRequired),
+			Source: azure.ToStringPtr(ConfigurationSourceUserOverride),
+		},
+	}
+}
+
+// NewMySQLDefaultConfigurationParameters returns an Azure Configuration
+// object that resets a configuration to its default value.
+func NewMySQLDefaultConfigurationParameters() mysql.Configuration {
+	return mysql.Configuration{
+		ConfigurationProperties: &mysql.ConfigurationProperties{
+			Source: azure.ToStringPtr(ConfigurationSourceSystemDefault),
+		},
+	}
+}
+
+// MySQLServerConfigurationIsUpToDate returns true if the supplied
+// Configuration has the value of the supplied MySQLServerConfiguration.
+func MySQLServerConfigurationIsUpToDate(kube *azuredbv1alpha3.MySQLServerConfiguration, az mysql.Configuration) bool {
+	if az.ConfigurationProperties == nil {
+		return false
+	}
+	return kube.Spec.ForProvider.Value == azure.ToString(az.Value)
+}
+
+// UpdateMySQLConfigurationObservation updates the status of the supplied
+// configuration with the supplied Azure Configuration.
+func UpdateMySQLConfigurationObservation(o *azuredbv1alpha3.ConfigurationObservation, az mysql.Configuration) {
+	o.ID = azure.ToString(az.ID)
+	o.Type = azure.ToString(az.Type)
+	if az.ConfigurationProperties == nil {
+		return
+	}
+	o.Value = azure.ToString(az.Value)
+	o.DefaultValue = azure.ToString(az.DefaultValue)
+	o.DataType = azure.ToString(az.DataType)
+	o.AllowedValues = azure.ToString(az.AllowedValues)
+	o.Source = azure.ToString(az.Source)
+	o.Description = azure.ToString(az.Description)
+}
+
+// NewMySQLDatabaseParameters returns an Azure Database object from a database
+// spec.
+func NewMySQLDatabaseParameters(d *azuredbv1alpha3.MySQLDatabase) mysql.Database {
+	return mysql.Database{
+		DatabaseProperties: &mysql.DatabaseProperties{
+			Charset:   d.Spec.ForProvider.Charset,
+			Collation: d.Spec.ForProvider.Collation,
+		},
+	}
+}
+
+// LateInitializeMySQLDatabase fills the empty fields of the supplied database
+// spec with the charset and collation of the supplied Azure Database.
+func LateInitializeMySQLDatabase(p *azuredbv1alpha3.DatabaseParameters, in mysql.Database) {
+	if in.DatabaseProperties == nil {
+		return
+	}
+	p.Charset = azure.LateInitializeStringPtrFromPtr(p.Charset, in.Charset)
+	p.Collation = azure.LateInitializeStringPtrFromPtr(p.Collation, in.Collation)
+}
+
 // The name must match the specification of the SKU, so, we don't allow user
 // to specify an arbitrary name. The format is tier + family + cores, e.g. B_Gen4_1, GP_Gen5_8.
 
@@ -321,6 +452,8 @@ func LateInitializeMySQL(p *azuredbv1beta1.SQLServerParameters, in mysql.Server)
 	if p.SSLEnforcement == "" {
 		p.SSLEnforcement = string(in.SslEnforcement)
 	}
+	p.InfrastructureEncryption = azure.LateInitializeStringPtrFromVal(p.InfrastructureEncryption, string(in.InfrastructureEncryption))
+	p.PublicNetworkAccess = azure.LateInitializeStringPtrFromVal(p.PublicNetworkAccess, string(in.PublicNetworkAccess))
 }
 
 // IsMySQLUpToDate is used to report whether given mysql.Server is in
@@ -334,6 +467,8 @@ func IsMySQLUpToDate(p azuredbv1beta1.SQLServerParameters, in mysql.Server) bool
 		return false
 	case p.SSLEnforcement != string(in.SslEnforcement):
 		return false
+	case azure.ToString(p.PublicNetworkAccess) != string(in.PublicNetworkAccess):
+		return false
 	case p.Version != string(in.Version):
 		return false
 	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
@@ -355,3 +490,39 @@ func IsMySQLUpToDate(p azuredbv1beta1.SQLServerParameters, in mysql.Server) bool
 	}
 	return true
 }
+
+// NewMySQLServerAzureADAdministratorParameters returns the Azure
+// ServerAdministratorResource that corresponds to the supplied administrator.
+func NewMySQLServerAzureADAdministratorParameters(a azuredbv1beta1.AzureADAdministrator) (mysql.ServerAdministratorResource, error) {
+	sid, err := uuid.FromString(a.ObjectID)
+	if err != nil {
+		return mysql.ServerAdministratorResource{}, errors.Wrap(err, errParseObjectID)
+	}
+	tid, err := uuid.FromString(a.TenantID)
+	if err != nil {
+		return mysql.ServerAdministratorResource{}, errors.Wrap(err, errParseTenantID)
+	}
+	return mysql.ServerAdministratorResource{
+		ServerAdministratorProperties: &mysql.ServerAdministratorProperties{
+			AdministratorType: azure.ToStringPtr(AdministratorTypeActiveDirectory),
+			Login:             azure.ToStringPtr(a.Login),
+			Sid:               &sid,
+			TenantID:          &tid,
+		},
+	}, nil
+}
+
+// IsMySQLServerAzureADAdministratorUpToDate returns true if the supplied
+// Azure ServerAdministratorResource matches the supplied administrator. A nil
+// administrator is always up to date.
+func IsMySQLServerAzureADAdministratorUpToDate(a *azuredbv1beta1.AzureADAdministrator, in mysql.ServerAdministratorResource) bool {
+	if a == nil {
+		return true
+	}
+	if in.ServerAdministratorProperties == nil || in.Sid == nil || in.TenantID == nil {
+		return false
+	}
+	return a.Login == azure.ToString(in.Login) &&
+		strings.EqualFold(a.ObjectID, in.Sid.String()) &&
+		strings.EqualFold(a.TenantID, in.TenantID.String())
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database/mysqlflexible"
+)
+
+// MySQLFlexibleServerAPI represents the API interface for a MySQL flexible
+// server client.
+type MySQLFlexibleServerAPI interface {
+	GetServer(ctx context.Context, s *azuredbv1alpha3.MySQLFlexibleServer) (mysqlflexible.Server, error)
+	CreateServer(ctx context.Context, s *azuredbv1alpha3.MySQLFlexibleServer, adminPassword string) error
+	UpdateServer(ctx context.Context, s *azuredbv1alpha3.MySQLFlexibleServer, adminPassword string) error
+	DeleteServer(ctx context.Context, s *azuredbv1alpha3.MySQLFlexibleServer) error
+	GetRESTClient() autorest.Sender
+}
+
+// MySQLFlexibleServerClient is the concrete implementation of the
+// MySQLFlexibleServerAPI interface that calls Azure API.
+type MySQLFlexibleServerClient struct {
+	mysqlflexible.ServersClient
+}
+
+// NewMySQLFlexibleServerClient creates and initializes a
+// MySQLFlexibleServerClient instance.
+func NewMySQLFlexibleServerClient(cl mysqlflexible.ServersClient) *MySQLFlexibleServerClient {
+	return &MySQLFlexibleServerClient{
+		ServersClient: cl,
+	}
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *MySQLFlexibleServerClient) GetRESTClient() autorest.Sender {
+	return c.ServersClient.Client
+}
+
+// GetServer retrieves the requested MySQL flexible server.
+func (c *MySQLFlexibleServerClient) GetServer(ctx context.Context, cr *azuredbv1alpha3.MySQLFlexibleServer) (mysqlflexible.Server, error) {
+	return c.ServersClient.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+}
+
+// CreateServer creates a MySQL flexible server.
+func (c *MySQLFlexibleServerClient) CreateServer(ctx context.Context, cr *azuredbv1alpha3.MySQLFlexibleServer, adminPassword string) error {
+	s := cr.Spec.ForProvider
+	op, err := c.Create(ctx, s.ResourceGroupName, meta.GetExternalName(cr), NewMySQLFlexibleServerParameters(s, adminPassword))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return nil
+}
+
+// UpdateServer updates a MySQL flexible server. The administrator login
+// password is only sent if one is supplied.
+func (c *MySQLFlexibleServerClient) UpdateServer(ctx context.Context, cr *azuredbv1alpha3.MySQLFlexibleServer, adminPassword string) error {
+	s := cr.Spec.ForProvider
+	op, err := c.Update(ctx, s.ResourceGroupName, meta.GetExternalName(cr), NewMySQLFlexibleServerUpdateParameters(s, adminPassword))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return nil
+}
+
+// DeleteServer deletes the supplied MySQL flexible server.
+func (c *MySQLFlexibleServerClient) DeleteServer(ctx context.Context, cr *azuredbv1alpha3.MySQLFlexibleServer) error {
+	op, err := c.ServersClient.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodDelete,
+	}
+	return nil
+}
+
+// IsMySQLFlexibleServerCopy returns true if the supplied MySQL flexible server
+// spec creates a replica or restore of another server, which keeps the
+// administrator login and password of that server.
+func IsMySQLFlexibleServerCopy(s azuredbv1alpha3.MySQLFlexibleServerParameters) bool {
+	switch azure.ToString(s.CreateMode) {
+	case azuredbv1alpha3.FlexibleServerCreateModePointInTimeRestore, azuredbv1alpha3.FlexibleServerCreateModeReplica:
+		return true
+	}
+	return false
+}
+
+// NewMySQLFlexibleServerParameters returns the Azure Server a MySQL flexible
+// server should be created with.
+func NewMySQLFlexibleServerParameters(s azuredbv1alpha3.MySQLFlexibleServerParameters, adminPassword string) mysqlflexible.Server {
+	p := &mysqlflexible.ServerProperties{
+		Version:          s.Version,
+		AvailabilityZone: s.AvailabilityZone,
+		Storage: &mysqlflexible.Storage{
+			StorageSizeGB: azure.ToInt32PtrFromIntPtr(s.StorageSizeGB),
+			Iops:          azure.ToInt32PtrFromIntPtr(s.StorageIOPS),
+			AutoGrow:      s.StorageAutoGrow,
+		},
+		Backup: &mysqlflexible.Backup{
+			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.BackupRetentionDays),
+			GeoRedundantBackup:  s.GeoRedundantBackup,
+		},
+		Network: &mysqlflexible.Network{
+			DelegatedSubnetResourceID: s.DelegatedSubnetID,
+			PrivateDNSZoneResourceID:  s.PrivateDNSZoneID,
+		},
+		HighAvailability:  toMySQLFlexibleHighAvailability(s.HighAvailability),
+		MaintenanceWindow: toMySQLFlexibleMaintenanceWindow(s.MaintenanceWindow),
+		CreateMode:        s.CreateMode,
+	}
+	if IsMySQLFlexibleServerCopy(s) {
+		p.SourceServerResourceID = s.SourceServerID
+		p.RestorePointInTime = safeDate(s.RestorePointInTime)
+	} else {
+		p.AdministratorLogin = azure.ToStringPtr(s.AdministratorLogin)
+		p.AdministratorLoginPassword = azure.ToStringPtr(adminPassword)
+	}
+	return mysqlflexible.Server{
+		Location: azure.ToStringPtr(s.Location),
+		Sku: &mysqlflexible.Sku{
+			Name: azure.ToStringPtr(s.SKU.Name),
+			Tier: azure.ToStringPtr(s.SKU.Tier),
+		},
+		ServerProperties: p,
+		Tags:             azure.ToStringPtrMap(s.Tags),
+	}
+}
+
+// NewMySQLFlexibleServerUpdateParameters returns the Azure ServerForUpdate a
+// MySQL flexible server should be updated with.
+func NewMySQLFlexibleServerUpdateParameters(s azuredbv1alpha3.MySQLFlexibleServerParameters, adminPassword string) mysqlflexible.ServerForUpdate {
+	return mysqlflexible.ServerForUpdate{
+		Sku: &mysqlflexible.Sku{
+			Name: azure.ToStringPtr(s.SKU.Name),
+			Tier: azure.ToStringPtr(s.SKU.Tier),
+		},
+		ServerPropertiesForUpdate: &mysqlflexible.ServerPropertiesForUpdate{
+			AdministratorLoginPassword: azure.ToStringPtr(adminPassword),
+			Storage: &mysqlflexible.Storage{
+				StorageSizeGB: azure.ToInt32PtrFromIntPtr(s.StorageSizeGB),
+				Iops:          azure.ToInt32PtrFromIntPtr(s.StorageIOPS),
+				AutoGrow:      s.StorageAutoGrow,
+			},
+			Backup: &mysqlflexible.Backup{
+				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.BackupRetentionDays),
+			},
+			HighAvailability:  toMySQLFlexibleHighAvailability(s.HighAvailability),
+			MaintenanceWindow: toMySQLFlexibleMaintenanceWindow(s.MaintenanceWindow),
+		},
+		Tags: azure.ToStringPtrMap(s.Tags),
+	}
+}
+
+func toMySQLFlexibleHighAvailability(ha *azuredbv1alpha3.FlexibleServerHighAvailability) *mysqlflexible.HighAvailability {
+	if ha == nil {
+		return nil
+	}
+	return &mysqlflexible.HighAvailability{
+		Mode:                    azure.ToStringPtr(ha.Mode),
+		StandbyAvailabilityZone: ha.StandbyAvailabilityZone,
+	}
+}
+
+func toMySQLFlexibleMaintenanceWindow(mw *azuredbv1alpha3.FlexibleServerMaintenanceWindow) *mysqlflexible.MaintenanceWindow {
+	if mw == nil {
+		return nil
+	}
+	return &mysqlflexible.MaintenanceWindow{
+		CustomWindow: azure.ToStringPtr(customWindowEnabled),
+		DayOfWeek:    azure.ToInt32Ptr(mw.DayOfWeek, azure.FieldRequired),
+		StartHour:    azure.ToInt32Ptr(mw.StartHour, azure.FieldRequired),
+		StartMinute:  azure.ToInt32Ptr(mw.StartMinute, azure.FieldRequired),
+	}
+}
+
+// LateInitializeMySQLFlexibleServer fills the empty fields of the supplied
+// MySQL flexible server spec with the values of the supplied Azure Server.
+func LateInitializeMySQLFlexibleServer(p *azuredbv1alpha3.MySQLFlexibleServerParameters, in mysqlflexible.Server) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	if in.ServerProperties == nil {
+		return
+	}
+	if p.AdministratorLogin == "" {
+		p.AdministratorLogin = azure.ToString(in.AdministratorLogin)
+	}
+	p.Version = azure.LateInitializeStringPtrFromPtr(p.Version, in.Version)
+	p.AvailabilityZone = azure.LateInitializeStringPtrFromPtr(p.AvailabilityZone, in.ServerProperties.AvailabilityZone)
+	if in.Storage != nil {
+		p.StorageSizeGB = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageSizeGB, in.Storage.StorageSizeGB)
+		p.StorageIOPS = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageIOPS, in.Storage.Iops)
+		p.StorageAutoGrow = azure.LateInitializeStringPtrFromPtr(p.StorageAutoGrow, in.Storage.AutoGrow)
+	}
+	if in.Backup != nil {
+		p.BackupRetentionDays = azure.LateInitializeIntPtrFromInt32Ptr(p.BackupRetentionDays, in.Backup.BackupRetentionDays)
+		p.GeoRedundantBackup = azure.LateInitializeStringPtrFromPtr(p.GeoRedundantBackup, in.Backup.GeoRedundantBackup)
+	}
+	if p.HighAvailability == nil && in.ServerProperties.HighAvailability != nil && in.ServerProperties.HighAvailability.Mode != nil {
+		p.HighAvailability = &azuredbv1alpha3.FlexibleServerHighAvailability{
+			Mode:                    azure.ToString(in.ServerProperties.HighAvailability.Mode),
+			StandbyAvailabilityZone: in.ServerProperties.HighAvailability.StandbyAvailabilityZone,
+		}
+	}
+	if p.MaintenanceWindow == nil && in.MaintenanceWindow != nil && azure.ToString(in.MaintenanceWindow.CustomWindow) == customWindowEnabled {
+		p.MaintenanceWindow = &azuredbv1alpha3.FlexibleServerMaintenanceWindow{
+			DayOfWeek:   azure.ToInt(in.MaintenanceWindow.DayOfWeek),
+			StartHour:   azure.ToInt(in.MaintenanceWindow.StartHour),
+			StartMinute: azure.ToInt(in.MaintenanceWindow.StartMinute),
+		}
+	}
+}
+
+// IsMySQLFlexibleServerUpToDate returns true if the supplied Azure Server
+// matches the supplied MySQL flexible server spec.
+func IsMySQLFlexibleServerUpToDate(p azuredbv1alpha3.MySQLFlexibleServerParameters, in mysqlflexible.Server) bool { // nolint:gocyclo
+	if in.ServerProperties == nil || in.Sku == nil {
+		return false
+	}
+	switch {
+	case p.SKU.Name != azure.ToString(in.Sku.Name):
+		return false
+	case p.SKU.Tier != azure.ToString(in.Sku.Tier):
+		return false
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+		return false
+	case in.Storage == nil:
+		return false
+	case !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.StorageSizeGB), in.Storage.StorageSizeGB):
+		return false
+	case !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.StorageIOPS), in.Storage.Iops):
+		return false
+	case !reflect.DeepEqual(p.StorageAutoGrow, in.Storage.AutoGrow):
+		return false
+	case in.Backup == nil || !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.BackupRetentionDays), in.Backup.BackupRetentionDays):
+		return false
+	case !isMySQLFlexibleHighAvailabilityUpToDate(p.HighAvailability, in.ServerProperties.HighAvailability):
+		return false
+	case !isMySQLFlexibleMaintenanceWindowUpToDate(p.MaintenanceWindow, in.MaintenanceWindow):
+		return false
+	}
+	return true
+}
+
+func isMySQLFlexibleHighAvailabilityUpToDate(ha *azuredbv1alpha3.FlexibleServerHighAvailability, in *mysqlflexible.HighAvailability) bool {
+	if in == nil {
+		return isFlexibleHighAvailabilityUpToDate(ha, nil, nil)
+	}
+	return isFlexibleHighAvailabilityUpToDate(ha, in.Mode, in.StandbyAvailabilityZone)
+}
+
+func isMySQLFlexibleMaintenanceWindowUpToDate(mw *azuredbv1alpha3.FlexibleServerMaintenanceWindow, in *mysqlflexible.MaintenanceWindow) bool {
+	if in == nil {
+		return isFlexibleMaintenanceWindowUpToDate(mw, nil, nil, nil, nil)
+	}
+	return isFlexibleMaintenanceWindowUpToDate(mw, in.CustomWindow, in.DayOfWeek, in.StartHour, in.StartMinute)
+}
+
+// UpdateMySQLFlexibleServerObservation updates the supplied observation with
+// the values of the supplied Azure Server.
+func UpdateMySQLFlexibleServerObservation(o *azuredbv1alpha3.FlexibleServerObservation, in mysqlflexible.Server) {
+	o.ID = azure.ToString(in.ID)
+	o.Type = azure.ToString(in.Type)
+	if in.ServerProperties == nil {
+		return
+	}
+	o.State = azure.ToString(in.State)
+	o.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
+	o.AvailabilityZone = azure.ToString(in.ServerProperties.AvailabilityZone)
+	o.ReplicationRole = azure.ToString(in.ReplicationRole)
+	if in.ServerProperties.HighAvailability != nil {
+		o.HighAvailabilityState = azure.ToString(in.ServerProperties.HighAvailability.State)
+		o.StandbyAvailabilityZone = azure.ToString(in.ServerProperties.HighAvailability.StandbyAvailabilityZone)
+	}
+	if in.Network != nil {
+		o.PublicNetworkAccess = azure.ToString(in.Network.PublicNetworkAccess)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mysqlflexible implements the Azure ARM Database for MySQL flexible
+// server API version 2021-05-01.
+package mysqlflexible
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/crossplane/provider-azure/pkg/clients/database/arm"
+)
+
+// APIVersion is the version of the flexible server API.
+const APIVersion = "2021-05-01"
+
+const serverPath = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.DBforMySQL/flexibleServers/{serverName}"
+
+// ServersClientAPI contains the set of methods on the ServersClient type.
+type ServersClientAPI interface {
+	Create(ctx context.Context, resourceGroupName string, serverName string, parameters Server) (result azure.Future, err error)
+	Delete(ctx context.Context, resourceGroupName string, serverName string) (result azure.Future, err error)
+	Get(ctx context.Context, resourceGroupName string, serverName string) (result Server, err error)
+	Update(ctx context.Context, resourceGroupName string, serverName string, parameters ServerForUpdate) (result azure.Future, err error)
+}
+
+var _ ServersClientAPI = (*ServersClient)(nil)
+
+// ServersClient is the client for flexible servers.
+type ServersClient struct {
+	arm.BaseClient
+}
+
+// NewServersClient creates an instance of the ServersClient client.
+func NewServersClient(subscriptionID string) ServersClient {
+	return ServersClient{arm.New(subscriptionID, APIVersion)}
+}
+
+// Create creates a new server or a replica of an existing server.
+func (client ServersClient) Create(ctx context.Context, resourceGroupName string, serverName string, parameters Server) (azure.Future, error) {
+	return client.Put(ctx, serverPath, serverPathParameters(resourceGroupName, serverName), parameters)
+}
+
+// Delete deletes a server.
+func (client ServersClient) Delete(ctx context.Context, resourceGroupName string, serverName string) (azure.Future, error) {
+	return client.BaseClient.Delete(ctx, serverPath, serverPathParameters(resourceGroupName, serverName))
+}
+
+// Get gets information about a server.
+func (client ServersClient) Get(ctx context.Context, resourceGroupName string, serverName string) (result Server, err error) {
+	err = client.BaseClient.Get(ctx, serverPath, serverPathParameters(resourceGroupName, serverName), &result)
+	return
+}
+
+// Update updates an existing server.
+func (client ServersClient) Update(ctx context.Context, resourceGroupName string, serverName string, parameters ServerForUpdate) (azure.Future, error) {
+	return client.Patch(ctx, serverPath, serverPathParameters(resourceGroupName, serverName), parameters)
+}
+
+func serverPathParameters(resourceGroupName, serverName string) map[string]interface{} {
+	return map[string]interface{}{
+		"resourceGroupName": resourceGroupName,
+		"serverName":        serverName,
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlflexible
+
+import (
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// Sku the SKU of a server.
+type Sku struct {
+	// Name - The name of the SKU, e.g. Standard_D2ds_v4.
+	Name *string `json:"name,omitempty"`
+	// Tier - The tier of the SKU. Possible values include: 'Burstable', 'GeneralPurpose', 'MemoryOptimized'
+	Tier *string `json:"tier,omitempty"`
+}
+
+// Storage the storage properties of a server.
+type Storage struct {
+	// StorageSizeGB - Max storage size allowed for a server.
+	StorageSizeGB *int32 `json:"storageSizeGB,omitempty"`
+	// Iops - Storage IOPS for a server.
+	Iops *int32 `json:"iops,omitempty"`
+	// AutoGrow - Possible values include: 'Enabled', 'Disabled'
+	AutoGrow *string `json:"autoGrow,omitempty"`
+	// StorageSku - READ-ONLY; The sku name of the server storage.
+	StorageSku *string `json:"storageSku,omitempty"`
+}
+
+// Backup the backup properties of a server.
+type Backup struct {
+	// BackupRetentionDays - Backup retention days for the server.
+	BackupRetentionDays *int32 `json:"backupRetentionDays,omitempty"`
+	// GeoRedundantBackup - Possible values include: 'Enabled', 'Disabled'
+	GeoRedundantBackup *string `json:"geoRedundantBackup,omitempty"`
+	// EarliestRestoreDate - READ-ONLY; Earliest restore point creation time.
+	EarliestRestoreDate *date.Time `json:"earliestRestoreDate,omitempty"`
+}
+
+// Network the network properties of a server.
+type Network struct {
+	// PublicNetworkAccess - READ-ONLY; Possible values include: 'Enabled', 'Disabled'
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+	// DelegatedSubnetResourceID - The ARM resource ID of the delegated subnet.
+	DelegatedSubnetResourceID *string `json:"delegatedSubnetResourceId,omitempty"`
+	// PrivateDNSZoneResourceID - The ARM resource ID of the private DNS zone.
+	PrivateDNSZoneResourceID *string `json:"privateDnsZoneResourceId,omitempty"`
+}
+
+// HighAvailability the high availability properties of a server.
+type HighAvailability struct {
+	// Mode - Possible values include: 'Disabled', 'ZoneRedundant', 'SameZone'
+	Mode *string `json:"mode,omitempty"`
+	// State - READ-ONLY; The state of the standby server.
+	State *string `json:"state,omitempty"`
+	// StandbyAvailabilityZone - The availability zone of the standby server.
+	StandbyAvailabilityZone *string `json:"standbyAvailabilityZone,omitempty"`
+}
+
+// MaintenanceWindow the maintenance window of a server.
+type MaintenanceWindow struct {
+	// CustomWindow - Indicates whether a custom window is enabled or disabled.
+	CustomWindow *string `json:"customWindow,omitempty"`
+	// StartHour - The start hour of the window.
+	StartHour *int32 `json:"startHour,omitempty"`
+	// StartMinute - The start minute of the window.
+	StartMinute *int32 `json:"startMinute,omitempty"`
+	// DayOfWeek - The day of the week of the window.
+	DayOfWeek *int32 `json:"dayOfWeek,omitempty"`
+}
+
+// ServerProperties the properties of a server.
+type ServerProperties struct {
+	// AdministratorLogin - The administrator's login name of a server.
+	AdministratorLogin *string `json:"administratorLogin,omitempty"`
+	// AdministratorLoginPassword - The password of the administrator login.
+	AdministratorLoginPassword *string `json:"administratorLoginPassword,omitempty"`
+	// Version - Possible values include: '5.7', '8.0.21'
+	Version *string `json:"version,omitempty"`
+	// AvailabilityZone - The availability zone of a server.
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+	// CreateMode - Possible values include: 'Default', 'PointInTimeRestore', 'Replica', 'GeoRestore'
+	CreateMode *string `json:"createMode,omitempty"`
+	// SourceServerResourceID - The source MySQL server ID.
+	SourceServerResourceID *string `json:"sourceServerResourceId,omitempty"`
+	// RestorePointInTime - Restore point creation time.
+	RestorePointInTime *date.Time `json:"restorePointInTime,omitempty"`
+	// ReplicationRole - Possible values include: 'None', 'Source', 'Replica'
+	ReplicationRole *string `json:"replicationRole,omitempty"`
+	// ReplicaCapacity - READ-ONLY; The maximum number of replicas that a source server can have.
+	ReplicaCapacity *int32 `json:"replicaCapacity,omitempty"`
+	// State - READ-ONLY; Possible values include: 'Ready', 'Dropping', 'Stopped', 'Starting', 'Stopping', 'Updating'
+	State *string `json:"state,omitempty"`
+	// FullyQualifiedDomainName - READ-ONLY; The fully qualified domain name of a server.
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName,omitempty"`
+	// Storage - Storage related properties of a server.
+	Storage *Storage `json:"storage,omitempty"`
+	// Backup - Backup related properties of a server.
+	Backup *Backup `json:"backup,omitempty"`
+	// HighAvailability - High availability related properties of a server.
+	HighAvailability *HighAvailability `json:"highAvailability,omitempty"`
+	// Network - Network related properties of a server.
+	Network *Network `json:"network,omitempty"`
+	// MaintenanceWindow - Maintenance window of a server.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// Server represents a flexible server.
+type Server struct {
+	// ID - READ-ONLY; Fully qualified resource ID for the resource.
+	ID *string `json:"id,omitempty"`
+	// Name - READ-ONLY; The name of the resource.
+	Name *string `json:"name,omitempty"`
+	// Type - READ-ONLY; The type of the resource.
+	Type *string `json:"type,omitempty"`
+	// Location - The geo-location where the resource lives.
+	Location *string `json:"location,omitempty"`
+	// Tags - Resource tags.
+	Tags map[string]*string `json:"tags"`
+	// Sku - The SKU of the server.
+	Sku *Sku `json:"sku,omitempty"`
+	// ServerProperties - Properties of the server.
+	*ServerProperties `json:"properties,omitempty"`
+}
+
+// ServerPropertiesForUpdate the properties of a server that may be updated.
+type ServerPropertiesForUpdate struct {
+	// AdministratorLoginPassword - The password of the administrator login.
+	AdministratorLoginPassword *string `json:"administratorLoginPassword,omitempty"`
+	// Storage - Storage related properties of a server.
+	Storage *Storage `json:"storage,omitempty"`
+	// Backup - Backup related properties of a server.
+	Backup *Backup `json:"backup,omitempty"`
+	// HighAvailability - High availability related properties of a server.
+	HighAvailability *HighAvailability `json:"highAvailability,omitempty"`
+	// MaintenanceWindow - Maintenance window of a server.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// ServerForUpdate represents a server to be updated.
+type ServerForUpdate struct {
+	// Sku - The SKU of the server.
+	Sku *Sku `json:"sku,omitempty"`
+	// ServerPropertiesForUpdate - Properties of the server.
+	*ServerPropertiesForUpdate `json:"properties,omitempty"`
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database/mysqlflexible"
+)
+
+func TestNewMySQLFlexibleServerParameters(t *testing.T) {
+	admin := "cooladmin"
+	password := "verysecure"
+	source := "/subscriptions/cool/resourceGroups/rg/providers/Microsoft.DBforMySQL/flexibleServers/source"
+
+	cases := map[string]struct {
+		p    v1alpha3.MySQLFlexibleServerParameters
+		pw   string
+		want *mysqlflexible.ServerProperties
+	}{
+		"Default": {
+			p: v1alpha3.MySQLFlexibleServerParameters{
+				AdministratorLogin: admin,
+				StorageSizeGB:      to.IntPtr(32),
+				StorageIOPS:        to.IntPtr(400),
+				StorageAutoGrow:    azure.ToStringPtr("Enabled"),
+			},
+			pw: password,
+			want: &mysqlflexible.ServerProperties{
+				AdministratorLogin:         azure.ToStringPtr(admin),
+				AdministratorLoginPassword: azure.ToStringPtr(password),
+				Storage: &mysqlflexible.Storage{
+					StorageSizeGB: azure.ToInt32Ptr(32),
+					Iops:          azure.ToInt32Ptr(400),
+					AutoGrow:      azure.ToStringPtr("Enabled"),
+				},
+				Backup:  &mysqlflexible.Backup{},
+				Network: &mysqlflexible.Network{},
+			},
+		},
+		"Replica": {
+			p: v1alpha3.MySQLFlexibleServerParameters{
+				AdministratorLogin: admin,
+				CreateMode:         azure.ToStringPtr(v1alpha3.FlexibleServerCreateModeReplica),
+				SourceServerID:     azure.ToStringPtr(source),
+			},
+			pw: password,
+			want: &mysqlflexible.ServerProperties{
+				Storage:                &mysqlflexible.Storage{},
+				Backup:                 &mysqlflexible.Backup{},
+				Network:                &mysqlflexible.Network{},
+				CreateMode:             azure.ToStringPtr(v1alpha3.FlexibleServerCreateModeReplica),
+				SourceServerResourceID: azure.ToStringPtr(source),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewMySQLFlexibleServerParameters(tc.p, tc.pw)
+			if diff := cmp.Diff(tc.want, got.ServerProperties); diff != "" {
+				t.Errorf("NewMySQLFlexibleServerParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMySQLFlexibleServerUpToDate(t *testing.T) {
+	server := func(iops int, autoGrow string) mysqlflexible.Server {
+		return mysqlflexible.Server{
+			Sku: &mysqlflexible.Sku{
+				Name: azure.ToStringPtr("Standard_B1ms"),
+				Tier: azure.ToStringPtr("Burstable"),
+			},
+			ServerProperties: &mysqlflexible.ServerProperties{
+				Storage: &mysqlflexible.Storage{
+					StorageSizeGB: azure.ToInt32Ptr(32),
+					Iops:          azure.ToInt32Ptr(iops),
+					AutoGrow:      azure.ToStringPtr(autoGrow),
+				},
+				Backup: &mysqlflexible.Backup{},
+			},
+		}
+	}
+	params := v1alpha3.MySQLFlexibleServerParameters{
+		SKU:             v1alpha3.FlexibleServerSKU{Name: "Standard_B1ms", Tier: "Burstable"},
+		StorageSizeGB:   to.IntPtr(32),
+		StorageIOPS:     to.IntPtr(400),
+		StorageAutoGrow: azure.ToStringPtr("Enabled"),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.MySQLFlexibleServerParameters
+		az   mysqlflexible.Server
+		want bool
+	}{
+		"UpToDate": {
+			p:    params,
+			az:   server(400, "Enabled"),
+			want: true,
+		},
+		"IOPSNeedsUpdate": {
+			p:    params,
+			az:   server(360, "Enabled"),
+			want: false,
+		},
+		"AutoGrowNeedsUpdate": {
+			p:    params,
+			az:   server(400, "Disabled"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMySQLFlexibleServerUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMySQLFlexibleServerUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// Error strings.
+const (
+	errGetPassword           = "cannot get administrator login password"
+	errGetPendingPassword    = "cannot get pending administrator login password"
+	errStorePendingPassword  = "cannot store pending administrator login password"
+	errDeletePendingPassword = "cannot delete pending administrator login password"
+	errHashPassword          = "cannot hash administrator login password"
+)
+
+// A new administrator login password is kept in a Secret named after the
+// connection secret, with this suffix, until it has been sent to Azure and
+// published to the connection secret.
+const pendingPasswordSuffix = "-pending-password"
+
+// UpdatePasswordRotationObservation updates the supplied observation with when
+// the administrator login password is next due to be rotated. Passwords that
+// were never rotated by the controller are due immediately.
+func UpdatePasswordRotationObservation(p v1beta1.SQLServerParameters, o *v1beta1.PasswordRotationObservation, now time.Time) {
+	o.NextRotationTime = nil
+	if p.AdministratorLoginPasswordRotationInterval == nil {
+		return
+	}
+	next := metav1.NewTime(now)
+	if o.LastRotationTime != nil {
+		next = metav1.NewTime(o.LastRotationTime.Add(p.AdministratorLoginPasswordRotationInterval.Duration))
+	}
+	o.NextRotationTime = &next
+}
+
+// IsPasswordRotationDue returns true if the administrator login password
+// should be rotated at the supplied time.
+func IsPasswordRotationDue(o v1beta1.PasswordRotationObservation, now time.Time) bool {
+	return o.NextRotationTime != nil && !now.Before(o.NextRotationTime.Time)
+}
+
+// IsPasswordRotationSucceeded returns true if Azure has applied a new
+// administrator login password that has not yet been recorded as rotated.
+func IsPasswordRotationSucceeded(o v1beta1.PasswordRotationObservation) bool {
+	return o.LastRotation.Status == azure.AsyncOperationStatusSucceeded
+}
+
+// IsPasswordChangeFailed returns true if the supplied operation, which sent an
+// administrator login password to Azure, has completed without success.
+func IsPasswordChangeFailed(op v1alpha3.AsyncOperation) bool {
+	return op.Status != "" && op.Status != azure.AsyncOperationStatusInProgress && op.Status != azure.AsyncOperationStatusSucceeded
+}
+
+// GetPassword returns the administrator login password held by the referenced
+// Secret key.
+func GetPassword(ctx context.Context, kube client.Reader, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrap(err, errGetPassword)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+// HashPassword returns a hash of the supplied administrator login password that
+// is safe to record in the status of a managed resource.
+func HashPassword(password string) (string, error) {
+	h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(h), errors.Wrap(err, errHashPassword)
+}
+
+// IsPasswordHashed returns true if the supplied hash was produced by
+// HashPassword from the supplied administrator login password.
+func IsPasswordHashed(hash, password string) bool {
+	return hash != "" && bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+func pendingPasswordSecret(mg resource.Managed) *corev1.Secret {
+	ref := mg.GetWriteConnectionSecretToReference()
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Namespace: ref.Namespace,
+		Name:      ref.Name + pendingPasswordSuffix,
+	}}
+}
+
+// GetPendingPassword returns the administrator login password the supplied
+// managed resource is being rotated to, or an empty string if it is not being
+// rotated. The managed resource must write a connection secret.
+func GetPendingPassword(ctx context.Context, kube client.Reader, mg resource.Managed) (string, error) {
+	s := pendingPasswordSecret(mg)
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: s.GetNamespace(), Name: s.GetName()}, s); err != nil {
+		return "", errors.Wrap(resource.IgnoreNotFound(err), errGetPendingPassword)
+	}
+	return string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey]), nil
+}
+
+// StorePendingPassword stores the administrator login password the supplied
+// managed resource is being rotated to, in a Secret it controls. The managed
+// resource must write a connection secret.
+func StorePendingPassword(ctx context.Context, kube client.Client, mg resource.Managed, of schema.GroupVersionKind, password string) error {
+	s := pendingPasswordSecret(mg)
+	meta.AddOwnerReference(s, meta.AsController(meta.TypedReferenceTo(mg, of)))
+	s.Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)}
+	return errors.Wrap(resource.NewAPIPatchingApplicator(kube).Apply(ctx, s), errStorePendingPassword)
+}
+
+// DeletePendingPassword deletes the administrator login password the supplied
+// managed resource was being rotated to. The managed resource must write a
+// connection secret.
+func DeletePendingPassword(ctx context.Context, kube client.Client, mg resource.Managed) error {
+	return errors.Wrap(resource.IgnoreNotFound(kube.Delete(ctx, pendingPasswordSecret(mg))), errDeletePendingPassword)
+}
@@ -22,11 +22,14 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
@@ -50,18 +53,23 @@ type PostgreSQLServerAPI interface {
 	CreateServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer, adminPassword string) error
 	DeleteServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) error
 	UpdateServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) error
+	RotateAdministratorLoginPassword(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer, password string) error
+	GetAzureADAdministrator(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) (postgresql.ServerAdministratorResource, error)
+	UpdateAzureADAdministrator(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) error
 	GetRESTClient() autorest.Sender
 }
 
 // PostgreSQLServerClient is the concreate implementation of the SQLServerAPI interface for PostgreSQL that calls Azure API.
 type PostgreSQLServerClient struct {
 	postgresql.ServersClient
+	Administrators postgresql.ServerAdministratorsClient
 }
 
 // NewPostgreSQLServerClient creates and initializes a PostgreSQLServerClient instance.
-func NewPostgreSQLServerClient(cl postgresql.ServersClient) *PostgreSQLServerClient {
+func NewPostgreSQLServerClient(servers postgresql.ServersClient, admins postgresql.ServerAdministratorsClient) *PostgreSQLServerClient {
 	return &PostgreSQLServerClient{
-		ServersClient: cl,
+		ServersClient:  servers,
+		Administrators: admins,
 	}
 }
 
@@ -81,12 +89,14 @@ func toPGSQLProperties(s v1beta1.SQLServerParameters, adminPassword string) post
 	switch createMode {
 	case azuredbv1beta1.CreateModePointInTimeRestore:
 		return &postgresql.ServerPropertiesForRestore{
-			MinimalTLSVersion:  postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-			Version:            postgresql.ServerVersion(s.Version),
-			SslEnforcement:     postgresql.SslEnforcementEnum(s.SSLEnforcement),
-			CreateMode:         postgresql.CreateModePointInTimeRestore,
-			RestorePointInTime: safeDate(s.RestorePointInTime),
-			SourceServerID:     s.SourceServerID,
+			MinimalTLSVersion:        postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
+			Version:                  postgresql.ServerVersion(s.Version),
+			SslEnforcement:           postgresql.SslEnforcementEnum(s.SSLEnforcement),
+			InfrastructureEncryption: postgresql.InfrastructureEncryption(azure.ToString(s.InfrastructureEncryption)),
+			PublicNetworkAccess:      postgresql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:               postgresql.CreateModePointInTimeRestore,
+			RestorePointInTime:       safeDate(s.RestorePointInTime),
+			SourceServerID:           s.SourceServerID,
 			StorageProfile: &postgresql.StorageProfile{
 				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 				GeoRedundantBackup:  postgresql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
@@ -96,11 +106,13 @@ func toPGSQLProperties(s v1beta1.SQLServerParameters, adminPassword string) post
 		}
 	case azuredbv1beta1.CreateModeGeoRestore:
 		return &postgresql.ServerPropertiesForGeoRestore{
-			MinimalTLSVersion: postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-			Version:           postgresql.ServerVersion(s.Version),
-			SslEnforcement:    postgresql.SslEnforcementEnum(s.SSLEnforcement),
-			SourceServerID:    s.SourceServerID,
-			CreateMode:        postgresql.CreateModeGeoRestore,
+			MinimalTLSVersion:        postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
+			Version:                  postgresql.ServerVersion(s.Version),
+			SslEnforcement:           postgresql.SslEnforcementEnum(s.SSLEnforcement),
+			InfrastructureEncryption: postgresql.InfrastructureEncryption(azure.ToString(s.InfrastructureEncryption)),
+			PublicNetworkAccess:      postgresql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			SourceServerID:           s.SourceServerID,
+			CreateMode:               postgresql.CreateModeGeoRestore,
 			StorageProfile: &postgresql.StorageProfile{
 				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 				GeoRedundantBackup:  postgresql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
@@ -110,11 +122,13 @@ func toPGSQLProperties(s v1beta1.SQLServerParameters, adminPassword string) post
 		}
 	case azuredbv1beta1.CreateModeReplica:
 		return &postgresql.ServerPropertiesForReplica{
-			MinimalTLSVersion: postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-			Version:           postgresql.ServerVersion(s.Version),
-			SslEnforcement:    postgresql.SslEnforcementEnum(s.SSLEnforcement),
-			CreateMode:        postgresql.CreateModeReplica,
-			SourceServerID:    s.SourceServerID,
+			MinimalTLSVersion:        postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
+			Version:                  postgresql.ServerVersion(s.Version),
+			SslEnforcement:           postgresql.SslEnforcementEnum(s.SSLEnforcement),
+			InfrastructureEncryption: postgresql.InfrastructureEncryption(azure.ToString(s.InfrastructureEncryption)),
+			PublicNetworkAccess:      postgresql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
+			CreateMode:               postgresql.CreateModeReplica,
+			SourceServerID:           s.SourceServerID,
 			StorageProfile: &postgresql.StorageProfile{
 				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 				GeoRedundantBackup:  postgresql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
@@ -131,6 +145,8 @@ func toPGSQLProperties(s v1beta1.SQLServerParameters, adminPassword string) post
 			AdministratorLoginPassword: &adminPassword,
 			Version:                    postgresql.ServerVersion(s.Version),
 			SslEnforcement:             postgresql.SslEnforcementEnum(s.SSLEnforcement),
+			InfrastructureEncryption:   postgresql.InfrastructureEncryption(azure.ToString(s.InfrastructureEncryption)),
+			PublicNetworkAccess:        postgresql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
 			CreateMode:                 postgresql.CreateModeDefault,
 			StorageProfile: &postgresql.StorageProfile{
 				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
@@ -166,15 +182,35 @@ func (c *PostgreSQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1
 	return nil
 }
 
+// RotateAdministratorLoginPassword sends the supplied administrator login
+// password to a PostgreSQL Server.
+func (c *PostgreSQLServerClient) RotateAdministratorLoginPassword(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer, password string) error {
+	updateParams := postgresql.ServerUpdateParameters{
+		ServerUpdateParametersProperties: &postgresql.ServerUpdateParametersProperties{
+			AdministratorLoginPassword: &password,
+		},
+	}
+	op, err := c.Update(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), updateParams)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.AdministratorLoginPasswordRotation.LastRotation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return nil
+}
+
 // UpdateServer updates a PostgreSQL Server.
 func (c *PostgreSQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer) error {
-	// TODO(muvaf): password update via Update call is supported by Azure but
-	// we don't support that.
+	// NOTE: The administrator login password is sent separately, by
+	// RotateAdministratorLoginPassword.
 	s := cr.Spec.ForProvider
 	properties := &postgresql.ServerUpdateParametersProperties{
-		Version:           postgresql.ServerVersion(s.Version),
-		MinimalTLSVersion: postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-		SslEnforcement:    postgresql.SslEnforcementEnum(s.SSLEnforcement),
+		Version:             postgresql.ServerVersion(s.Version),
+		MinimalTLSVersion:   postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
+		SslEnforcement:      postgresql.SslEnforcementEnum(s.SSLEnforcement),
+		PublicNetworkAccess: postgresql.PublicNetworkAccessEnum(azure.ToString(s.PublicNetworkAccess)),
 		StorageProfile: &postgresql.StorageProfile{
 			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 			GeoRedundantBackup:  postgresql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
@@ -215,6 +251,34 @@ func (c *PostgreSQLServerClient) DeleteServer(ctx context.Context, cr *azuredbv1
 	return nil
 }
 
+// GetAzureADAdministrator retrieves the Azure AD administrator of the supplied
+// PostgreSQL Server.
+func (c *PostgreSQLServerClient) GetAzureADAdministrator(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer) (postgresql.ServerAdministratorResource, error) {
+	return c.Administrators.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+}
+
+// UpdateAzureADAdministrator sets the Azure AD administrator of the supplied
+// PostgreSQL Server.
+func (c *PostgreSQLServerClient) UpdateAzureADAdministrator(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer) error {
+	s := cr.Spec.ForProvider
+	if s.AzureADAdministrator == nil {
+		return nil
+	}
+	p, err := NewPostgreSQLServerAzureADAdministratorParameters(*s.AzureADAdministrator)
+	if err != nil {
+		return err
+	}
+	op, err := c.Administrators.CreateOrUpdate(ctx, s.ResourceGroupName, meta.GetExternalName(cr), p)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return nil
+}
+
 // NewPostgreSQLVirtualNetworkRuleParameters returns an Azure VirtualNetworkRule object from a virtual network spec
 func NewPostgreSQLVirtualNetworkRuleParameters(v *azuredbv1alpha3.PostgreSQLServerVirtualNetworkRule) postgresql.VirtualNetworkRule {
 	return postgresql.VirtualNetworkRule{
@@ -267,6 +331,73 @@ func PostgreSQLServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.PostgreSQLServ
 	return cmp.Equal(up.FirewallRuleProperties, az.FirewallRuleProperties)
 }
 
+// NewPostgreSQLConfigurationParameters returns an Azure Configuration object that
+// sets the value of a configuration spec.
+func NewPostgreSQLConfigurationParameters(c *azuredbv1alpha3.PostgreSQLServerConfiguration) postgresql.Configuration {
+	return postgresql.Configuration{
+		ConfigurationProperties: &postgresql.ConfigurationProperties{
+			Value:  azure.ToStringPtr(c.Spec.ForProvider.Value, azure.FieldRequired),
+			Source: azure.ToStringPtr(ConfigurationSourceUserOverride),
+		},
+	}
+}
+
+// NewPostgreSQLDefaultConfigurationParameters returns an Azure Configuration
+// object that resets a configuration to its default value.
+func NewPostgreSQLDefaultConfigurationParameters() postgresql.Configuration {
+	return postgresql.Configuration{
+		ConfigurationProperties: &postgresql.ConfigurationProperties{
+			Source: azure.ToStringPtr(ConfigurationSourceSystemDefault),
+		},
+	}
+}
+
+// PostgreSQLServerConfigurationIsUpToDate returns true if the supplied
+// Configuration has the value of the supplied PostgreSQLServerConfiguration.
+func PostgreSQLServerConfigurationIsUpToDate(kube *azuredbv1alpha3.PostgreSQLServerConfiguration, az postgresql.Configuration) bool {
+	if az.ConfigurationProperties == nil {
+		return false
+	}
+	return kube.Spec.ForProvider.Value == azure.ToString(az.Value)
+}
+
+// UpdatePostgreSQLConfigurationObservation updates the status of the supplied
+// configuration with the supplied Azure Configuration.
+func UpdatePostgreSQLConfigurationObservation(o *azuredbv1alpha3.ConfigurationObservation, az postgresql.Configuration) {
+	o.ID = azure.ToString(az.ID)
+	o.Type = azure.ToString(az.Type)
+	if az.ConfigurationProperties == nil {
+		return
+	}
+	o.Value = azure.ToString(az.Value)
+	o.DefaultValue = azure.ToString(az.DefaultValue)
+	o.DataType = azure.ToString(az.DataType)
+	o.AllowedValues = azure.ToString(az.AllowedValues)
+	o.Source = azure.ToString(az.Source)
+	o.Description = azure.ToString(az.Description)
+}
+
+// NewPostgreSQLDatabaseParameters returns an Azure Database object from a database
+// spec.
+func NewPostgreSQLDatabaseParameters(d *azuredbv1alpha3.PostgreSQLDatabase) postgresql.Database {
+	return postgresql.Database{
+		DatabaseProperties: &postgresql.DatabaseProperties{
+			Charset:   d.Spec.ForProvider.Charset,
+			Collation: d.Spec.ForProvider.Collation,
+		},
+	}
+}
+
+// LateInitializePostgreSQLDatabase fills the empty fields of the supplied database
+// spec with the charset and collation of the supplied Azure Database.
+func LateInitializePostgreSQLDatabase(p *azuredbv1alpha3.DatabaseParameters, in postgresql.Database) {
+	if in.DatabaseProperties == nil {
+		return
+	}
+	p.Charset = azure.LateInitializeStringPtrFromPtr(p.Charset, in.Charset)
+	p.Collation = azure.LateInitializeStringPtrFromPtr(p.Collation, in.Collation)
+}
+
 // The name must match the specification of the SKU, so, we don't allow user
 // to specify an arbitrary name. The format is tier + family + cores, e.g. B_Gen4_1, GP_Gen5_8.
 
@@ -313,6 +444,8 @@ func LateInitializePostgreSQL(p *azuredbv1beta1.SQLServerParameters, in postgres
 	if p.SSLEnforcement == "" {
 		p.SSLEnforcement = string(in.SslEnforcement)
 	}
+	p.InfrastructureEncryption = azure.LateInitializeStringPtrFromVal(p.InfrastructureEncryption, string(in.InfrastructureEncryption))
+	p.PublicNetworkAccess = azure.LateInitializeStringPtrFromVal(p.PublicNetworkAccess, string(in.PublicNetworkAccess))
 }
 
 // IsPostgreSQLUpToDate is used to report whether given postgresql.Server is in
@@ -326,6 +459,8 @@ func IsPostgreSQLUpToDate(p azuredbv1beta1.SQLServerParameters, in postgresql.Se
 		return false
 	case p.SSLEnforcement != string(in.SslEnforcement):
 		return false
+	case azure.ToString(p.PublicNetworkAccess) != string(in.PublicNetworkAccess):
+		return false
 	case p.Version != string(in.Version):
 		return false
 	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
@@ -347,3 +482,39 @@ func IsPostgreSQLUpToDate(p azuredbv1beta1.SQLServerParameters, in postgresql.Se
 	}
 	return true
 }
+
+// NewPostgreSQLServerAzureADAdministratorParameters returns the Azure
+// ServerAdministratorResource that corresponds to the supplied administrator.
+func NewPostgreSQLServerAzureADAdministratorParameters(a azuredbv1beta1.AzureADAdministrator) (postgresql.ServerAdministratorResource, error) {
+	sid, err := uuid.FromString(a.ObjectID)
+	if err != nil {
+		return postgresql.ServerAdministratorResource{}, errors.Wrap(err, errParseObjectID)
+	}
+	tid, err := uuid.FromString(a.TenantID)
+	if err != nil {
+		return postgresql.ServerAdministratorResource{}, errors.Wrap(err, errParseTenantID)
+	}
+	return postgresql.ServerAdministratorResource{
+		ServerAdministratorProperties: &postgresql.ServerAdministratorProperties{
+			AdministratorType: azure.ToStringPtr(AdministratorTypeActiveDirectory),
+			Login:             azure.ToStringPtr(a.Login),
+			Sid:               &sid,
+			TenantID:          &tid,
+		},
+	}, nil
+}
+
+// IsPostgreSQLServerAzureADAdministratorUpToDate returns true if the supplied
+// Azure ServerAdministratorResource matches the supplied administrator. A nil
+// administrator is always up to date.
+func IsPostgreSQLServerAzureADAdministratorUpToDate(a *azuredbv1beta1.AzureADAdministrator, in postgresql.ServerAdministratorResource) bool {
+	if a == nil {
+		return true
+	}
+	if in.ServerAdministratorProperties == nil || in.Sid == nil || in.TenantID == nil {
+		return false
+	}
+	return a.Login == azure.ToString(in.Login) &&
+		strings.EqualFold(a.ObjectID, in.Sid.String()) &&
+		strings.EqualFold(a.TenantID, in.TenantID.String())
+}
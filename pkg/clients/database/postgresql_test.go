@@ -22,9 +22,12 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
 	"github.com/crossplane/provider-azure/apis/database/v1beta1"
@@ -135,6 +138,20 @@ func TestTopostgresqlProperties(t *testing.T) {
 			fp:   postgresqlServerParameters(nil),
 			want: postgresqlServerPropertiesForDefaultCreate(),
 		},
+		{
+			name: "InfrastructureEncryptionAndPublicNetworkAccess",
+			fp: v1beta1.SQLServerParameters{
+				InfrastructureEncryption: azure.ToStringPtr(string(postgresql.InfrastructureEncryptionEnabled)),
+				PublicNetworkAccess:      azure.ToStringPtr(string(postgresql.PublicNetworkAccessEnumDisabled)),
+			},
+			want: &postgresql.ServerPropertiesForDefaultCreate{
+				AdministratorLoginPassword: to.StringPtr("admin"),
+				InfrastructureEncryption:   postgresql.InfrastructureEncryptionEnabled,
+				PublicNetworkAccess:        postgresql.PublicNetworkAccessEnumDisabled,
+				CreateMode:                 postgresql.CreateModeDefault,
+				StorageProfile:             &postgresql.StorageProfile{},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -431,3 +448,122 @@ func TestPostgreSQLServerFirewallRuleIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewPostgreSQLServerAzureADAdministratorParameters(t *testing.T) {
+	login := "cooladmins"
+	oid := uuid.Must(uuid.FromString("0c2b6d7a-2a4c-4e5f-8c6c-3a1f2e6b9d10"))
+	tid := uuid.Must(uuid.FromString("6a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"))
+
+	type want struct {
+		a   postgresql.ServerAdministratorResource
+		err error
+	}
+
+	cases := map[string]struct {
+		a    v1beta1.AzureADAdministrator
+		want want
+	}{
+		"Successful": {
+			a: v1beta1.AzureADAdministrator{
+				Login:    login,
+				ObjectID: oid.String(),
+				TenantID: tid.String(),
+			},
+			want: want{
+				a: postgresql.ServerAdministratorResource{
+					ServerAdministratorProperties: &postgresql.ServerAdministratorProperties{
+						AdministratorType: azure.ToStringPtr(AdministratorTypeActiveDirectory),
+						Login:             azure.ToStringPtr(login),
+						Sid:               &oid,
+						TenantID:          &tid,
+					},
+				},
+			},
+		},
+		"InvalidObjectID": {
+			a: v1beta1.AzureADAdministrator{
+				Login:    login,
+				ObjectID: "not-a-uuid",
+				TenantID: tid.String(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("uuid: incorrect UUID length: not-a-uuid"), errParseObjectID),
+			},
+		},
+		"InvalidTenantID": {
+			a: v1beta1.AzureADAdministrator{
+				Login:    login,
+				ObjectID: oid.String(),
+				TenantID: "not-a-uuid",
+			},
+			want: want{
+				err: errors.Wrap(errors.New("uuid: incorrect UUID length: not-a-uuid"), errParseTenantID),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewPostgreSQLServerAzureADAdministratorParameters(tc.a)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("NewPostgreSQLServerAzureADAdministratorParameters(...): -want error, +got error\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.a, got); diff != "" {
+				t.Errorf("NewPostgreSQLServerAzureADAdministratorParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPostgreSQLServerAzureADAdministratorUpToDate(t *testing.T) {
+	login := "cooladmins"
+	oid := uuid.Must(uuid.FromString("0c2b6d7a-2a4c-4e5f-8c6c-3a1f2e6b9d10"))
+	tid := uuid.Must(uuid.FromString("6a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"))
+	admin := postgresql.ServerAdministratorResource{
+		ServerAdministratorProperties: &postgresql.ServerAdministratorProperties{
+			Login:    azure.ToStringPtr(login),
+			Sid:      &oid,
+			TenantID: &tid,
+		},
+	}
+
+	cases := map[string]struct {
+		a    *v1beta1.AzureADAdministrator
+		az   postgresql.ServerAdministratorResource
+		want bool
+	}{
+		"NotSpecified": {
+			az:   postgresql.ServerAdministratorResource{},
+			want: true,
+		},
+		"NotFound": {
+			a:    &v1beta1.AzureADAdministrator{Login: login, ObjectID: oid.String(), TenantID: tid.String()},
+			az:   postgresql.ServerAdministratorResource{},
+			want: false,
+		},
+		"UpToDate": {
+			a:    &v1beta1.AzureADAdministrator{Login: login, ObjectID: "0C2B6D7A-2A4C-4E5F-8C6C-3A1F2E6B9D10", TenantID: tid.String()},
+			az:   admin,
+			want: true,
+		},
+		"LoginNeedsUpdate": {
+			a:    &v1beta1.AzureADAdministrator{Login: "otheradmins", ObjectID: oid.String(), TenantID: tid.String()},
+			az:   admin,
+			want: false,
+		},
+		"TenantIDNeedsUpdate": {
+			a:    &v1beta1.AzureADAdministrator{Login: login, ObjectID: oid.String(), TenantID: oid.String()},
+			az:   admin,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPostgreSQLServerAzureADAdministratorUpToDate(tc.a, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPostgreSQLServerAzureADAdministratorUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
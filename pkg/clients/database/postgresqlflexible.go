@@ -0,0 +1,356 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database/postgresqlflexible"
+)
+
+// PostgreSQLFlexibleServerAPI represents the API interface for a PostgreSQL
+// flexible server client.
+type PostgreSQLFlexibleServerAPI interface {
+	GetServer(ctx context.Context, s *azuredbv1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error)
+	CreateServer(ctx context.Context, s *azuredbv1alpha3.PostgreSQLFlexibleServer, adminPassword string) error
+	UpdateServer(ctx context.Context, s *azuredbv1alpha3.PostgreSQLFlexibleServer, adminPassword string) error
+	DeleteServer(ctx context.Context, s *azuredbv1alpha3.PostgreSQLFlexibleServer) error
+	GetRESTClient() autorest.Sender
+}
+
+// PostgreSQLFlexibleServerClient is the concrete implementation of the
+// PostgreSQLFlexibleServerAPI interface that calls Azure API.
+type PostgreSQLFlexibleServerClient struct {
+	postgresqlflexible.ServersClient
+}
+
+// NewPostgreSQLFlexibleServerClient creates and initializes a
+// PostgreSQLFlexibleServerClient instance.
+func NewPostgreSQLFlexibleServerClient(cl postgresqlflexible.ServersClient) *PostgreSQLFlexibleServerClient {
+	return &PostgreSQLFlexibleServerClient{
+		ServersClient: cl,
+	}
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *PostgreSQLFlexibleServerClient) GetRESTClient() autorest.Sender {
+	return c.ServersClient.Client
+}
+
+// GetServer retrieves the requested PostgreSQL flexible server.
+func (c *PostgreSQLFlexibleServerClient) GetServer(ctx context.Context, cr *azuredbv1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+	return c.ServersClient.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+}
+
+// CreateServer creates a PostgreSQL flexible server.
+func (c *PostgreSQLFlexibleServerClient) CreateServer(ctx context.Context, cr *azuredbv1alpha3.PostgreSQLFlexibleServer, adminPassword string) error {
+	s := cr.Spec.ForProvider
+	op, err := c.Create(ctx, s.ResourceGroupName, meta.GetExternalName(cr), NewPostgreSQLFlexibleServerParameters(s, adminPassword))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return nil
+}
+
+// UpdateServer updates a PostgreSQL flexible server. The administrator login
+// password is only sent if one is supplied.
+func (c *PostgreSQLFlexibleServerClient) UpdateServer(ctx context.Context, cr *azuredbv1alpha3.PostgreSQLFlexibleServer, adminPassword string) error {
+	s := cr.Spec.ForProvider
+	op, err := c.Update(ctx, s.ResourceGroupName, meta.GetExternalName(cr), NewPostgreSQLFlexibleServerUpdateParameters(s, adminPassword))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return nil
+}
+
+// DeleteServer deletes the supplied PostgreSQL flexible server.
+func (c *PostgreSQLFlexibleServerClient) DeleteServer(ctx context.Context, cr *azuredbv1alpha3.PostgreSQLFlexibleServer) error {
+	op, err := c.ServersClient.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodDelete,
+	}
+	return nil
+}
+
+// NewPostgreSQLFlexibleServerParameters returns the Azure Server a PostgreSQL
+// flexible server should be created with. Restored servers keep the
+// administrator login of the server they are restored from.
+func NewPostgreSQLFlexibleServerParameters(s azuredbv1alpha3.PostgreSQLFlexibleServerParameters, adminPassword string) postgresqlflexible.Server {
+	p := &postgresqlflexible.ServerProperties{
+		Version:          s.Version,
+		AvailabilityZone: s.AvailabilityZone,
+		Storage: &postgresqlflexible.Storage{
+			StorageSizeGB: azure.ToInt32PtrFromIntPtr(s.StorageSizeGB),
+		},
+		Backup: &postgresqlflexible.Backup{
+			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.BackupRetentionDays),
+			GeoRedundantBackup:  s.GeoRedundantBackup,
+		},
+		Network: &postgresqlflexible.Network{
+			DelegatedSubnetResourceID:   s.DelegatedSubnetID,
+			PrivateDNSZoneArmResourceID: s.PrivateDNSZoneID,
+		},
+		HighAvailability:  toPostgreSQLFlexibleHighAvailability(s.HighAvailability),
+		MaintenanceWindow: toPostgreSQLFlexibleMaintenanceWindow(s.MaintenanceWindow),
+		CreateMode:        s.CreateMode,
+	}
+	if azure.ToString(s.CreateMode) == azuredbv1alpha3.FlexibleServerCreateModePointInTimeRestore {
+		p.SourceServerResourceID = s.SourceServerID
+		p.PointInTimeUTC = safeDate(s.PointInTimeUTC)
+	} else {
+		p.AdministratorLogin = azure.ToStringPtr(s.AdministratorLogin)
+		p.AdministratorLoginPassword = azure.ToStringPtr(adminPassword)
+	}
+	return postgresqlflexible.Server{
+		Location: azure.ToStringPtr(s.Location),
+		Sku: &postgresqlflexible.Sku{
+			Name: azure.ToStringPtr(s.SKU.Name),
+			Tier: azure.ToStringPtr(s.SKU.Tier),
+		},
+		ServerProperties: p,
+		Tags:             azure.ToStringPtrMap(s.Tags),
+	}
+}
+
+// NewPostgreSQLFlexibleServerUpdateParameters returns the Azure
+// ServerForUpdate a PostgreSQL flexible server should be updated with.
+func NewPostgreSQLFlexibleServerUpdateParameters(s azuredbv1alpha3.PostgreSQLFlexibleServerParameters, adminPassword string) postgresqlflexible.ServerForUpdate {
+	return postgresqlflexible.ServerForUpdate{
+		Sku: &postgresqlflexible.Sku{
+			Name: azure.ToStringPtr(s.SKU.Name),
+			Tier: azure.ToStringPtr(s.SKU.Tier),
+		},
+		ServerPropertiesForUpdate: &postgresqlflexible.ServerPropertiesForUpdate{
+			AdministratorLoginPassword: azure.ToStringPtr(adminPassword),
+			Storage: &postgresqlflexible.Storage{
+				StorageSizeGB: azure.ToInt32PtrFromIntPtr(s.StorageSizeGB),
+			},
+			Backup: &postgresqlflexible.Backup{
+				BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.BackupRetentionDays),
+			},
+			HighAvailability:  toPostgreSQLFlexibleHighAvailability(s.HighAvailability),
+			MaintenanceWindow: toPostgreSQLFlexibleMaintenanceWindow(s.MaintenanceWindow),
+		},
+		Tags: azure.ToStringPtrMap(s.Tags),
+	}
+}
+
+func toPostgreSQLFlexibleHighAvailability(ha *azuredbv1alpha3.FlexibleServerHighAvailability) *postgresqlflexible.HighAvailability {
+	if ha == nil {
+		return nil
+	}
+	return &postgresqlflexible.HighAvailability{
+		Mode:                    azure.ToStringPtr(ha.Mode),
+		StandbyAvailabilityZone: ha.StandbyAvailabilityZone,
+	}
+}
+
+func toPostgreSQLFlexibleMaintenanceWindow(mw *azuredbv1alpha3.FlexibleServerMaintenanceWindow) *postgresqlflexible.MaintenanceWindow {
+	if mw == nil {
+		return nil
+	}
+	return &postgresqlflexible.MaintenanceWindow{
+		CustomWindow: azure.ToStringPtr(customWindowEnabled),
+		DayOfWeek:    azure.ToInt32Ptr(mw.DayOfWeek, azure.FieldRequired),
+		StartHour:    azure.ToInt32Ptr(mw.StartHour, azure.FieldRequired),
+		StartMinute:  azure.ToInt32Ptr(mw.StartMinute, azure.FieldRequired),
+	}
+}
+
+// LateInitializePostgreSQLFlexibleServer fills the empty fields of the
+// supplied PostgreSQL flexible server spec with the values of the supplied
+// Azure Server.
+func LateInitializePostgreSQLFlexibleServer(p *azuredbv1alpha3.PostgreSQLFlexibleServerParameters, in postgresqlflexible.Server) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	if in.ServerProperties == nil {
+		return
+	}
+	if p.AdministratorLogin == "" {
+		p.AdministratorLogin = azure.ToString(in.AdministratorLogin)
+	}
+	p.Version = azure.LateInitializeStringPtrFromPtr(p.Version, in.Version)
+	p.AvailabilityZone = azure.LateInitializeStringPtrFromPtr(p.AvailabilityZone, in.ServerProperties.AvailabilityZone)
+	if in.Storage != nil {
+		p.StorageSizeGB = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageSizeGB, in.Storage.StorageSizeGB)
+	}
+	if in.Backup != nil {
+		p.BackupRetentionDays = azure.LateInitializeIntPtrFromInt32Ptr(p.BackupRetentionDays, in.Backup.BackupRetentionDays)
+		p.GeoRedundantBackup = azure.LateInitializeStringPtrFromPtr(p.GeoRedundantBackup, in.Backup.GeoRedundantBackup)
+	}
+	if p.HighAvailability == nil && in.ServerProperties.HighAvailability != nil && in.ServerProperties.HighAvailability.Mode != nil {
+		p.HighAvailability = &azuredbv1alpha3.FlexibleServerHighAvailability{
+			Mode:                    azure.ToString(in.ServerProperties.HighAvailability.Mode),
+			StandbyAvailabilityZone: in.ServerProperties.HighAvailability.StandbyAvailabilityZone,
+		}
+	}
+	if p.MaintenanceWindow == nil && in.MaintenanceWindow != nil && azure.ToString(in.MaintenanceWindow.CustomWindow) == customWindowEnabled {
+		p.MaintenanceWindow = &azuredbv1alpha3.FlexibleServerMaintenanceWindow{
+			DayOfWeek:   azure.ToInt(in.MaintenanceWindow.DayOfWeek),
+			StartHour:   azure.ToInt(in.MaintenanceWindow.StartHour),
+			StartMinute: azure.ToInt(in.MaintenanceWindow.StartMinute),
+		}
+	}
+}
+
+// IsPostgreSQLFlexibleServerUpToDate returns true if the supplied Azure Server
+// matches the supplied PostgreSQL flexible server spec.
+func IsPostgreSQLFlexibleServerUpToDate(p azuredbv1alpha3.PostgreSQLFlexibleServerParameters, in postgresqlflexible.Server) bool { // nolint:gocyclo
+	if in.ServerProperties == nil || in.Sku == nil {
+		return false
+	}
+	switch {
+	case p.SKU.Name != azure.ToString(in.Sku.Name):
+		return false
+	case p.SKU.Tier != azure.ToString(in.Sku.Tier):
+		return false
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+		return false
+	case in.Storage == nil || !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.StorageSizeGB), in.Storage.StorageSizeGB):
+		return false
+	case in.Backup == nil || !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.BackupRetentionDays), in.Backup.BackupRetentionDays):
+		return false
+	case !isPostgreSQLFlexibleHighAvailabilityUpToDate(p.HighAvailability, in.ServerProperties.HighAvailability):
+		return false
+	case !isPostgreSQLFlexibleMaintenanceWindowUpToDate(p.MaintenanceWindow, in.MaintenanceWindow):
+		return false
+	}
+	return true
+}
+
+func isPostgreSQLFlexibleHighAvailabilityUpToDate(ha *azuredbv1alpha3.FlexibleServerHighAvailability, in *postgresqlflexible.HighAvailability) bool {
+	if in == nil {
+		return isFlexibleHighAvailabilityUpToDate(ha, nil, nil)
+	}
+	return isFlexibleHighAvailabilityUpToDate(ha, in.Mode, in.StandbyAvailabilityZone)
+}
+
+func isPostgreSQLFlexibleMaintenanceWindowUpToDate(mw *azuredbv1alpha3.FlexibleServerMaintenanceWindow, in *postgresqlflexible.MaintenanceWindow) bool {
+	if in == nil {
+		return isFlexibleMaintenanceWindowUpToDate(mw, nil, nil, nil, nil)
+	}
+	return isFlexibleMaintenanceWindowUpToDate(mw, in.CustomWindow, in.DayOfWeek, in.StartHour, in.StartMinute)
+}
+
+// UpdatePostgreSQLFlexibleServerObservation updates the supplied observation
+// with the values of the supplied Azure Server.
+func UpdatePostgreSQLFlexibleServerObservation(o *azuredbv1alpha3.FlexibleServerObservation, in postgresqlflexible.Server) {
+	o.ID = azure.ToString(in.ID)
+	o.Type = azure.ToString(in.Type)
+	if in.ServerProperties == nil {
+		return
+	}
+	o.State = azure.ToString(in.State)
+	o.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
+	o.AvailabilityZone = azure.ToString(in.ServerProperties.AvailabilityZone)
+	if in.ServerProperties.HighAvailability != nil {
+		o.HighAvailabilityState = azure.ToString(in.ServerProperties.HighAvailability.State)
+		o.StandbyAvailabilityZone = azure.ToString(in.ServerProperties.HighAvailability.StandbyAvailabilityZone)
+	}
+	if in.Network != nil {
+		o.PublicNetworkAccess = azure.ToString(in.Network.PublicNetworkAccess)
+	}
+}
+
+// NewPostgreSQLFlexibleFirewallRuleParameters returns an Azure FirewallRule
+// object from a firewall rule spec.
+func NewPostgreSQLFlexibleFirewallRuleParameters(r *azuredbv1alpha3.PostgreSQLFlexibleServerFirewallRule) postgresqlflexible.FirewallRule {
+	return postgresqlflexible.FirewallRule{
+		FirewallRuleProperties: &postgresqlflexible.FirewallRuleProperties{
+			StartIPAddress: azure.ToStringPtr(r.Spec.ForProvider.StartIPAddress),
+			EndIPAddress:   azure.ToStringPtr(r.Spec.ForProvider.EndIPAddress),
+		},
+	}
+}
+
+// PostgreSQLFlexibleServerFirewallRuleIsUpToDate returns true if the supplied
+// FirewallRule appears to be up to date with the supplied
+// PostgreSQLFlexibleServerFirewallRule.
+func PostgreSQLFlexibleServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.PostgreSQLFlexibleServerFirewallRule, az postgresqlflexible.FirewallRule) bool {
+	if az.FirewallRuleProperties == nil {
+		return false
+	}
+	return kube.Spec.ForProvider.StartIPAddress == azure.ToString(az.StartIPAddress) &&
+		kube.Spec.ForProvider.EndIPAddress == azure.ToString(az.EndIPAddress)
+}
+
+// NewPostgreSQLFlexibleConfigurationParameters returns an Azure Configuration
+// object that sets the value of a configuration spec.
+func NewPostgreSQLFlexibleConfigurationParameters(c *azuredbv1alpha3.PostgreSQLFlexibleServerConfiguration) postgresqlflexible.Configuration {
+	return postgresqlflexible.Configuration{
+		ConfigurationProperties: &postgresqlflexible.ConfigurationProperties{
+			Value:  azure.ToStringPtr(c.Spec.ForProvider.Value, azure.FieldRequired),
+			Source: azure.ToStringPtr(ConfigurationSourceUserOverride),
+		},
+	}
+}
+
+// NewPostgreSQLFlexibleDefaultConfigurationParameters returns an Azure
+// Configuration object that resets a configuration to its default value.
+func NewPostgreSQLFlexibleDefaultConfigurationParameters() postgresqlflexible.Configuration {
+	return postgresqlflexible.Configuration{
+		ConfigurationProperties: &postgresqlflexible.ConfigurationProperties{
+			Source: azure.ToStringPtr(ConfigurationSourceSystemDefault),
+		},
+	}
+}
+
+// PostgreSQLFlexibleServerConfigurationIsUpToDate returns true if the supplied
+// Configuration has the value of the supplied
+// PostgreSQLFlexibleServerConfiguration.
+func PostgreSQLFlexibleServerConfigurationIsUpToDate(kube *azuredbv1alpha3.PostgreSQLFlexibleServerConfiguration, az postgresqlflexible.Configuration) bool {
+	if az.ConfigurationProperties == nil {
+		return false
+	}
+	return kube.Spec.ForProvider.Value == azure.ToString(az.Value)
+}
+
+// UpdatePostgreSQLFlexibleConfigurationObservation updates the status of the
+// supplied configuration with the supplied Azure Configuration.
+func UpdatePostgreSQLFlexibleConfigurationObservation(o *azuredbv1alpha3.ConfigurationObservation, az postgresqlflexible.Configuration) {
+	o.ID = azure.ToString(az.ID)
+	o.Type = azure.ToString(az.Type)
+	if az.ConfigurationProperties == nil {
+		return
+	}
+	o.Value = azure.ToString(az.Value)
+	o.DefaultValue = azure.ToString(az.DefaultValue)
+	o.DataType = azure.ToString(az.DataType)
+	o.AllowedValues = azure.ToString(az.AllowedValues)
+	o.Source = azure.ToString(az.Source)
+	o.Description = azure.ToString(az.Description)
+}
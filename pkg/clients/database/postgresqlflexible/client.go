@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgresqlflexible implements the Azure ARM Database for PostgreSQL
+// flexible server API version 2021-06-01.
+package postgresqlflexible
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/crossplane/provider-azure/pkg/clients/database/arm"
+)
+
+// APIVersion is the version of the flexible server API.
+const APIVersion = "2021-06-01"
+
+const (
+	serverPath        = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.DBforPostgreSQL/flexibleServers/{serverName}"
+	firewallRulePath  = serverPath + "/firewallRules/{firewallRuleName}"
+	configurationPath = serverPath + "/configurations/{configurationName}"
+)
+
+// ServersClientAPI contains the set of methods on the ServersClient type.
+type ServersClientAPI interface {
+	Create(ctx context.Context, resourceGroupName string, serverName string, parameters Server) (result azure.Future, err error)
+	Delete(ctx context.Context, resourceGroupName string, serverName string) (result azure.Future, err error)
+	Get(ctx context.Context, resourceGroupName string, serverName string) (result Server, err error)
+	Update(ctx context.Context, resourceGroupName string, serverName string, parameters ServerForUpdate) (result azure.Future, err error)
+}
+
+var _ ServersClientAPI = (*ServersClient)(nil)
+
+// ServersClient is the client for flexible servers.
+type ServersClient struct {
+	arm.BaseClient
+}
+
+// NewServersClient creates an instance of the ServersClient client.
+func NewServersClient(subscriptionID string) ServersClient {
+	return ServersClient{arm.New(subscriptionID, APIVersion)}
+}
+
+// Create creates a new server.
+func (client ServersClient) Create(ctx context.Context, resourceGroupName string, serverName string, parameters Server) (azure.Future, error) {
+	return client.Put(ctx, serverPath, serverPathParameters(resourceGroupName, serverName), parameters)
+}
+
+// Delete deletes a server.
+func (client ServersClient) Delete(ctx context.Context, resourceGroupName string, serverName string) (azure.Future, error) {
+	return client.BaseClient.Delete(ctx, serverPath, serverPathParameters(resourceGroupName, serverName))
+}
+
+// Get gets information about a server.
+func (client ServersClient) Get(ctx context.Context, resourceGroupName string, serverName string) (result Server, err error) {
+	err = client.BaseClient.Get(ctx, serverPath, serverPathParameters(resourceGroupName, serverName), &result)
+	return
+}
+
+// Update updates an existing server.
+func (client ServersClient) Update(ctx context.Context, resourceGroupName string, serverName string, parameters ServerForUpdate) (azure.Future, error) {
+	return client.Patch(ctx, serverPath, serverPathParameters(resourceGroupName, serverName), parameters)
+}
+
+// FirewallRulesClientAPI contains the set of methods on the FirewallRulesClient type.
+type FirewallRulesClientAPI interface {
+	CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters FirewallRule) (result azure.Future, err error)
+	Delete(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result azure.Future, err error)
+	Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result FirewallRule, err error)
+}
+
+var _ FirewallRulesClientAPI = (*FirewallRulesClient)(nil)
+
+// FirewallRulesClient is the client for flexible server firewall rules.
+type FirewallRulesClient struct {
+	arm.BaseClient
+}
+
+// NewFirewallRulesClient creates an instance of the FirewallRulesClient client.
+func NewFirewallRulesClient(subscriptionID string) FirewallRulesClient {
+	return FirewallRulesClient{arm.New(subscriptionID, APIVersion)}
+}
+
+// CreateOrUpdate creates a new firewall rule or updates an existing firewall rule.
+func (client FirewallRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters FirewallRule) (azure.Future, error) {
+	return client.Put(ctx, firewallRulePath, firewallRulePathParameters(resourceGroupName, serverName, firewallRuleName), parameters)
+}
+
+// Delete deletes a firewall rule.
+func (client FirewallRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (azure.Future, error) {
+	return client.BaseClient.Delete(ctx, firewallRulePath, firewallRulePathParameters(resourceGroupName, serverName, firewallRuleName))
+}
+
+// Get gets information about a firewall rule.
+func (client FirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result FirewallRule, err error) {
+	err = client.BaseClient.Get(ctx, firewallRulePath, firewallRulePathParameters(resourceGroupName, serverName, firewallRuleName), &result)
+	return
+}
+
+// ConfigurationsClientAPI contains the set of methods on the ConfigurationsClient type.
+type ConfigurationsClientAPI interface {
+	Get(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result Configuration, err error)
+	Put(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters Configuration) (result azure.Future, err error)
+}
+
+var _ ConfigurationsClientAPI = (*ConfigurationsClient)(nil)
+
+// ConfigurationsClient is the client for flexible server configurations.
+type ConfigurationsClient struct {
+	arm.BaseClient
+}
+
+// NewConfigurationsClient creates an instance of the ConfigurationsClient client.
+func NewConfigurationsClient(subscriptionID string) ConfigurationsClient {
+	return ConfigurationsClient{arm.New(subscriptionID, APIVersion)}
+}
+
+// Get gets information about a configuration of a server.
+func (client ConfigurationsClient) Get(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result Configuration, err error) {
+	err = client.BaseClient.Get(ctx, configurationPath, configurationPathParameters(resourceGroupName, serverName, configurationName), &result)
+	return
+}
+
+// Put updates a configuration of a server.
+func (client ConfigurationsClient) Put(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters Configuration) (azure.Future, error) {
+	return client.BaseClient.Put(ctx, configurationPath, configurationPathParameters(resourceGroupName, serverName, configurationName), parameters)
+}
+
+func serverPathParameters(resourceGroupName, serverName string) map[string]interface{} {
+	return map[string]interface{}{
+		"resourceGroupName": resourceGroupName,
+		"serverName":        serverName,
+	}
+}
+
+func firewallRulePathParameters(resourceGroupName, serverName, firewallRuleName string) map[string]interface{} {
+	p := serverPathParameters(resourceGroupName, serverName)
+	p["firewallRuleName"] = firewallRuleName
+	return p
+}
+
+func configurationPathParameters(resourceGroupName, serverName, configurationName string) map[string]interface{} {
+	p := serverPathParameters(resourceGroupName, serverName)
+	p["configurationName"] = configurationName
+	return p
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresqlflexible
+
+import (
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// Sku the SKU of a server.
+type Sku struct {
+	// Name - The name of the SKU, e.g. Standard_D2s_v3.
+	Name *string `json:"name,omitempty"`
+	// Tier - The tier of the SKU. Possible values include: 'Burstable', 'GeneralPurpose', 'MemoryOptimized'
+	Tier *string `json:"tier,omitempty"`
+}
+
+// Storage the storage properties of a server.
+type Storage struct {
+	// StorageSizeGB - Max storage allowed for a server.
+	StorageSizeGB *int32 `json:"storageSizeGB,omitempty"`
+}
+
+// Backup the backup properties of a server.
+type Backup struct {
+	// BackupRetentionDays - Backup retention days for the server.
+	BackupRetentionDays *int32 `json:"backupRetentionDays,omitempty"`
+	// GeoRedundantBackup - Possible values include: 'Enabled', 'Disabled'
+	GeoRedundantBackup *string `json:"geoRedundantBackup,omitempty"`
+	// EarliestRestoreDate - READ-ONLY; The earliest restore point time for the server.
+	EarliestRestoreDate *date.Time `json:"earliestRestoreDate,omitempty"`
+}
+
+// Network the network properties of a server.
+type Network struct {
+	// PublicNetworkAccess - READ-ONLY; Possible values include: 'Enabled', 'Disabled'
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+	// DelegatedSubnetResourceID - The ARM resource ID of the delegated subnet.
+	DelegatedSubnetResourceID *string `json:"delegatedSubnetResourceId,omitempty"`
+	// PrivateDNSZoneArmResourceID - The ARM resource ID of the private DNS zone.
+	PrivateDNSZoneArmResourceID *string `json:"privateDnsZoneArmResourceId,omitempty"`
+}
+
+// HighAvailability the high availability properties of a server.
+type HighAvailability struct {
+	// Mode - Possible values include: 'Disabled', 'ZoneRedundant', 'SameZone'
+	Mode *string `json:"mode,omitempty"`
+	// State - READ-ONLY; The state of the standby server.
+	State *string `json:"state,omitempty"`
+	// StandbyAvailabilityZone - The availability zone of the standby server.
+	StandbyAvailabilityZone *string `json:"standbyAvailabilityZone,omitempty"`
+}
+
+// MaintenanceWindow the maintenance window of a server.
+type MaintenanceWindow struct {
+	// CustomWindow - Possible values include: 'Enabled', 'Disabled'
+	CustomWindow *string `json:"customWindow,omitempty"`
+	// StartHour - The start hour of the window.
+	StartHour *int32 `json:"startHour,omitempty"`
+	// StartMinute - The start minute of the window.
+	StartMinute *int32 `json:"startMinute,omitempty"`
+	// DayOfWeek - The day of the week of the window.
+	DayOfWeek *int32 `json:"dayOfWeek,omitempty"`
+}
+
+// ServerProperties the properties of a server.
+type ServerProperties struct {
+	// AdministratorLogin - The administrator's login name of a server.
+	AdministratorLogin *string `json:"administratorLogin,omitempty"`
+	// AdministratorLoginPassword - The administrator login password.
+	AdministratorLoginPassword *string `json:"administratorLoginPassword,omitempty"`
+	// Version - Possible values include: '11', '12', '13'
+	Version *string `json:"version,omitempty"`
+	// State - READ-ONLY; Possible values include: 'Ready', 'Dropping', 'Disabled', 'Starting', 'Stopping', 'Stopped', 'Updating'
+	State *string `json:"state,omitempty"`
+	// FullyQualifiedDomainName - READ-ONLY; The fully qualified domain name of a server.
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName,omitempty"`
+	// Storage - Storage properties of a server.
+	Storage *Storage `json:"storage,omitempty"`
+	// Backup - Backup properties of a server.
+	Backup *Backup `json:"backup,omitempty"`
+	// Network - Network properties of a server.
+	Network *Network `json:"network,omitempty"`
+	// HighAvailability - High availability properties of a server.
+	HighAvailability *HighAvailability `json:"highAvailability,omitempty"`
+	// MaintenanceWindow - Maintenance window properties of a server.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	// SourceServerResourceID - The source server resource ID to restore from.
+	SourceServerResourceID *string `json:"sourceServerResourceId,omitempty"`
+	// PointInTimeUTC - Restore point creation time.
+	PointInTimeUTC *date.Time `json:"pointInTimeUTC,omitempty"`
+	// AvailabilityZone - The availability zone of a server.
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+	// CreateMode - Possible values include: 'Default', 'PointInTimeRestore'
+	CreateMode *string `json:"createMode,omitempty"`
+}
+
+// Server represents a flexible server.
+type Server struct {
+	// ID - READ-ONLY; Fully qualified resource ID for the resource.
+	ID *string `json:"id,omitempty"`
+	// Name - READ-ONLY; The name of the resource.
+	Name *string `json:"name,omitempty"`
+	// Type - READ-ONLY; The type of the resource.
+	Type *string `json:"type,omitempty"`
+	// Location - The geo-location where the resource lives.
+	Location *string `json:"location,omitempty"`
+	// Tags - Resource tags.
+	Tags map[string]*string `json:"tags"`
+	// Sku - The SKU of the server.
+	Sku *Sku `json:"sku,omitempty"`
+	// ServerProperties - Properties of the server.
+	*ServerProperties `json:"properties,omitempty"`
+}
+
+// ServerPropertiesForUpdate the properties of a server that may be updated.
+type ServerPropertiesForUpdate struct {
+	// AdministratorLoginPassword - The password of the administrator login.
+	AdministratorLoginPassword *string `json:"administratorLoginPassword,omitempty"`
+	// Storage - Storage properties of a server.
+	Storage *Storage `json:"storage,omitempty"`
+	// Backup - Backup properties of a server.
+	Backup *Backup `json:"backup,omitempty"`
+	// HighAvailability - High availability properties of a server.
+	HighAvailability *HighAvailability `json:"highAvailability,omitempty"`
+	// MaintenanceWindow - Maintenance window properties of a server.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// ServerForUpdate represents a server to be updated.
+type ServerForUpdate struct {
+	// Sku - The SKU of the server.
+	Sku *Sku `json:"sku,omitempty"`
+	// ServerPropertiesForUpdate - Properties of the server.
+	*ServerPropertiesForUpdate `json:"properties,omitempty"`
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+}
+
+// FirewallRuleProperties the properties of a server firewall rule.
+type FirewallRuleProperties struct {
+	// StartIPAddress - The start IP address of the server firewall rule. Must be IPv4 format.
+	StartIPAddress *string `json:"startIpAddress,omitempty"`
+	// EndIPAddress - The end IP address of the server firewall rule. Must be IPv4 format.
+	EndIPAddress *string `json:"endIpAddress,omitempty"`
+}
+
+// FirewallRule represents a server firewall rule.
+type FirewallRule struct {
+	// ID - READ-ONLY; Fully qualified resource ID for the resource.
+	ID *string `json:"id,omitempty"`
+	// Name - READ-ONLY; The name of the resource.
+	Name *string `json:"name,omitempty"`
+	// Type - READ-ONLY; The type of the resource.
+	Type *string `json:"type,omitempty"`
+	// FirewallRuleProperties - The properties of a firewall rule.
+	*FirewallRuleProperties `json:"properties,omitempty"`
+}
+
+// ConfigurationProperties the properties of a configuration.
+type ConfigurationProperties struct {
+	// Value - Value of the configuration.
+	Value *string `json:"value,omitempty"`
+	// Description - READ-ONLY; Description of the configuration.
+	Description *string `json:"description,omitempty"`
+	// DefaultValue - READ-ONLY; Default value of the configuration.
+	DefaultValue *string `json:"defaultValue,omitempty"`
+	// DataType - READ-ONLY; Data type of the configuration.
+	DataType *string `json:"dataType,omitempty"`
+	// AllowedValues - READ-ONLY; Allowed values of the configuration.
+	AllowedValues *string `json:"allowedValues,omitempty"`
+	// Source - Source of the configuration.
+	Source *string `json:"source,omitempty"`
+}
+
+// Configuration represents a configuration.
+type Configuration struct {
+	// ID - READ-ONLY; Fully qualified resource ID for the resource.
+	ID *string `json:"id,omitempty"`
+	// Name - READ-ONLY; The name of the resource.
+	Name *string `json:"name,omitempty"`
+	// Type - READ-ONLY; The type of the resource.
+	Type *string `json:"type,omitempty"`
+	// ConfigurationProperties - The properties of a configuration.
+	*ConfigurationProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database/postgresqlflexible"
+)
+
+func TestNewPostgreSQLFlexibleServerParameters(t *testing.T) {
+	admin := "cooladmin"
+	password := "verysecure"
+	source := "/subscriptions/cool/resourceGroups/rg/providers/Microsoft.DBforPostgreSQL/flexibleServers/source"
+
+	cases := map[string]struct {
+		p    v1alpha3.PostgreSQLFlexibleServerParameters
+		pw   string
+		want *postgresqlflexible.ServerProperties
+	}{
+		"Default": {
+			p: v1alpha3.PostgreSQLFlexibleServerParameters{
+				AdministratorLogin: admin,
+				StorageSizeGB:      to.IntPtr(32),
+				MaintenanceWindow:  &v1alpha3.FlexibleServerMaintenanceWindow{DayOfWeek: 0, StartHour: 2},
+			},
+			pw: password,
+			want: &postgresqlflexible.ServerProperties{
+				AdministratorLogin:         azure.ToStringPtr(admin),
+				AdministratorLoginPassword: azure.ToStringPtr(password),
+				Storage:                    &postgresqlflexible.Storage{StorageSizeGB: azure.ToInt32Ptr(32)},
+				Backup:                     &postgresqlflexible.Backup{},
+				Network:                    &postgresqlflexible.Network{},
+				MaintenanceWindow: &postgresqlflexible.MaintenanceWindow{
+					CustomWindow: azure.ToStringPtr(customWindowEnabled),
+					DayOfWeek:    azure.ToInt32Ptr(0, azure.FieldRequired),
+					StartHour:    azure.ToInt32Ptr(2, azure.FieldRequired),
+					StartMinute:  azure.ToInt32Ptr(0, azure.FieldRequired),
+				},
+			},
+		},
+		"PointInTimeRestore": {
+			p: v1alpha3.PostgreSQLFlexibleServerParameters{
+				AdministratorLogin: admin,
+				CreateMode:         azure.ToStringPtr(v1alpha3.FlexibleServerCreateModePointInTimeRestore),
+				SourceServerID:     azure.ToStringPtr(source),
+			},
+			pw: password,
+			want: &postgresqlflexible.ServerProperties{
+				Storage:                &postgresqlflexible.Storage{},
+				Backup:                 &postgresqlflexible.Backup{},
+				Network:                &postgresqlflexible.Network{},
+				CreateMode:             azure.ToStringPtr(v1alpha3.FlexibleServerCreateModePointInTimeRestore),
+				SourceServerResourceID: azure.ToStringPtr(source),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewPostgreSQLFlexibleServerParameters(tc.p, tc.pw)
+			if diff := cmp.Diff(tc.want, got.ServerProperties); diff != "" {
+				t.Errorf("NewPostgreSQLFlexibleServerParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPostgreSQLFlexibleServerUpToDate(t *testing.T) {
+	server := func(storage int32, mw *postgresqlflexible.MaintenanceWindow) postgresqlflexible.Server {
+		return postgresqlflexible.Server{
+			Sku: &postgresqlflexible.Sku{
+				Name: azure.ToStringPtr("Standard_B1ms"),
+				Tier: azure.ToStringPtr("Burstable"),
+			},
+			ServerProperties: &postgresqlflexible.ServerProperties{
+				Storage:           &postgresqlflexible.Storage{StorageSizeGB: azure.ToInt32Ptr(int(storage))},
+				Backup:            &postgresqlflexible.Backup{},
+				MaintenanceWindow: mw,
+			},
+		}
+	}
+	params := v1alpha3.PostgreSQLFlexibleServerParameters{
+		SKU:           v1alpha3.FlexibleServerSKU{Name: "Standard_B1ms", Tier: "Burstable"},
+		StorageSizeGB: to.IntPtr(32),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.PostgreSQLFlexibleServerParameters
+		az   postgresqlflexible.Server
+		want bool
+	}{
+		"UpToDate": {
+			p:    params,
+			az:   server(32, &postgresqlflexible.MaintenanceWindow{CustomWindow: azure.ToStringPtr(customWindowDisabled)}),
+			want: true,
+		},
+		"StorageNeedsUpdate": {
+			p:    params,
+			az:   server(64, nil),
+			want: false,
+		},
+		"MaintenanceWindowNeedsUpdate": {
+			p: func() v1alpha3.PostgreSQLFlexibleServerParameters {
+				p := params
+				p.MaintenanceWindow = &v1alpha3.FlexibleServerMaintenanceWindow{DayOfWeek: 1}
+				return p
+			}(),
+			az:   server(32, &postgresqlflexible.MaintenanceWindow{CustomWindow: azure.ToStringPtr(customWindowDisabled)}),
+			want: false,
+		},
+		"HighAvailabilityNeedsUpdate": {
+			p: func() v1alpha3.PostgreSQLFlexibleServerParameters {
+				p := params
+				p.HighAvailability = &v1alpha3.FlexibleServerHighAvailability{Mode: "ZoneRedundant"}
+				return p
+			}(),
+			az:   server(32, nil),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPostgreSQLFlexibleServerUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPostgreSQLFlexibleServerUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializePostgreSQLFlexibleServer(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.PostgreSQLFlexibleServerParameters
+		az   postgresqlflexible.Server
+		want v1alpha3.PostgreSQLFlexibleServerParameters
+	}{
+		"LateInitialized": {
+			p: v1alpha3.PostgreSQLFlexibleServerParameters{},
+			az: postgresqlflexible.Server{
+				ServerProperties: &postgresqlflexible.ServerProperties{
+					AdministratorLogin: azure.ToStringPtr("cooladmin"),
+					Version:            azure.ToStringPtr("13"),
+					Storage:            &postgresqlflexible.Storage{StorageSizeGB: azure.ToInt32Ptr(32)},
+					HighAvailability:   &postgresqlflexible.HighAvailability{Mode: azure.ToStringPtr("Disabled")},
+					MaintenanceWindow:  &postgresqlflexible.MaintenanceWindow{CustomWindow: azure.ToStringPtr(customWindowDisabled)},
+				},
+			},
+			want: v1alpha3.PostgreSQLFlexibleServerParameters{
+				AdministratorLogin: "cooladmin",
+				Version:            azure.ToStringPtr("13"),
+				StorageSizeGB:      to.IntPtr(32),
+				HighAvailability:   &v1alpha3.FlexibleServerHighAvailability{Mode: "Disabled"},
+			},
+		},
+		"NotOverwritten": {
+			p: v1alpha3.PostgreSQLFlexibleServerParameters{
+				Version:       azure.ToStringPtr("12"),
+				StorageSizeGB: to.IntPtr(64),
+			},
+			az: postgresqlflexible.Server{
+				ServerProperties: &postgresqlflexible.ServerProperties{
+					Version: azure.ToStringPtr("13"),
+					Storage: &postgresqlflexible.Storage{StorageSizeGB: azure.ToInt32Ptr(32)},
+				},
+			},
+			want: v1alpha3.PostgreSQLFlexibleServerParameters{
+				Version:       azure.ToStringPtr("12"),
+				StorageSizeGB: to.IntPtr(64),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializePostgreSQLFlexibleServer(&tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializePostgreSQLFlexibleServer(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,10 +19,18 @@ package fake
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb/mariadbapi"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql/mysqlapi"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql/postgresqlapi"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/crossplane/provider-azure/pkg/clients/database/postgresqlflexible"
 )
 
 var _ mysqlapi.VirtualNetworkRulesClientAPI = &MockMySQLVirtualNetworkRulesClient{}
@@ -128,3 +136,345 @@ func (c *MockPostgreSQLFirewallRulesClient) Delete(ctx context.Context, resource
 func (c *MockPostgreSQLFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result postgresql.FirewallRule, err error) {
 	return c.MockGet(ctx, resourceGroupName, serverName, firewallRuleName)
 }
+
+var _ mysqlapi.ConfigurationsClientAPI = &MockMySQLConfigurationsClient{}
+
+// MockMySQLConfigurationsClient is a fake implementation of mysql.ConfigurationsClient.
+type MockMySQLConfigurationsClient struct {
+	mysqlapi.ConfigurationsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters mysql.Configuration) (result mysql.ConfigurationsCreateOrUpdateFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result mysql.Configuration, err error)
+}
+
+// CreateOrUpdate calls the MockMySQLConfigurationsClient's MockCreateOrUpdate method.
+func (c *MockMySQLConfigurationsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters mysql.Configuration) (result mysql.ConfigurationsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, configurationName, parameters)
+}
+
+// Get calls the MockMySQLConfigurationsClient's MockGet method.
+func (c *MockMySQLConfigurationsClient) Get(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result mysql.Configuration, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, configurationName)
+}
+
+var _ postgresqlapi.ConfigurationsClientAPI = &MockPostgreSQLConfigurationsClient{}
+
+// MockPostgreSQLConfigurationsClient is a fake implementation of postgresql.ConfigurationsClient.
+type MockPostgreSQLConfigurationsClient struct {
+	postgresqlapi.ConfigurationsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters postgresql.Configuration) (result postgresql.ConfigurationsCreateOrUpdateFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result postgresql.Configuration, err error)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLConfigurationsClient's MockCreateOrUpdate method.
+func (c *MockPostgreSQLConfigurationsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters postgresql.Configuration) (result postgresql.ConfigurationsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, configurationName, parameters)
+}
+
+// Get calls the MockPostgreSQLConfigurationsClient's MockGet method.
+func (c *MockPostgreSQLConfigurationsClient) Get(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result postgresql.Configuration, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, configurationName)
+}
+
+var _ mysqlapi.ServersClientAPI = &MockMySQLServersClient{}
+
+// MockMySQLServersClient is a fake implementation of mysql.ServersClient.
+type MockMySQLServersClient struct {
+	mysqlapi.ServersClientAPI
+
+	MockGet func(ctx context.Context, resourceGroupName string, serverName string) (result mysql.Server, err error)
+}
+
+// Get calls the MockMySQLServersClient's MockGet method.
+func (c *MockMySQLServersClient) Get(ctx context.Context, resourceGroupName string, serverName string) (result mysql.Server, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName)
+}
+
+var _ mysqlapi.DatabasesClientAPI = &MockMySQLDatabasesClient{}
+
+// MockMySQLDatabasesClient is a fake implementation of mysql.DatabasesClient.
+type MockMySQLDatabasesClient struct {
+	mysqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters mysql.Database) (result mysql.DatabasesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.Database, err error)
+}
+
+// CreateOrUpdate calls the MockMySQLDatabasesClient's MockCreateOrUpdate method.
+func (c *MockMySQLDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters mysql.Database) (result mysql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockMySQLDatabasesClient's MockDelete method.
+func (c *MockMySQLDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockMySQLDatabasesClient's MockGet method.
+func (c *MockMySQLDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+var _ postgresqlapi.ServersClientAPI = &MockPostgreSQLServersClient{}
+
+// MockPostgreSQLServersClient is a fake implementation of postgresql.ServersClient.
+type MockPostgreSQLServersClient struct {
+	postgresqlapi.ServersClientAPI
+
+	MockGet func(ctx context.Context, resourceGroupName string, serverName string) (result postgresql.Server, err error)
+}
+
+// Get calls the MockPostgreSQLServersClient's MockGet method.
+func (c *MockPostgreSQLServersClient) Get(ctx context.Context, resourceGroupName string, serverName string) (result postgresql.Server, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName)
+}
+
+var _ postgresqlapi.DatabasesClientAPI = &MockPostgreSQLDatabasesClient{}
+
+// MockPostgreSQLDatabasesClient is a fake implementation of postgresql.DatabasesClient.
+type MockPostgreSQLDatabasesClient struct {
+	postgresqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters postgresql.Database) (result postgresql.DatabasesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.Database, err error)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLDatabasesClient's MockCreateOrUpdate method.
+func (c *MockPostgreSQLDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters postgresql.Database) (result postgresql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockPostgreSQLDatabasesClient's MockDelete method.
+func (c *MockPostgreSQLDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockPostgreSQLDatabasesClient's MockGet method.
+func (c *MockPostgreSQLDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+var _ postgresqlflexible.FirewallRulesClientAPI = &MockPostgreSQLFlexibleFirewallRulesClient{}
+
+// MockPostgreSQLFlexibleFirewallRulesClient is a fake implementation of postgresqlflexible.FirewallRulesClient.
+type MockPostgreSQLFlexibleFirewallRulesClient struct {
+	postgresqlflexible.FirewallRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters postgresqlflexible.FirewallRule) (result azure.Future, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result azure.Future, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result postgresqlflexible.FirewallRule, err error)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLFlexibleFirewallRulesClient's MockCreateOrUpdate method.
+func (c *MockPostgreSQLFlexibleFirewallRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters postgresqlflexible.FirewallRule) (result azure.Future, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, firewallRuleName, parameters)
+}
+
+// Delete calls the MockPostgreSQLFlexibleFirewallRulesClient's MockDelete method.
+func (c *MockPostgreSQLFlexibleFirewallRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result azure.Future, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, firewallRuleName)
+}
+
+// Get calls the MockPostgreSQLFlexibleFirewallRulesClient's MockGet method.
+func (c *MockPostgreSQLFlexibleFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result postgresqlflexible.FirewallRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, firewallRuleName)
+}
+
+var _ postgresqlflexible.ConfigurationsClientAPI = &MockPostgreSQLFlexibleConfigurationsClient{}
+
+// MockPostgreSQLFlexibleConfigurationsClient is a fake implementation of postgresqlflexible.ConfigurationsClient.
+type MockPostgreSQLFlexibleConfigurationsClient struct {
+	postgresqlflexible.ConfigurationsClientAPI
+
+	MockPut func(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters postgresqlflexible.Configuration) (result azure.Future, err error)
+	MockGet func(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result postgresqlflexible.Configuration, err error)
+}
+
+// Put calls the MockPostgreSQLFlexibleConfigurationsClient's MockPut method.
+func (c *MockPostgreSQLFlexibleConfigurationsClient) Put(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters postgresqlflexible.Configuration) (result azure.Future, err error) {
+	return c.MockPut(ctx, resourceGroupName, serverName, configurationName, parameters)
+}
+
+// Get calls the MockPostgreSQLFlexibleConfigurationsClient's MockGet method.
+func (c *MockPostgreSQLFlexibleConfigurationsClient) Get(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result postgresqlflexible.Configuration, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, configurationName)
+}
+
+var _ sqlapi.DatabasesClientAPI = &MockMSSQLDatabasesClient{}
+
+// MockMSSQLDatabasesClient is a fake implementation of sql.DatabasesClient.
+type MockMSSQLDatabasesClient struct {
+	sqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.Database) (result sql.DatabasesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.Database, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLDatabasesClient's MockCreateOrUpdate method.
+func (c *MockMSSQLDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.Database) (result sql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockMSSQLDatabasesClient's MockDelete method.
+func (c *MockMSSQLDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockMSSQLDatabasesClient's MockGet method.
+func (c *MockMSSQLDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+var _ sqlapi.TransparentDataEncryptionsClientAPI = &MockMSSQLTransparentDataEncryptionsClient{}
+
+// MockMSSQLTransparentDataEncryptionsClient is a fake implementation of sql.TransparentDataEncryptionsClient.
+type MockMSSQLTransparentDataEncryptionsClient struct {
+	sqlapi.TransparentDataEncryptionsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.TransparentDataEncryption) (result sql.TransparentDataEncryption, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.TransparentDataEncryption, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLTransparentDataEncryptionsClient's MockCreateOrUpdate method.
+func (c *MockMSSQLTransparentDataEncryptionsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.TransparentDataEncryption) (result sql.TransparentDataEncryption, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Get calls the MockMSSQLTransparentDataEncryptionsClient's MockGet method.
+func (c *MockMSSQLTransparentDataEncryptionsClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.TransparentDataEncryption, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+var _ sqlapi.ElasticPoolsClientAPI = &MockMSSQLElasticPoolsClient{}
+
+// MockMSSQLElasticPoolsClient is a fake implementation of sql.ElasticPoolsClient.
+type MockMSSQLElasticPoolsClient struct {
+	sqlapi.ElasticPoolsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPool) (result sql.ElasticPoolsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPoolsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPool, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLElasticPoolsClient's MockCreateOrUpdate method.
+func (c *MockMSSQLElasticPoolsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPool) (result sql.ElasticPoolsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, elasticPoolName, parameters)
+}
+
+// Delete calls the MockMSSQLElasticPoolsClient's MockDelete method.
+func (c *MockMSSQLElasticPoolsClient) Delete(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPoolsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, elasticPoolName)
+}
+
+// Get calls the MockMSSQLElasticPoolsClient's MockGet method.
+func (c *MockMSSQLElasticPoolsClient) Get(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPool, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, elasticPoolName)
+}
+
+var _ sqlapi.FirewallRulesClientAPI = &MockMSSQLFirewallRulesClient{}
+
+// MockMSSQLFirewallRulesClient is a fake implementation of sql.FirewallRulesClient.
+type MockMSSQLFirewallRulesClient struct {
+	sqlapi.FirewallRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters sql.FirewallRule) (result sql.FirewallRule, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result sql.FirewallRule, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLFirewallRulesClient's MockCreateOrUpdate method.
+func (c *MockMSSQLFirewallRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters sql.FirewallRule) (result sql.FirewallRule, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, firewallRuleName, parameters)
+}
+
+// Delete calls the MockMSSQLFirewallRulesClient's MockDelete method.
+func (c *MockMSSQLFirewallRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, firewallRuleName)
+}
+
+// Get calls the MockMSSQLFirewallRulesClient's MockGet method.
+func (c *MockMSSQLFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result sql.FirewallRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, firewallRuleName)
+}
+
+var _ sqlapi.VirtualNetworkRulesClientAPI = &MockMSSQLVirtualNetworkRulesClient{}
+
+// MockMSSQLVirtualNetworkRulesClient is a fake implementation of sql.VirtualNetworkRulesClient.
+type MockMSSQLVirtualNetworkRulesClient struct {
+	sqlapi.VirtualNetworkRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string, parameters sql.VirtualNetworkRule) (result sql.VirtualNetworkRulesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result sql.VirtualNetworkRulesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result sql.VirtualNetworkRule, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLVirtualNetworkRulesClient's MockCreateOrUpdate method.
+func (c *MockMSSQLVirtualNetworkRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string, parameters sql.VirtualNetworkRule) (result sql.VirtualNetworkRulesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, virtualNetworkRuleName, parameters)
+}
+
+// Delete calls the MockMSSQLVirtualNetworkRulesClient's MockDelete method.
+func (c *MockMSSQLVirtualNetworkRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result sql.VirtualNetworkRulesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, virtualNetworkRuleName)
+}
+
+// Get calls the MockMSSQLVirtualNetworkRulesClient's MockGet method.
+func (c *MockMSSQLVirtualNetworkRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result sql.VirtualNetworkRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, virtualNetworkRuleName)
+}
+
+var _ mariadbapi.VirtualNetworkRulesClientAPI = &MockMariaDBVirtualNetworkRulesClient{}
+
+// MockMariaDBVirtualNetworkRulesClient is a fake implementation of mariadb.VirtualNetworkRulesClient.
+type MockMariaDBVirtualNetworkRulesClient struct {
+	mariadbapi.VirtualNetworkRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string, parameters mariadb.VirtualNetworkRule) (result mariadb.VirtualNetworkRulesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result mariadb.VirtualNetworkRulesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result mariadb.VirtualNetworkRule, err error)
+}
+
+// CreateOrUpdate calls the MockMariaDBVirtualNetworkRulesClient's MockCreateOrUpdate method.
+func (c *MockMariaDBVirtualNetworkRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string, parameters mariadb.VirtualNetworkRule) (result mariadb.VirtualNetworkRulesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, virtualNetworkRuleName, parameters)
+}
+
+// Delete calls the MockMariaDBVirtualNetworkRulesClient's MockDelete method.
+func (c *MockMariaDBVirtualNetworkRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result mariadb.VirtualNetworkRulesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, virtualNetworkRuleName)
+}
+
+// Get calls the MockMariaDBVirtualNetworkRulesClient's MockGet method.
+func (c *MockMariaDBVirtualNetworkRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result mariadb.VirtualNetworkRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, virtualNetworkRuleName)
+}
+
+var _ mariadbapi.FirewallRulesClientAPI = &MockMariaDBFirewallRulesClient{}
+
+// MockMariaDBFirewallRulesClient is a fake implementation of mariadb.FirewallRulesClient.
+type MockMariaDBFirewallRulesClient struct {
+	mariadbapi.FirewallRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters mariadb.FirewallRule) (result mariadb.FirewallRulesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result mariadb.FirewallRulesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result mariadb.FirewallRule, err error)
+}
+
+// CreateOrUpdate calls the MockMariaDBFirewallRulesClient's MockCreateOrUpdate method.
+func (c *MockMariaDBFirewallRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters mariadb.FirewallRule) (result mariadb.FirewallRulesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, firewallRuleName, parameters)
+}
+
+// Delete calls the MockMariaDBFirewallRulesClient's MockDelete method.
+func (c *MockMariaDBFirewallRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result mariadb.FirewallRulesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, firewallRuleName)
+}
+
+// Get calls the MockMariaDBFirewallRulesClient's MockGet method.
+func (c *MockMariaDBFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result mariadb.FirewallRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, firewallRuleName)
+}
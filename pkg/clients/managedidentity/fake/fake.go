@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi/msiapi"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var _ msiapi.UserAssignedIdentitiesClientAPI = &MockUserAssignedIdentitiesClient{}
+
+// MockUserAssignedIdentitiesClient is a fake implementation of
+// msi.UserAssignedIdentitiesClient.
+type MockUserAssignedIdentitiesClient struct {
+	msiapi.UserAssignedIdentitiesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, resourceName string, parameters msi.Identity) (result msi.Identity, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, resourceName string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, resourceName string) (result msi.Identity, err error)
+}
+
+// CreateOrUpdate calls the MockUserAssignedIdentitiesClient's
+// MockCreateOrUpdate method.
+func (c *MockUserAssignedIdentitiesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, resourceName string, parameters msi.Identity) (result msi.Identity, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, resourceName, parameters)
+}
+
+// Delete calls the MockUserAssignedIdentitiesClient's MockDelete method.
+func (c *MockUserAssignedIdentitiesClient) Delete(ctx context.Context, resourceGroupName string, resourceName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, resourceName)
+}
+
+// Get calls the MockUserAssignedIdentitiesClient's MockGet method.
+func (c *MockUserAssignedIdentitiesClient) Get(ctx context.Context, resourceGroupName string, resourceName string) (result msi.Identity, err error) {
+	return c.MockGet(ctx, resourceGroupName, resourceName)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedidentity
+
+import (
+	"reflect"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+
+	"github.com/crossplane/provider-azure/apis/managedidentity/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// NewUserAssignedIdentityParameters returns the Azure Identity a
+// UserAssignedIdentity should be created or updated with.
+func NewUserAssignedIdentityParameters(p v1alpha3.UserAssignedIdentityParameters) msi.Identity {
+	return msi.Identity{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+	}
+}
+
+// LateInitializeUserAssignedIdentity fills the empty fields of the supplied
+// UserAssignedIdentity spec with the values of the supplied Azure Identity.
+func LateInitializeUserAssignedIdentity(p *v1alpha3.UserAssignedIdentityParameters, in msi.Identity) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+}
+
+// UpdateUserAssignedIdentityObservation updates the supplied
+// UserAssignedIdentity observation with the values of the supplied Azure
+// Identity.
+func UpdateUserAssignedIdentityObservation(o *v1alpha3.UserAssignedIdentityObservation, in msi.Identity) {
+	o.ID = azure.ToString(in.ID)
+	if in.UserAssignedIdentityProperties == nil {
+		return
+	}
+	if in.TenantID != nil {
+		o.TenantID = in.TenantID.String()
+	}
+	if in.PrincipalID != nil {
+		o.PrincipalID = in.PrincipalID.String()
+	}
+	if in.ClientID != nil {
+		o.ClientID = in.ClientID.String()
+	}
+}
+
+// IsUserAssignedIdentityUpToDate returns true if the supplied Azure Identity
+// matches the supplied UserAssignedIdentity spec.
+func IsUserAssignedIdentityUpToDate(p v1alpha3.UserAssignedIdentityParameters, in msi.Identity) bool {
+	return reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedidentity
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/google/go-cmp/cmp"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/crossplane/provider-azure/apis/managedidentity/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestLateInitializeUserAssignedIdentity(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha3.UserAssignedIdentityParameters
+		az   msi.Identity
+		want *v1alpha3.UserAssignedIdentityParameters
+	}{
+		"NoTags": {
+			p:    &v1alpha3.UserAssignedIdentityParameters{},
+			az:   msi.Identity{},
+			want: &v1alpha3.UserAssignedIdentityParameters{},
+		},
+		"LateInitializeTags": {
+			p:    &v1alpha3.UserAssignedIdentityParameters{},
+			az:   msi.Identity{Tags: map[string]*string{"cool": azure.ToStringPtr("very")}},
+			want: &v1alpha3.UserAssignedIdentityParameters{Tags: map[string]string{"cool": "very"}},
+		},
+		"TagsAlreadySpecified": {
+			p:    &v1alpha3.UserAssignedIdentityParameters{Tags: map[string]string{"cool": "extremely"}},
+			az:   msi.Identity{Tags: map[string]*string{"cool": azure.ToStringPtr("very")}},
+			want: &v1alpha3.UserAssignedIdentityParameters{Tags: map[string]string{"cool": "extremely"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeUserAssignedIdentity(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeUserAssignedIdentity(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateUserAssignedIdentityObservation(t *testing.T) {
+	id := "/subscriptions/cool/resourceGroups/coolgroup/providers/Microsoft.ManagedIdentity/userAssignedIdentities/coolidentity"
+	tid := uuid.Must(uuid.FromString("6a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"))
+	pid := uuid.Must(uuid.FromString("0c2b6d7a-2a4c-4e5f-8c6c-3a1f2e6b9d10"))
+	cid := uuid.Must(uuid.FromString("3f4e5d6c-7b8a-4c9d-8e0f-1a2b3c4d5e6f"))
+
+	cases := map[string]struct {
+		az   msi.Identity
+		want v1alpha3.UserAssignedIdentityObservation
+	}{
+		"NoProperties": {
+			az:   msi.Identity{ID: azure.ToStringPtr(id)},
+			want: v1alpha3.UserAssignedIdentityObservation{ID: id},
+		},
+		"Properties": {
+			az: msi.Identity{
+				ID: azure.ToStringPtr(id),
+				UserAssignedIdentityProperties: &msi.UserAssignedIdentityProperties{
+					TenantID:    &tid,
+					PrincipalID: &pid,
+					ClientID:    &cid,
+				},
+			},
+			want: v1alpha3.UserAssignedIdentityObservation{
+				ID:          id,
+				TenantID:    tid.String(),
+				PrincipalID: pid.String(),
+				ClientID:    cid.String(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := v1alpha3.UserAssignedIdentityObservation{}
+			UpdateUserAssignedIdentityObservation(&got, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpdateUserAssignedIdentityObservation(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUserAssignedIdentityUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.UserAssignedIdentityParameters
+		az   msi.Identity
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha3.UserAssignedIdentityParameters{Tags: map[string]string{"cool": "very"}},
+			az:   msi.Identity{Tags: map[string]*string{"cool": azure.ToStringPtr("very")}},
+			want: true,
+		},
+		"TagsNeedUpdate": {
+			p:    v1alpha3.UserAssignedIdentityParameters{Tags: map[string]string{"cool": "extremely"}},
+			az:   msi.Identity{Tags: map[string]*string{"cool": azure.ToStringPtr("very")}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUserAssignedIdentityUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUserAssignedIdentityUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,12 +21,12 @@ import (
 
 	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 
-	"github.com/crossplane/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/network/v1beta1"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
 // NewVirtualNetworkParameters returns an Azure VirtualNetwork object from a virtual network spec
-func NewVirtualNetworkParameters(v *v1alpha3.VirtualNetwork) networkmgmt.VirtualNetwork {
+func NewVirtualNetworkParameters(v *v1beta1.VirtualNetwork) networkmgmt.VirtualNetwork {
 	return networkmgmt.VirtualNetwork{
 		Location: azure.ToStringPtr(v.Spec.Location),
 		Tags:     azure.ToStringPtrMap(v.Spec.Tags),
@@ -41,7 +41,7 @@ func NewVirtualNetworkParameters(v *v1alpha3.VirtualNetwork) networkmgmt.Virtual
 }
 
 // VirtualNetworkNeedsUpdate determines if a virtual network need to be updated
-func VirtualNetworkNeedsUpdate(kube *v1alpha3.VirtualNetwork, az networkmgmt.VirtualNetwork) bool {
+func VirtualNetworkNeedsUpdate(kube *v1beta1.VirtualNetwork, az networkmgmt.VirtualNetwork) bool {
 	up := NewVirtualNetworkParameters(kube)
 
 	switch {
@@ -60,7 +60,7 @@ func VirtualNetworkNeedsUpdate(kube *v1alpha3.VirtualNetwork, az networkmgmt.Vir
 
 // UpdateVirtualNetworkStatusFromAzure updates the status related to the external
 // Azure virtual network in the VirtualNetworkStatus
-func UpdateVirtualNetworkStatusFromAzure(v *v1alpha3.VirtualNetwork, az networkmgmt.VirtualNetwork) {
+func UpdateVirtualNetworkStatusFromAzure(v *v1beta1.VirtualNetwork, az networkmgmt.VirtualNetwork) {
 	v.Status.State = azure.ToString(az.ProvisioningState)
 	v.Status.ID = azure.ToString(az.ID)
 	v.Status.Etag = azure.ToString(az.Etag)
@@ -69,7 +69,7 @@ func UpdateVirtualNetworkStatusFromAzure(v *v1alpha3.VirtualNetwork, az networkm
 }
 
 // NewSubnetParameters returns an Azure Subnet object from a subnet spec
-func NewSubnetParameters(s *v1alpha3.Subnet) networkmgmt.Subnet {
+func NewSubnetParameters(s *v1beta1.Subnet) networkmgmt.Subnet {
 	return networkmgmt.Subnet{
 		SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
 			AddressPrefix:    azure.ToStringPtr(s.Spec.SubnetPropertiesFormat.AddressPrefix),
@@ -79,7 +79,7 @@ func NewSubnetParameters(s *v1alpha3.Subnet) networkmgmt.Subnet {
 }
 
 // NewServiceEndpoints converts to Azure ServiceEndpointPropertiesFormat
-func NewServiceEndpoints(e []v1alpha3.ServiceEndpointPropertiesFormat) *[]networkmgmt.ServiceEndpointPropertiesFormat {
+func NewServiceEndpoints(e []v1beta1.ServiceEndpointPropertiesFormat) *[]networkmgmt.ServiceEndpointPropertiesFormat {
 	endpoints := make([]networkmgmt.ServiceEndpointPropertiesFormat, len(e))
 
 	for i, end := range e {
@@ -92,7 +92,7 @@ func NewServiceEndpoints(e []v1alpha3.ServiceEndpointPropertiesFormat) *[]networ
 }
 
 // SubnetNeedsUpdate determines if a virtual network need to be updated
-func SubnetNeedsUpdate(kube *v1alpha3.Subnet, az networkmgmt.Subnet) bool {
+func SubnetNeedsUpdate(kube *v1beta1.Subnet, az networkmgmt.Subnet) bool {
 	up := NewSubnetParameters(kube)
 
 	return !reflect.DeepEqual(up.SubnetPropertiesFormat.AddressPrefix, az.SubnetPropertiesFormat.AddressPrefix)
@@ -100,7 +100,7 @@ func SubnetNeedsUpdate(kube *v1alpha3.Subnet, az networkmgmt.Subnet) bool {
 
 // UpdateSubnetStatusFromAzure updates the status related to the external
 // Azure subnet in the SubnetStatus
-func UpdateSubnetStatusFromAzure(v *v1alpha3.Subnet, az networkmgmt.Subnet) {
+func UpdateSubnetStatusFromAzure(v *v1beta1.Subnet, az networkmgmt.Subnet) {
 	v.Status.State = azure.ToString(az.ProvisioningState)
 	v.Status.Etag = azure.ToString(az.Etag)
 	v.Status.ID = azure.ToString(az.ID)
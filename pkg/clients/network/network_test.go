@@ -27,7 +27,7 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
-	"github.com/crossplane/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/network/v1beta1"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
@@ -50,17 +50,17 @@ var (
 func TestNewVirtualNetworkParameters(t *testing.T) {
 	cases := []struct {
 		name string
-		r    *v1alpha3.VirtualNetwork
+		r    *v1beta1.VirtualNetwork
 		want networkmgmt.VirtualNetwork
 	}{
 		{
 			name: "SuccessfulFull",
-			r: &v1alpha3.VirtualNetwork{
+			r: &v1beta1.VirtualNetwork{
 				ObjectMeta: metav1.ObjectMeta{UID: uid},
-				Spec: v1alpha3.VirtualNetworkSpec{
+				Spec: v1beta1.VirtualNetworkSpec{
 					Location: location,
-					VirtualNetworkPropertiesFormat: v1alpha3.VirtualNetworkPropertiesFormat{
-						AddressSpace: v1alpha3.AddressSpace{
+					VirtualNetworkPropertiesFormat: v1beta1.VirtualNetworkPropertiesFormat{
+						AddressSpace: v1beta1.AddressSpace{
 							AddressPrefixes: addressPrefixes,
 						},
 						EnableDDOSProtection: enableDDOSProtection,
@@ -82,12 +82,12 @@ func TestNewVirtualNetworkParameters(t *testing.T) {
 		},
 		{
 			name: "SuccessfulPartial",
-			r: &v1alpha3.VirtualNetwork{
+			r: &v1beta1.VirtualNetwork{
 				ObjectMeta: metav1.ObjectMeta{UID: uid},
-				Spec: v1alpha3.VirtualNetworkSpec{
+				Spec: v1beta1.VirtualNetworkSpec{
 					Location: location,
-					VirtualNetworkPropertiesFormat: v1alpha3.VirtualNetworkPropertiesFormat{
-						AddressSpace: v1alpha3.AddressSpace{
+					VirtualNetworkPropertiesFormat: v1beta1.VirtualNetworkPropertiesFormat{
+						AddressSpace: v1beta1.AddressSpace{
 							AddressPrefixes: addressPrefixes,
 						},
 						EnableDDOSProtection: enableDDOSProtection,
@@ -121,16 +121,16 @@ func TestNewVirtualNetworkParameters(t *testing.T) {
 func TestVirtualNetworkNeedsUpdate(t *testing.T) {
 	cases := []struct {
 		name string
-		kube *v1alpha3.VirtualNetwork
+		kube *v1beta1.VirtualNetwork
 		az   networkmgmt.VirtualNetwork
 		want bool
 	}{
 		{
 			name: "NeedsUpdateAddressSpace",
-			kube: &v1alpha3.VirtualNetwork{
-				Spec: v1alpha3.VirtualNetworkSpec{
-					VirtualNetworkPropertiesFormat: v1alpha3.VirtualNetworkPropertiesFormat{
-						AddressSpace: v1alpha3.AddressSpace{
+			kube: &v1beta1.VirtualNetwork{
+				Spec: v1beta1.VirtualNetworkSpec{
+					VirtualNetworkPropertiesFormat: v1beta1.VirtualNetworkPropertiesFormat{
+						AddressSpace: v1beta1.AddressSpace{
 							AddressPrefixes: []string{"10.3.0.0/16"},
 						},
 						EnableDDOSProtection: enableDDOSProtection,
@@ -153,10 +153,10 @@ func TestVirtualNetworkNeedsUpdate(t *testing.T) {
 		},
 		{
 			name: "NeedsUpdateDdosProtection",
-			kube: &v1alpha3.VirtualNetwork{
-				Spec: v1alpha3.VirtualNetworkSpec{
-					VirtualNetworkPropertiesFormat: v1alpha3.VirtualNetworkPropertiesFormat{
-						AddressSpace: v1alpha3.AddressSpace{
+			kube: &v1beta1.VirtualNetwork{
+				Spec: v1beta1.VirtualNetworkSpec{
+					VirtualNetworkPropertiesFormat: v1beta1.VirtualNetworkPropertiesFormat{
+						AddressSpace: v1beta1.AddressSpace{
 							AddressPrefixes: addressPrefixes,
 						},
 						EnableDDOSProtection: !enableDDOSProtection,
@@ -179,10 +179,10 @@ func TestVirtualNetworkNeedsUpdate(t *testing.T) {
 		},
 		{
 			name: "NeedsUpdateVMProtection",
-			kube: &v1alpha3.VirtualNetwork{
-				Spec: v1alpha3.VirtualNetworkSpec{
-					VirtualNetworkPropertiesFormat: v1alpha3.VirtualNetworkPropertiesFormat{
-						AddressSpace: v1alpha3.AddressSpace{
+			kube: &v1beta1.VirtualNetwork{
+				Spec: v1beta1.VirtualNetworkSpec{
+					VirtualNetworkPropertiesFormat: v1beta1.VirtualNetworkPropertiesFormat{
+						AddressSpace: v1beta1.AddressSpace{
 							AddressPrefixes: addressPrefixes,
 						},
 						EnableDDOSProtection: enableDDOSProtection,
@@ -205,10 +205,10 @@ func TestVirtualNetworkNeedsUpdate(t *testing.T) {
 		},
 		{
 			name: "NeedsUpdateTags",
-			kube: &v1alpha3.VirtualNetwork{
-				Spec: v1alpha3.VirtualNetworkSpec{
-					VirtualNetworkPropertiesFormat: v1alpha3.VirtualNetworkPropertiesFormat{
-						AddressSpace: v1alpha3.AddressSpace{
+			kube: &v1beta1.VirtualNetwork{
+				Spec: v1beta1.VirtualNetworkSpec{
+					VirtualNetworkPropertiesFormat: v1beta1.VirtualNetworkPropertiesFormat{
+						AddressSpace: v1beta1.AddressSpace{
 							AddressPrefixes: addressPrefixes,
 						},
 						EnableDDOSProtection: enableDDOSProtection,
@@ -231,10 +231,10 @@ func TestVirtualNetworkNeedsUpdate(t *testing.T) {
 		},
 		{
 			name: "NoUpdate",
-			kube: &v1alpha3.VirtualNetwork{
-				Spec: v1alpha3.VirtualNetworkSpec{
-					VirtualNetworkPropertiesFormat: v1alpha3.VirtualNetworkPropertiesFormat{
-						AddressSpace: v1alpha3.AddressSpace{
+			kube: &v1beta1.VirtualNetwork{
+				Spec: v1beta1.VirtualNetworkSpec{
+					VirtualNetworkPropertiesFormat: v1beta1.VirtualNetworkPropertiesFormat{
+						AddressSpace: v1beta1.AddressSpace{
 							AddressPrefixes: addressPrefixes,
 						},
 						EnableDDOSProtection: enableDDOSProtection,
@@ -278,7 +278,7 @@ func TestUpdateVirtualNetworkStatusFromAzure(t *testing.T) {
 	cases := []struct {
 		name string
 		r    networkmgmt.VirtualNetwork
-		want v1alpha3.VirtualNetworkStatus
+		want v1beta1.VirtualNetworkStatus
 	}{
 		{
 			name: "SuccessfulFull",
@@ -298,7 +298,7 @@ func TestUpdateVirtualNetworkStatusFromAzure(t *testing.T) {
 					ResourceGUID:      azure.ToStringPtr(string(uid)),
 				},
 			},
-			want: v1alpha3.VirtualNetworkStatus{
+			want: v1beta1.VirtualNetworkStatus{
 				State:        string(networkmgmt.Succeeded),
 				ID:           id,
 				Etag:         etag,
@@ -322,7 +322,7 @@ func TestUpdateVirtualNetworkStatusFromAzure(t *testing.T) {
 					ResourceGUID:      azure.ToStringPtr(string(uid)),
 				},
 			},
-			want: v1alpha3.VirtualNetworkStatus{
+			want: v1beta1.VirtualNetworkStatus{
 				State:        string(networkmgmt.Succeeded),
 				ResourceGUID: string(uid),
 				Type:         resourceType,
@@ -333,8 +333,8 @@ func TestUpdateVirtualNetworkStatusFromAzure(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 
-			v := &v1alpha3.VirtualNetwork{
-				Status: v1alpha3.VirtualNetworkStatus{
+			v := &v1beta1.VirtualNetwork{
+				Status: v1beta1.VirtualNetworkStatus{
 					ResourceStatus: resourceStatus,
 				},
 			}
@@ -358,15 +358,15 @@ func TestUpdateVirtualNetworkStatusFromAzure(t *testing.T) {
 func TestNewSubnetParameters(t *testing.T) {
 	cases := []struct {
 		name string
-		r    *v1alpha3.Subnet
+		r    *v1beta1.Subnet
 		want networkmgmt.Subnet
 	}{
 		{
 			name: "Successful",
-			r: &v1alpha3.Subnet{
+			r: &v1beta1.Subnet{
 				ObjectMeta: metav1.ObjectMeta{UID: uid},
-				Spec: v1alpha3.SubnetSpec{
-					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+				Spec: v1beta1.SubnetSpec{
+					SubnetPropertiesFormat: v1beta1.SubnetPropertiesFormat{
 						AddressPrefix: addressPrefix,
 					},
 				},
@@ -393,17 +393,17 @@ func TestNewSubnetParameters(t *testing.T) {
 func TestNewServiceEndpoints(t *testing.T) {
 	cases := []struct {
 		name string
-		r    []v1alpha3.ServiceEndpointPropertiesFormat
+		r    []v1beta1.ServiceEndpointPropertiesFormat
 		want *[]networkmgmt.ServiceEndpointPropertiesFormat
 	}{
 		{
 			name: "SuccessfulNotSet",
-			r:    []v1alpha3.ServiceEndpointPropertiesFormat{},
+			r:    []v1beta1.ServiceEndpointPropertiesFormat{},
 			want: &[]networkmgmt.ServiceEndpointPropertiesFormat{},
 		},
 		{
 			name: "SuccessfulSet",
-			r: []v1alpha3.ServiceEndpointPropertiesFormat{
+			r: []v1beta1.ServiceEndpointPropertiesFormat{
 				{Service: serviceEndpoint},
 			},
 			want: &[]networkmgmt.ServiceEndpointPropertiesFormat{
@@ -425,15 +425,15 @@ func TestNewServiceEndpoints(t *testing.T) {
 func TestSubnetNeedsUpdate(t *testing.T) {
 	cases := []struct {
 		name string
-		kube *v1alpha3.Subnet
+		kube *v1beta1.Subnet
 		az   networkmgmt.Subnet
 		want bool
 	}{
 		{
 			name: "NeedsUpdate",
-			kube: &v1alpha3.Subnet{
-				Spec: v1alpha3.SubnetSpec{
-					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+			kube: &v1beta1.Subnet{
+				Spec: v1beta1.SubnetSpec{
+					SubnetPropertiesFormat: v1beta1.SubnetPropertiesFormat{
 						AddressPrefix: "10.1.0.0/16",
 					},
 				},
@@ -447,9 +447,9 @@ func TestSubnetNeedsUpdate(t *testing.T) {
 		},
 		{
 			name: "NoUpdate",
-			kube: &v1alpha3.Subnet{
-				Spec: v1alpha3.SubnetSpec{
-					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+			kube: &v1beta1.Subnet{
+				Spec: v1beta1.SubnetSpec{
+					SubnetPropertiesFormat: v1beta1.SubnetPropertiesFormat{
 						AddressPrefix: addressPrefix,
 					},
 				},
@@ -484,7 +484,7 @@ func TestUpdateSubnetStatusFromAzure(t *testing.T) {
 	cases := []struct {
 		name string
 		r    networkmgmt.Subnet
-		want v1alpha3.SubnetStatus
+		want v1beta1.SubnetStatus
 	}{
 		{
 			name: "SuccessfulFull",
@@ -496,7 +496,7 @@ func TestUpdateSubnetStatusFromAzure(t *testing.T) {
 					ProvisioningState: azure.ToStringPtr("Succeeded"),
 				},
 			},
-			want: v1alpha3.SubnetStatus{
+			want: v1beta1.SubnetStatus{
 				State:   string(networkmgmt.Succeeded),
 				ID:      id,
 				Etag:    etag,
@@ -511,7 +511,7 @@ func TestUpdateSubnetStatusFromAzure(t *testing.T) {
 					ProvisioningState: azure.ToStringPtr("Succeeded"),
 				},
 			},
-			want: v1alpha3.SubnetStatus{
+			want: v1beta1.SubnetStatus{
 				State: string(networkmgmt.Succeeded),
 				ID:    id,
 			},
@@ -521,8 +521,8 @@ func TestUpdateSubnetStatusFromAzure(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 
-			v := &v1alpha3.Subnet{
-				Status: v1alpha3.SubnetStatus{
+			v := &v1beta1.Subnet{
+				Status: v1beta1.SubnetStatus{
 					ResourceStatus: resourceStatus,
 				},
 			}
@@ -17,48 +17,175 @@ limitations under the License.
 package controller
 
 import (
-	"k8s.io/client-go/util/workqueue"
-	ctrl "sigs.k8s.io/controller-runtime"
+	"strings"
 
-	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
 
+	cachev1beta1 "github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	computev1alpha3 "github.com/crossplane/provider-azure/apis/compute/v1alpha3"
+	databasev1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	databasev1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	managedidentityv1alpha3 "github.com/crossplane/provider-azure/apis/managedidentity/v1alpha3"
+	networkv1beta1 "github.com/crossplane/provider-azure/apis/network/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+	azurev1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
 	"github.com/crossplane/provider-azure/pkg/controller/cache"
 	"github.com/crossplane/provider-azure/pkg/controller/compute"
 	"github.com/crossplane/provider-azure/pkg/controller/config"
 	"github.com/crossplane/provider-azure/pkg/controller/database/cosmosdb"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mariadbserver"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mariadbserverfirewallrule"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mariadbservervirtualnetworkrule"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mssqldatabase"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mssqlelasticpool"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mssqlserver"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mssqlserverfirewallrule"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mssqlservervirtualnetworkrule"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mysqldatabase"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mysqlflexibleserver"
 	"github.com/crossplane/provider-azure/pkg/controller/database/mysqlserver"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mysqlserverconfiguration"
 	"github.com/crossplane/provider-azure/pkg/controller/database/mysqlserverfirewallrule"
 	"github.com/crossplane/provider-azure/pkg/controller/database/mysqlservervirtualnetworkrule"
+	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqldatabase"
+	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlflexibleserver"
+	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlflexibleserverconfiguration"
+	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlflexibleserverfirewallrule"
 	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlserver"
+	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlserverconfiguration"
 	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlserverfirewallrule"
 	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlservervirtualnetworkrule"
+	"github.com/crossplane/provider-azure/pkg/controller/managedidentity/userassignedidentity"
 	"github.com/crossplane/provider-azure/pkg/controller/network/subnet"
 	"github.com/crossplane/provider-azure/pkg/controller/network/virtualnetwork"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
 	"github.com/crossplane/provider-azure/pkg/controller/resourcegroup"
 	"github.com/crossplane/provider-azure/pkg/controller/storage/account"
 	"github.com/crossplane/provider-azure/pkg/controller/storage/container"
 )
 
-// Setup Azure controllers.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter) error{
-		config.Setup,
-		cache.SetupRedis,
-		compute.SetupAKSCluster,
-		mysqlserver.Setup,
-		mysqlserverfirewallrule.Setup,
-		mysqlservervirtualnetworkrule.Setup,
-		postgresqlserver.Setup,
-		postgresqlserverfirewallrule.Setup,
-		postgresqlservervirtualnetworkrule.Setup,
-		cosmosdb.Setup,
-		virtualnetwork.Setup,
-		subnet.Setup,
-		resourcegroup.Setup,
-		account.Setup,
-		container.Setup,
-	} {
-		if err := setup(mgr, l, rl); err != nil {
+const errInvalidFilter = "invalid controller filter"
+
+// A Filter selects which managed resource controllers are set up. Groups may
+// be full API group names, e.g. cache.azure.crossplane.io, or their first
+// label, e.g. cache. Kinds may be kinds, e.g. Redis, or kinds qualified by
+// their group, e.g. Redis.cache.azure.crossplane.io. All controllers are
+// selected if the filter is empty.
+type Filter struct {
+	Groups []string
+	Kinds  []string
+}
+
+// Enabled returns true if the filter selects the supplied group kind, in the
+// form Kind.group.
+func (f Filter) Enabled(groupKind string) bool {
+	if len(f.Groups) == 0 && len(f.Kinds) == 0 {
+		return true
+	}
+	gk := schema.ParseGroupKind(groupKind)
+	for _, g := range f.Groups {
+		if strings.EqualFold(g, gk.Group) || strings.EqualFold(g, strings.SplitN(gk.Group, ".", 2)[0]) {
+			return true
+		}
+	}
+	for _, k := range f.Kinds {
+		if strings.EqualFold(k, gk.Kind) || strings.EqualFold(k, groupKind) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if any of the filter's groups or kinds does not
+// select any of the supplied group kinds, for example because it is misspelt.
+func (f Filter) Validate(groupKinds []string) error {
+	for _, g := range f.Groups {
+		if !(Filter{Groups: []string{g}}).enablesAny(groupKinds) {
+			return errors.Errorf("unknown API group %q", g)
+		}
+	}
+	for _, k := range f.Kinds {
+		if !(Filter{Kinds: []string{k}}).enablesAny(groupKinds) {
+			return errors.Errorf("unknown kind %q", k)
+		}
+	}
+	return nil
+}
+
+func (f Filter) enablesAny(groupKinds []string) bool {
+	for _, gk := range groupKinds {
+		if f.Enabled(gk) {
+			return true
+		}
+	}
+	return false
+}
+
+type setupFn func(ctrl.Manager, options.Options) error
+
+// Setup Azure controllers. The ProviderConfig controller is always set up;
+// managed resource controllers are set up only if the supplied filter
+// selects their kind, so that disabled kinds register no watches. Managed
+// resource controllers only reconcile resources passed by the predicate of the
+// supplied options. Setup fails before setting up any controller if the filter
+// selects a group or kind that no controller reconciles.
+func Setup(mgr ctrl.Manager, o options.Options, f Filter) error {
+	controllers := []struct {
+		groupKind string
+		setup     setupFn
+	}{
+		{cachev1beta1.RedisGroupKind, cache.SetupRedis},
+		{computev1alpha3.AKSClusterGroupKind, compute.SetupAKSCluster},
+		{databasev1beta1.MySQLServerGroupKind, mysqlserver.Setup},
+		{databasev1alpha3.MySQLServerConfigurationGroupKind, mysqlserverconfiguration.Setup},
+		{databasev1alpha3.MySQLServerFirewallRuleGroupKind, mysqlserverfirewallrule.Setup},
+		{databasev1alpha3.MySQLServerVirtualNetworkRuleGroupKind, mysqlservervirtualnetworkrule.Setup},
+		{databasev1alpha3.MySQLDatabaseGroupKind, mysqldatabase.Setup},
+		{databasev1alpha3.MySQLFlexibleServerGroupKind, mysqlflexibleserver.Setup},
+		{databasev1beta1.PostgreSQLServerGroupKind, postgresqlserver.Setup},
+		{databasev1alpha3.PostgreSQLServerConfigurationGroupKind, postgresqlserverconfiguration.Setup},
+		{databasev1alpha3.PostgreSQLServerFirewallRuleGroupKind, postgresqlserverfirewallrule.Setup},
+		{databasev1alpha3.PostgreSQLServerVirtualNetworkRuleGroupKind, postgresqlservervirtualnetworkrule.Setup},
+		{databasev1alpha3.PostgreSQLDatabaseGroupKind, postgresqldatabase.Setup},
+		{databasev1alpha3.PostgreSQLFlexibleServerGroupKind, postgresqlflexibleserver.Setup},
+		{databasev1alpha3.PostgreSQLFlexibleServerConfigurationGroupKind, postgresqlflexibleserverconfiguration.Setup},
+		{databasev1alpha3.PostgreSQLFlexibleServerFirewallRuleGroupKind, postgresqlflexibleserverfirewallrule.Setup},
+		{databasev1beta1.MariaDBServerGroupKind, mariadbserver.Setup},
+		{databasev1alpha3.MariaDBServerFirewallRuleGroupKind, mariadbserverfirewallrule.Setup},
+		{databasev1alpha3.MariaDBServerVirtualNetworkRuleGroupKind, mariadbservervirtualnetworkrule.Setup},
+		{databasev1alpha3.MSSQLServerGroupKind, mssqlserver.Setup},
+		{databasev1alpha3.MSSQLServerFirewallRuleGroupKind, mssqlserverfirewallrule.Setup},
+		{databasev1alpha3.MSSQLServerVirtualNetworkRuleGroupKind, mssqlservervirtualnetworkrule.Setup},
+		{databasev1alpha3.MSSQLDatabaseGroupKind, mssqldatabase.Setup},
+		{databasev1alpha3.MSSQLElasticPoolGroupKind, mssqlelasticpool.Setup},
+		{databasev1alpha3.CosmosDBAccountGroupKind, cosmosdb.Setup},
+		{managedidentityv1alpha3.UserAssignedIdentityGroupKind, userassignedidentity.Setup},
+		{networkv1beta1.VirtualNetworkGroupKind, virtualnetwork.Setup},
+		{networkv1beta1.SubnetGroupKind, subnet.Setup},
+		{azurev1alpha3.ResourceGroupGroupKind, resourcegroup.Setup},
+		{storagev1alpha3.AccountGroupKind, account.Setup},
+		{storagev1alpha3.ContainerGroupKind, container.Setup},
+	}
+
+	groupKinds := make([]string, len(controllers))
+	for i, c := range controllers {
+		groupKinds[i] = c.groupKind
+	}
+	if err := f.Validate(groupKinds); err != nil {
+		return errors.Wrap(err, errInvalidFilter)
+	}
+
+	if err := config.Setup(mgr, o); err != nil {
+		return err
+	}
+	for _, c := range controllers {
+		if !f.Enabled(c.groupKind) {
+			o.Logger.Debug("Skipping disabled controller", "kind", c.groupKind)
+			continue
+		}
+		if err := c.setup(mgr, o); err != nil {
 			return err
 		}
 	}
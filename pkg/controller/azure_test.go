@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	cachev1beta1 "github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	computev1alpha3 "github.com/crossplane/provider-azure/apis/compute/v1alpha3"
+)
+
+func TestFilterEnabled(t *testing.T) {
+	cases := map[string]struct {
+		f         Filter
+		groupKind string
+		want      bool
+	}{
+		"EmptyFilter": {
+			groupKind: cachev1beta1.RedisGroupKind,
+			want:      true,
+		},
+		"ShortGroup": {
+			f:         Filter{Groups: []string{"cache"}},
+			groupKind: cachev1beta1.RedisGroupKind,
+			want:      true,
+		},
+		"FullGroup": {
+			f:         Filter{Groups: []string{"cache.azure.crossplane.io"}},
+			groupKind: cachev1beta1.RedisGroupKind,
+			want:      true,
+		},
+		"OtherGroup": {
+			f:         Filter{Groups: []string{"cache"}},
+			groupKind: computev1alpha3.AKSClusterGroupKind,
+			want:      false,
+		},
+		"Kind": {
+			f:         Filter{Kinds: []string{"akscluster"}},
+			groupKind: computev1alpha3.AKSClusterGroupKind,
+			want:      true,
+		},
+		"QualifiedKind": {
+			f:         Filter{Kinds: []string{"AKSCluster.compute.azure.crossplane.io"}},
+			groupKind: computev1alpha3.AKSClusterGroupKind,
+			want:      true,
+		},
+		"OtherKind": {
+			f:         Filter{Kinds: []string{"Redis"}},
+			groupKind: computev1alpha3.AKSClusterGroupKind,
+			want:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.f.Enabled(tc.groupKind)
+			if got != tc.want {
+				t.Errorf("Enabled(%q): want %t, got %t", tc.groupKind, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	groupKinds := []string{cachev1beta1.RedisGroupKind, computev1alpha3.AKSClusterGroupKind}
+
+	cases := map[string]struct {
+		f    Filter
+		want error
+	}{
+		"EmptyFilter": {},
+		"KnownGroupsAndKinds": {
+			f: Filter{Groups: []string{"cache"}, Kinds: []string{"AKSCluster.compute.azure.crossplane.io"}},
+		},
+		"UnknownGroup": {
+			f:    Filter{Groups: []string{"cahce"}},
+			want: errors.New(`unknown API group "cahce"`),
+		},
+		"UnknownKind": {
+			f:    Filter{Kinds: []string{"Reddis"}},
+			want: errors.New(`unknown kind "Reddis"`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.f.Validate(groupKinds)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("Validate(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
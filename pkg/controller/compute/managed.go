@@ -18,54 +18,73 @@ package compute
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/client-go/util/workqueue"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/yaml"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
-	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/password"
-	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-azure/apis/compute/v1alpha3"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 	"github.com/crossplane/provider-azure/pkg/clients/compute"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
 )
 
+// defaultExecAPIVersion is the ExecCredential API version we expect exec
+// credential plugins to return unless told otherwise.
+const defaultExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+
 // Error strings.
 const (
-	errGenPassword      = "cannot generate service principal secret"
-	errNotAKSCluster    = "managed resource is not a AKSCluster"
-	errCreateAKSCluster = "cannot create AKSCluster"
-	errGetAKSCluster    = "cannot get AKSCluster"
-	errGetKubeConfig    = "cannot get AKSCluster kubeconfig"
-	errDeleteAKSCluster = "cannot delete AKSCluster"
+	errGenPassword          = "cannot generate service principal secret"
+	errNotAKSCluster        = "managed resource is not a AKSCluster"
+	errCreateAKSCluster     = "cannot create AKSCluster"
+	errGetAKSCluster        = "cannot get AKSCluster"
+	errGetKubeConfig        = "cannot get AKSCluster kubeconfig"
+	errUpdateAKSCluster     = "cannot update AKSCluster"
+	errUpdateCR             = "cannot update AKSCluster custom resource"
+	errGetMaintenanceWindow = "cannot get AKSCluster maintenance window"
+	errStartAKSCluster      = "cannot start AKSCluster"
+	errStopAKSCluster       = "cannot stop AKSCluster"
+	errDeleteAKSCluster     = "cannot delete AKSCluster"
+	errRotateSecret         = "cannot rotate AKSCluster service principal secret"
+	errRemoveSecrets        = "cannot remove stale AKSCluster service principal secrets"
+
+	errFetchLastOperation = "cannot fetch last operation"
 )
 
 // SetupAKSCluster adds a controller that reconciles AKSClusters.
-func SetupAKSCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func SetupAKSCluster(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1alpha3.AKSClusterGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
-		}).
+		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha3.AKSCluster{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.AKSClusterList{})).
+		WithEventFilter(o.Predicate).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha3.AKSClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
@@ -97,7 +116,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotAKSCluster)
 	}
 
-	c, err := e.client.GetManagedCluster(ctx, cr)
+	c, eo, err := e.client.ObserveManagedCluster(ctx, cr)
 	if azure.IsNotFound(err) {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
@@ -105,31 +124,70 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSCluster)
 	}
 
-	cr.Status.ProviderID = to.String(c.ID)
-	cr.Status.State = to.String(c.ProvisioningState)
-	cr.Status.Endpoint = to.String(c.Fqdn)
+	current := cr.Spec.AKSClusterParameters.DeepCopy()
+	compute.LateInitialize(&cr.Spec.AKSClusterParameters, c)
+	if !reflect.DeepEqual(current, &cr.Spec.AKSClusterParameters) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+	compute.UpdateObservation(&cr.Status, c)
+	cr.Status.PowerState = eo.PowerState
+	cr.Status.OIDCIssuerURL = eo.OIDCIssuerURL
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.ServicePrincipalSecret.LastRotation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	compute.UpdateServicePrincipalSecretObservation(cr, time.Now())
 
-	if cr.Status.State != "Succeeded" {
-		// AKS clusters are always up to date because we can't yet update them.
+	if cr.Status.State != "Succeeded" || isOperationInProgress(cr) {
+		// We don't try to update clusters that are still being created,
+		// updated, started, stopped, or having their secret rotated.
 		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
 	}
 
+	if cr.Status.PowerState == v1alpha3.PowerStateStopped {
+		// A stopped cluster has no API server, so there is no kubeconfig to
+		// get. We can't update a stopped cluster except to start it.
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: isPowerStateUpToDate(cr) && !compute.HasStaleServicePrincipalSecrets(cr),
+		}, nil
+	}
+
 	kubeconfig, err := e.client.GetKubeConfig(ctx, cr)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetKubeConfig)
 	}
 
-	cd, err := connectionDetails(kubeconfig, meta.GetExternalName(cr))
+	cd, err := connectionDetails(kubeconfig, meta.GetExternalName(cr), cr.Spec.KubeconfigExecPlugin)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSCluster)
 	}
+	if compute.IsPrivateCluster(c) && c.PrivateFQDN != nil {
+		cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(fmt.Sprintf("https://%s:443", to.String(c.PrivateFQDN)))
+	}
+
+	us := eo.UpgradeSettings
+	if us.MaintenanceWindow, err = e.client.GetMaintenanceWindow(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMaintenanceWindow)
+	}
 
 	cr.SetConditions(xpv1.Available())
 
-	// AKS clusters are always up to date because we can't yet update them.
+	// Version changes are only considered drift during the cluster's
+	// maintenance window.
 	o := managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  true,
+		ResourceExists: true,
+		ResourceUpToDate: isPowerStateUpToDate(cr) &&
+			compute.IsManagedClusterUpToDate(cr, c) &&
+			!compute.IsUpgradeDue(cr, c, time.Now()) &&
+			compute.IsUpgradeSettingsUpToDate(cr, us) &&
+			!compute.IsServicePrincipalSecretRotationDue(cr, time.Now()) &&
+			!compute.HasStaleServicePrincipalSecrets(cr),
 		ConnectionDetails: cd,
 	}
 	return o, nil
@@ -149,8 +207,55 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// TODO(negz): Support updates.
-	return managed.ExternalUpdate{}, nil
+	cr, ok := mg.(*v1alpha3.AKSCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAKSCluster)
+	}
+	if isOperationInProgress(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// Secrets the cluster no longer uses are removed regardless of its power
+	// state.
+	if compute.HasStaleServicePrincipalSecrets(cr) {
+		return managed.ExternalUpdate{}, errors.Wrap(e.client.RemoveStaleServicePrincipalSecrets(ctx, cr), errRemoveSecrets)
+	}
+
+	switch {
+	case isPowerStateUpToDate(cr):
+		// We only update the cluster's configuration once it's in the
+		// desired power state.
+	case cr.Spec.PowerState == v1alpha3.PowerStateRunning:
+		return managed.ExternalUpdate{}, errors.Wrap(e.client.StartManagedCluster(ctx, cr), errStartAKSCluster)
+	case cr.Spec.PowerState == v1alpha3.PowerStateStopped:
+		return managed.ExternalUpdate{}, errors.Wrap(e.client.StopManagedCluster(ctx, cr), errStopAKSCluster)
+	}
+
+	if cr.Status.PowerState == v1alpha3.PowerStateStopped {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if compute.IsServicePrincipalSecretRotationDue(cr, time.Now()) {
+		secret, err := e.newPasswordFn()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenPassword)
+		}
+		return managed.ExternalUpdate{}, errors.Wrap(e.client.RotateServicePrincipalSecret(ctx, cr, secret), errRotateSecret)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.client.UpdateManagedCluster(ctx, cr), errUpdateAKSCluster)
+}
+
+// isOperationInProgress returns true if any operation the controller started
+// on the supplied AKS cluster is still in progress.
+func isOperationInProgress(cr *v1alpha3.AKSCluster) bool {
+	return cr.Status.LastOperation.Status == azure.AsyncOperationStatusInProgress ||
+		cr.Status.ServicePrincipalSecret.LastRotation.Status == azure.AsyncOperationStatusInProgress
+}
+
+// isPowerStateUpToDate returns true if the supplied AKS cluster is in its
+// desired power state, or has no desired power state.
+func isPowerStateUpToDate(cr *v1alpha3.AKSCluster) bool {
+	return cr.Spec.PowerState == "" || cr.Spec.PowerState == cr.Status.PowerState
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -162,7 +267,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return errors.Wrap(e.client.DeleteManagedCluster(ctx, cr), errDeleteAKSCluster)
 }
 
-func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetails, error) {
+func connectionDetails(kubeconfig []byte, name string, exec *v1alpha3.ExecPlugin) (managed.ConnectionDetails, error) {
 	kcfg, err := clientcmd.Load(kubeconfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse kubeconfig file")
@@ -181,6 +286,14 @@ func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetail
 		return nil, errors.Errorf("auth-info configuration is not found: %s", kctx.AuthInfo)
 	}
 
+	if exec != nil {
+		auth = &clientcmdapi.AuthInfo{Exec: newExecConfig(exec)}
+		kcfg.AuthInfos[kctx.AuthInfo] = auth
+		if kubeconfig, err = writeKubeconfig(kcfg); err != nil {
+			return nil, errors.Wrap(err, "cannot write kubeconfig file")
+		}
+	}
+
 	return managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(cluster.Server),
 		xpv1.ResourceCredentialsSecretCAKey:         cluster.CertificateAuthorityData,
@@ -189,3 +302,34 @@ func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetail
 		xpv1.ResourceCredentialsSecretKubeconfigKey: kubeconfig,
 	}, nil
 }
+
+// writeKubeconfig serializes the supplied kubeconfig using its v1 form.
+func writeKubeconfig(kcfg *clientcmdapi.Config) ([]byte, error) {
+	out := &clientcmdapiv1.Config{}
+	if err := clientcmdapiv1.Convert_api_Config_To_v1_Config(kcfg, out, nil); err != nil {
+		return nil, err
+	}
+	out.APIVersion = clientcmdapiv1.SchemeGroupVersion.Version
+	out.Kind = "Config"
+	return yaml.Marshal(out)
+}
+
+func newExecConfig(p *v1alpha3.ExecPlugin) *clientcmdapi.ExecConfig {
+	ec := &clientcmdapi.ExecConfig{
+		APIVersion: p.APIVersion,
+		Command:    p.Command,
+		Args:       p.Args,
+	}
+	if ec.APIVersion == "" {
+		ec.APIVersion = defaultExecAPIVersion
+	}
+	names := make([]string, 0, len(p.Env))
+	for n := range p.Env {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		ec.Env = append(ec.Env, clientcmdapi.ExecEnvVar{Name: n, Value: p.Env[n]})
+	}
+	return ec
+}
@@ -20,23 +20,38 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-03-01/containerservice"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/compute"
 	"github.com/crossplane/provider-azure/pkg/clients/compute/fake"
 )
 
 type modifier func(*v1alpha3.AKSCluster)
 
+func withExternalName(name string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		meta.SetExternalName(c, name)
+	}
+}
+
 func withState(state string) modifier {
 	return func(c *v1alpha3.AKSCluster) {
 		c.Status.State = state
@@ -55,6 +70,38 @@ func withEndpoint(ep string) modifier {
 	}
 }
 
+func withFQDN(fqdn, private string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.FQDN = fqdn
+		c.Status.PrivateFQDN = private
+	}
+}
+
+func withOIDCIssuerURL(url string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.OIDCIssuerURL = url
+	}
+}
+
+func withPowerState(spec, status string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.PowerState = spec
+		c.Status.PowerState = status
+	}
+}
+
+func withDNSNamePrefix(p string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.DNSNamePrefix = p
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(cr *v1alpha3.AKSCluster) {
+		cr.Status.SetConditions(c...)
+	}
+}
+
 func aksCluster(m ...modifier) *v1alpha3.AKSCluster {
 	ac := &v1alpha3.AKSCluster{}
 
@@ -71,6 +118,26 @@ func TestObserve(t *testing.T) {
 	stateSucceeded := "Succeeded"
 	stateWat := "Wat"
 	endpoint := "http://wat.example.org"
+	privateEndpoint := "wat.private.example.org"
+	dnsPrefix := "wat"
+	oidcIssuerURL := "https://oidc.example.org/wat/"
+	name := "cool-cluster"
+	kubeconfig := []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: cool-cluster
+  cluster:
+    server: https://cool-cluster.example.org:443
+contexts:
+- name: cool-cluster
+  context:
+    cluster: cool-cluster
+    user: clusterUser_rg_cool-cluster
+users:
+- name: clusterUser_rg_cool-cluster
+  user:
+    token: sometoken
+`)
 
 	type args struct {
 		ctx context.Context
@@ -99,8 +166,8 @@ func TestObserve(t *testing.T) {
 		"ErrClusterNotFound": {
 			e: &external{
 				client: fake.AKSClient{
-					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
-						return containerservice.ManagedCluster{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
+						return containerservice.ManagedCluster{}, compute.ExtendedObservation{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
 				},
 			},
@@ -116,8 +183,8 @@ func TestObserve(t *testing.T) {
 		"ErrGetCluster": {
 			e: &external{
 				client: fake.AKSClient{
-					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
-						return containerservice.ManagedCluster{}, errBoom
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
+						return containerservice.ManagedCluster{}, compute.ExtendedObservation{}, errBoom
 					},
 				},
 			},
@@ -133,14 +200,15 @@ func TestObserve(t *testing.T) {
 		"NotReady": {
 			e: &external{
 				client: fake.AKSClient{
-					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+					MockGetRESTClient: func() autorest.Sender { return nil },
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
 						return containerservice.ManagedCluster{
 							ID: to.StringPtr(id),
 							ManagedClusterProperties: &containerservice.ManagedClusterProperties{
 								ProvisioningState: to.StringPtr(stateWat),
 								Fqdn:              to.StringPtr(endpoint),
 							},
-						}, nil
+						}, compute.ExtendedObservation{PowerState: v1alpha3.PowerStateRunning, OIDCIssuerURL: oidcIssuerURL}, nil
 					},
 				},
 			},
@@ -154,16 +222,132 @@ func TestObserve(t *testing.T) {
 					withProviderID(id),
 					withState(stateWat),
 					withEndpoint(endpoint),
+					withFQDN(endpoint, ""),
+					withPowerState("", v1alpha3.PowerStateRunning),
+					withOIDCIssuerURL(oidcIssuerURL),
+				),
+			},
+		},
+		"NotReadyPrivateCluster": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetRESTClient: func() autorest.Sender { return nil },
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
+						return containerservice.ManagedCluster{
+							ID: to.StringPtr(id),
+							ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+								ProvisioningState: to.StringPtr(stateWat),
+								Fqdn:              to.StringPtr(endpoint),
+								PrivateFQDN:       to.StringPtr(privateEndpoint),
+								APIServerAccessProfile: &containerservice.ManagedClusterAPIServerAccessProfile{
+									EnablePrivateCluster: to.BoolPtr(true),
+								},
+							},
+						}, compute.ExtendedObservation{PowerState: v1alpha3.PowerStateRunning}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: aksCluster(
+					withProviderID(id),
+					withState(stateWat),
+					withEndpoint(privateEndpoint),
+					withFQDN(endpoint, privateEndpoint),
+					withPowerState("", v1alpha3.PowerStateRunning),
+				),
+			},
+		},
+		"LateInitialize": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: fake.AKSClient{
+					MockGetRESTClient: func() autorest.Sender { return nil },
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
+						return containerservice.ManagedCluster{
+							ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+								ProvisioningState: to.StringPtr(stateWat),
+								DNSPrefix:         to.StringPtr(dnsPrefix),
+							},
+						}, compute.ExtendedObservation{PowerState: v1alpha3.PowerStateRunning}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: aksCluster(
+					withState(stateWat),
+					withDNSNamePrefix(dnsPrefix),
+					withPowerState("", v1alpha3.PowerStateRunning),
+				),
+			},
+		},
+		"ErrUpdateCR": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: fake.AKSClient{
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
+						return containerservice.ManagedCluster{
+							ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+								DNSPrefix: to.StringPtr(dnsPrefix),
+							},
+						}, compute.ExtendedObservation{}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				mg:  aksCluster(withDNSNamePrefix(dnsPrefix)),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"StoppedNeedsStart": {
+			e: &external{
+				client: fake.AKSClient{
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+						}}, compute.ExtendedObservation{PowerState: v1alpha3.PowerStateStopped}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender { return nil },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withPowerState(v1alpha3.PowerStateRunning, "")),
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				mg: aksCluster(
+					withState(stateSucceeded),
+					withPowerState(v1alpha3.PowerStateRunning, v1alpha3.PowerStateStopped),
+					withConditions(xpv1.Unavailable()),
 				),
 			},
 		},
 		"ErrGetKubeConfig": {
 			e: &external{
 				client: fake.AKSClient{
-					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+					MockGetRESTClient: func() autorest.Sender { return nil },
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
 						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
 							ProvisioningState: to.StringPtr(stateSucceeded),
-						}}, nil
+						}}, compute.ExtendedObservation{PowerState: v1alpha3.PowerStateRunning}, nil
 					},
 					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]byte, error) {
 						return nil, errBoom
@@ -177,10 +361,41 @@ func TestObserve(t *testing.T) {
 			want: want{
 				mg: aksCluster(
 					withState(stateSucceeded),
+					withPowerState("", v1alpha3.PowerStateRunning),
 				),
 				err: errors.Wrap(errBoom, errGetKubeConfig),
 			},
 		},
+		"ErrGetMaintenanceWindow": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetRESTClient: func() autorest.Sender { return nil },
+					MockObserveManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, compute.ExtendedObservation, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+						}}, compute.ExtendedObservation{PowerState: v1alpha3.PowerStateRunning}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]byte, error) {
+						return kubeconfig, nil
+					},
+					MockGetMaintenanceWindow: func(_ context.Context, _ *v1alpha3.AKSCluster) (*v1alpha3.MaintenanceWindow, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withExternalName(name)),
+			},
+			want: want{
+				mg: aksCluster(
+					withExternalName(name),
+					withState(stateSucceeded),
+					withPowerState("", v1alpha3.PowerStateRunning),
+				),
+				err: errors.Wrap(errBoom, errGetMaintenanceWindow),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -272,6 +487,164 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eu  managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAKSCluster": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotAKSCluster),
+			},
+		},
+		"OperationInProgress": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg: aksCluster(func(c *v1alpha3.AKSCluster) {
+					c.Status.LastOperation.Status = azure.AsyncOperationStatusInProgress
+				}),
+			},
+		},
+		"ErrStartCluster": {
+			e: &external{
+				client: fake.AKSClient{
+					MockStartManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withPowerState(v1alpha3.PowerStateRunning, v1alpha3.PowerStateStopped)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errStartAKSCluster),
+			},
+		},
+		"ErrStopCluster": {
+			e: &external{
+				client: fake.AKSClient{
+					MockStopManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withPowerState(v1alpha3.PowerStateStopped, v1alpha3.PowerStateRunning)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errStopAKSCluster),
+			},
+		},
+		"StoppedNotUpdated": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withPowerState(v1alpha3.PowerStateStopped, v1alpha3.PowerStateStopped)),
+			},
+		},
+		"ErrRemoveStaleSecrets": {
+			e: &external{
+				client: fake.AKSClient{
+					MockRemoveStaleServicePrincipalSecrets: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: aksCluster(func(c *v1alpha3.AKSCluster) {
+					c.Status.ServicePrincipalSecret.StaleKeyIDs = []string{"old"}
+					c.Status.ServicePrincipalSecret.LastRotation.Status = azure.AsyncOperationStatusSucceeded
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errRemoveSecrets),
+			},
+		},
+		"ErrRotateSecret": {
+			e: &external{
+				client: fake.AKSClient{
+					MockRotateServicePrincipalSecret: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) error {
+						return errBoom
+					},
+				},
+				newPasswordFn: func() (string, error) { return "secret", nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: aksCluster(func(c *v1alpha3.AKSCluster) {
+					past := metav1.NewTime(time.Now().Add(-time.Hour))
+					c.Status.ServicePrincipalSecret.NextRotationTime = &past
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errRotateSecret),
+			},
+		},
+		"ErrUpdateCluster": {
+			e: &external{
+				client: fake.AKSClient{
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateAKSCluster),
+			},
+		},
+		"Successful": {
+			e: &external{
+				client: fake.AKSClient{
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -319,3 +692,101 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectionDetails(t *testing.T) {
+	name := "cool-cluster"
+	server := "https://cool-cluster.example.org:443"
+	kubeconfig := []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: cool-cluster
+  cluster:
+    server: https://cool-cluster.example.org:443
+contexts:
+- name: cool-cluster
+  context:
+    cluster: cool-cluster
+    user: clusterUser_rg_cool-cluster
+users:
+- name: clusterUser_rg_cool-cluster
+  user:
+    token: sometoken
+`)
+	exec := &v1alpha3.ExecPlugin{
+		Command: "kubelogin",
+		Args:    []string{"get-token", "--login", "azurecli"},
+		Env:     map[string]string{"B": "b", "A": "a"},
+	}
+
+	type args struct {
+		kubeconfig []byte
+		name       string
+		exec       *v1alpha3.ExecPlugin
+	}
+	type want struct {
+		auth *clientcmdapi.AuthInfo
+		err  error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ErrContextNotFound": {
+			args: args{
+				kubeconfig: kubeconfig,
+				name:       "wat",
+			},
+			want: want{
+				err: errors.Errorf("context configuration is not found for cluster: %s", "wat"),
+			},
+		},
+		"Credentials": {
+			args: args{
+				kubeconfig: kubeconfig,
+				name:       name,
+			},
+			want: want{
+				auth: &clientcmdapi.AuthInfo{Token: "sometoken"},
+			},
+		},
+		"ExecPlugin": {
+			args: args{
+				kubeconfig: kubeconfig,
+				name:       name,
+				exec:       exec,
+			},
+			want: want{
+				auth: &clientcmdapi.AuthInfo{Exec: &clientcmdapi.ExecConfig{
+					APIVersion: defaultExecAPIVersion,
+					Command:    "kubelogin",
+					Args:       []string{"get-token", "--login", "azurecli"},
+					Env:        []clientcmdapi.ExecEnvVar{{Name: "A", Value: "a"}, {Name: "B", Value: "b"}},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cd, err := connectionDetails(tc.args.kubeconfig, tc.args.name, tc.args.exec)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("connectionDetails(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(server, string(cd[xpv1.ResourceCredentialsSecretEndpointKey])); diff != "" {
+				t.Errorf("connectionDetails(...): -want endpoint, +got endpoint:\n%s", diff)
+			}
+			kcfg, err := clientcmd.Load(cd[xpv1.ResourceCredentialsSecretKubeconfigKey])
+			if err != nil {
+				t.Fatalf("clientcmd.Load(...): %s", err)
+			}
+			got := kcfg.AuthInfos[kcfg.Contexts[tc.args.name].AuthInfo]
+			if diff := cmp.Diff(tc.want.auth, got, cmpopts.IgnoreFields(clientcmdapi.AuthInfo{}, "LocationOfOrigin", "Extensions")); diff != "" {
+				t.Errorf("connectionDetails(...): -want auth, +got auth:\n%s", diff)
+			}
+		})
+	}
+}
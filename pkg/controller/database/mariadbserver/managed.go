@@ -0,0 +1,336 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mariadbserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errUpdateCR            = "cannot update MariaDBServer custom resource"
+	errGenPassword         = "cannot generate admin password"
+	errNotMariaDBServer    = "managed resource is not a MariaDBServer"
+	errCreateMariaDBServer = "cannot create MariaDBServer"
+	errUpdateMariaDBServer = "cannot update MariaDBServer"
+	errGetMariaDBServer    = "cannot get MariaDBServer"
+	errDeleteMariaDBServer = "cannot delete MariaDBServer"
+	errFetchLastOperation  = "cannot fetch last operation"
+	errRotatePassword      = "cannot rotate MariaDBServer administrator login password"
+)
+
+// Setup adds a controller that reconciles MariaDBServers.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.MariaDBServerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.MariaDBServer{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1beta1.MariaDBServerList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.MariaDBServerGroupVersionKind),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := mariadb.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: database.NewMariaDBServerClient(cl), newPasswordFn: password.Generate}, nil
+}
+
+type external struct {
+	kube          client.Client
+	client        database.MariaDBServerAPI
+	newPasswordFn func() (password string, err error)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.MariaDBServer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMariaDBServer)
+	}
+
+	server, err := e.client.GetServer(ctx, cr)
+	if azure.IsNotFound(err) {
+		if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+		// Azure returns NotFound for GET calls until creation is completed
+		// successfully and we cannot return `ResourceExists: false` during creation
+		// since this will cause `Create` to be called again and it's not idempotent.
+		// So, we check whether a creation operation in fact is in motion.
+		creating := cr.Status.AtProvider.LastOperation.Method == "PUT" &&
+			cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress
+		return managed.ExternalObservation{ResourceExists: creating}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMariaDBServer)
+	}
+	database.LateInitializeMariaDB(&cr.Spec.ForProvider, server)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+	database.UpdateMariaDBObservation(&cr.Status.AtProvider, server)
+	// We make this call after kube.Update since it doesn't update the
+	// status subresource but fetches the the whole object after it's done. So,
+	// changes to status has to be done after kube.Update in order not to get them
+	// lost.
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	rotation := &cr.Status.AtProvider.AdministratorLoginPasswordRotation
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &rotation.LastRotation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	database.UpdatePasswordRotationObservation(cr.Spec.ForProvider, rotation, time.Now())
+	switch cr.Status.AtProvider.UserVisibleState {
+	case v1beta1.StateReady:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	pw, err := e.changedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	o := managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: database.IsMariaDBUpToDate(cr.Spec.ForProvider, server) &&
+			!isPasswordRotationDue(cr) &&
+			pw == "" &&
+			!database.IsPasswordRotationSucceeded(*rotation),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.FullyQualifiedDomainName),
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", cr.Spec.ForProvider.AdministratorLogin, meta.GetExternalName(cr))),
+		},
+	}
+
+	// Azure has applied the new password, so we publish it. It's no longer
+	// pending once it has been published.
+	if database.IsPasswordRotationSucceeded(*rotation) && cr.GetWriteConnectionSecretToReference() != nil {
+		pw, err := database.GetPendingPassword(ctx, e.kube, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if pw != "" {
+			o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+		}
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.MariaDBServer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMariaDBServer)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	pw, err := e.password(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := e.client.CreateServer(ctx, cr, pw); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMariaDBServer)
+	}
+	if cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef != nil {
+		h, err := database.HashPassword(pw)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		cr.Status.AtProvider.AdministratorLoginPasswordRotation.PasswordHash = h
+	}
+	now := metav1.Now()
+	cr.Status.AtProvider.AdministratorLoginPasswordRotation.LastRotationTime = &now
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.MariaDBServer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMariaDBServer)
+	}
+	if isOperationInProgress(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	rotation := &cr.Status.AtProvider.AdministratorLoginPasswordRotation
+	if database.IsPasswordRotationSucceeded(*rotation) {
+		// The new password was published when the server was observed.
+		if cr.GetWriteConnectionSecretToReference() != nil {
+			if err := database.DeletePendingPassword(ctx, e.kube, cr); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		now := metav1.Now()
+		rotation.LastRotationTime = &now
+		rotation.LastRotation = v1alpha3.AsyncOperation{}
+		database.UpdatePasswordRotationObservation(cr.Spec.ForProvider, rotation, now.Time)
+		return managed.ExternalUpdate{}, nil
+	}
+	pw, err := e.changedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	referenced := pw != ""
+	if pw == "" && isPasswordRotationDue(cr) {
+		if pw, err = e.newPasswordFn(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenPassword)
+		}
+	}
+	if pw != "" {
+		// We store the new password before sending it to Azure so that it
+		// isn't lost if we fail to record that we did.
+		if cr.GetWriteConnectionSecretToReference() != nil {
+			if err := database.StorePendingPassword(ctx, e.kube, cr, v1beta1.MariaDBServerGroupVersionKind, pw); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		if err := e.client.RotateAdministratorLoginPassword(ctx, cr, pw); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRotatePassword)
+		}
+		if referenced {
+			h, err := database.HashPassword(pw)
+			if err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+			rotation.PasswordHash = h
+		}
+		return managed.ExternalUpdate{}, errors.Wrap(
+			azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &rotation.LastRotation),
+			errFetchLastOperation)
+	}
+
+	if err := e.client.UpdateServer(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMariaDBServer)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+// isOperationInProgress returns true if any operation the controller started
+// on the supplied MariaDB server is still in progress.
+func isOperationInProgress(cr *v1beta1.MariaDBServer) bool {
+	return cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress ||
+		cr.Status.AtProvider.AdministratorLoginPasswordRotation.LastRotation.Status == azure.AsyncOperationStatusInProgress
+}
+
+// isPasswordRotationDue returns true if the supplied MariaDB server's
+// administrator login password should be rotated now. Passwords are only
+// rotated if they are not supplied by a secret reference, and if there is a
+// connection secret to publish them to.
+func isPasswordRotationDue(cr *v1beta1.MariaDBServer) bool {
+	return cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef == nil &&
+		cr.GetWriteConnectionSecretToReference() != nil &&
+		database.IsPasswordRotationDue(cr.Status.AtProvider.AdministratorLoginPasswordRotation, time.Now())
+}
+
+// password returns the administrator login password the supplied MariaDB server
+// should be created with; either the referenced password or a new one.
+func (e *external) password(ctx context.Context, cr *v1beta1.MariaDBServer) (string, error) {
+	if ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef; ref != nil {
+		return database.GetPassword(ctx, e.kube, *ref)
+	}
+	pw, err := e.newPasswordFn()
+	return pw, errors.Wrap(err, errGenPassword)
+}
+
+// changedPassword returns the administrator login password referenced by the
+// supplied MariaDB server if it is not the password that was last sent to
+// Azure, or if Azure failed to apply it. It returns an empty string otherwise.
+func (e *external) changedPassword(ctx context.Context, cr *v1beta1.MariaDBServer) (string, error) {
+	ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef
+	if ref == nil {
+		return "", nil
+	}
+	want, err := database.GetPassword(ctx, e.kube, *ref)
+	if err != nil {
+		return "", err
+	}
+	rotation := cr.Status.AtProvider.AdministratorLoginPasswordRotation
+	if database.IsPasswordHashed(rotation.PasswordHash, want) && !database.IsPasswordChangeFailed(rotation.LastRotation) {
+		return "", nil
+	}
+	return want, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.MariaDBServer)
+	if !ok {
+		return errors.New(errNotMariaDBServer)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.UserVisibleState == v1beta1.StateDropping {
+		return nil
+	}
+	if err := e.client.DeleteServer(ctx, cr); resource.Ignore(azure.IsNotFound, err) != nil {
+		return errors.Wrap(err, errDeleteMariaDBServer)
+	}
+
+	return errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
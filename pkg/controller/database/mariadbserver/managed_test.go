@@ -0,0 +1,781 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mariadbserver
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azurev1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+	_ database.MariaDBServerAPI = &MockMariaDBServerAPI{}
+)
+
+type MockMariaDBServerAPI struct {
+	MockGetServer     func(ctx context.Context, s *v1beta1.MariaDBServer) (mariadb.Server, error)
+	MockCreateServer  func(ctx context.Context, s *v1beta1.MariaDBServer, adminPassword string) error
+	MockUpdateServer  func(ctx context.Context, s *v1beta1.MariaDBServer) error
+	MockDeleteServer  func(ctx context.Context, s *v1beta1.MariaDBServer) error
+	MockGetRESTClient func() autorest.Sender
+
+	MockRotateAdministratorLoginPassword func(ctx context.Context, s *v1beta1.MariaDBServer, password string) error
+}
+
+func (m *MockMariaDBServerAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
+
+func (m *MockMariaDBServerAPI) GetServer(ctx context.Context, s *v1beta1.MariaDBServer) (mariadb.Server, error) {
+	return m.MockGetServer(ctx, s)
+}
+
+func (m *MockMariaDBServerAPI) CreateServer(ctx context.Context, s *v1beta1.MariaDBServer, adminPassword string) error {
+	return m.MockCreateServer(ctx, s, adminPassword)
+}
+
+func (m *MockMariaDBServerAPI) UpdateServer(ctx context.Context, s *v1beta1.MariaDBServer) error {
+	return m.MockUpdateServer(ctx, s)
+}
+
+func (m *MockMariaDBServerAPI) RotateAdministratorLoginPassword(ctx context.Context, s *v1beta1.MariaDBServer, password string) error {
+	return m.MockRotateAdministratorLoginPassword(ctx, s, password)
+}
+
+func (m *MockMariaDBServerAPI) DeleteServer(ctx context.Context, s *v1beta1.MariaDBServer) error {
+	return m.MockDeleteServer(ctx, s)
+}
+
+type modifier func(*v1beta1.MariaDBServer)
+
+func withExternalName(name string) modifier {
+	return func(p *v1beta1.MariaDBServer) {
+		meta.SetExternalName(p, name)
+	}
+}
+
+func withAdminName(name string) modifier {
+	return func(p *v1beta1.MariaDBServer) {
+		p.Spec.ForProvider.AdministratorLogin = name
+	}
+}
+
+func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+	return func(p *v1beta1.MariaDBServer) {
+		p.Status.AtProvider.LastOperation = op
+	}
+}
+
+func withConnectionSecret(name string) modifier {
+	return func(p *v1beta1.MariaDBServer) {
+		p.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "cool-namespace", Name: name})
+	}
+}
+
+func withPasswordSecretRef(name string) modifier {
+	return func(p *v1beta1.MariaDBServer) {
+		p.Spec.ForProvider.AdministratorLoginPasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "cool-namespace", Name: name},
+			Key:             "password",
+		}
+	}
+}
+
+// withSecrets returns a MockGetFn that gets the supplied secret data by name.
+func withSecrets(data map[string]map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		d, ok := data[key.Name]
+		if !ok {
+			return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+		}
+		obj.(*corev1.Secret).Data = d
+		return nil
+	}
+}
+
+func withPasswordRotation(o v1beta1.PasswordRotationObservation) modifier {
+	return func(p *v1beta1.MariaDBServer) {
+		p.Status.AtProvider.AdministratorLoginPasswordRotation = o
+	}
+}
+
+func mariadbserver(m ...modifier) *v1beta1.MariaDBServer {
+	p := &v1beta1.MariaDBServer{}
+
+	for _, mod := range m {
+		mod(p)
+	}
+	return p
+}
+
+const (
+	inProgressResponse = `{"status": "InProgress"}`
+)
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	name := "coolserver"
+	endpoint := "coolazure.example.prg"
+	admin := "cooladmin"
+	password := "verysecure"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAMariaDBServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotMariaDBServer),
+			},
+		},
+		"ErrGetServer": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1beta1.MariaDBServer) (mariadb.Server, error) {
+						return mariadb.Server{}, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetMariaDBServer),
+			},
+		},
+		"ServerCreating": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1beta1.MariaDBServer) (mariadb.Server, error) {
+						return mariadb.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+							return &http.Response{
+								Request:       req,
+								StatusCode:    http.StatusAccepted,
+								Body:          ioutil.NopCloser(strings.NewReader(inProgressResponse)),
+								ContentLength: int64(len([]byte(inProgressResponse))),
+							}, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "crossplane.io"})),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ServerNotFound": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1beta1.MariaDBServer) (mariadb.Server, error) {
+						return mariadb.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"ServerAvailable": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockMariaDBServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1beta1.MariaDBServer) (mariadb.Server, error) {
+						return mariadb.Server{
+							Sku: &mariadb.Sku{},
+							ServerProperties: &mariadb.ServerProperties{
+								UserVisibleState:         mariadb.ServerStateReady,
+								FullyQualifiedDomainName: &endpoint,
+								StorageProfile:           &mariadb.StorageProfile{},
+							}}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withExternalName(name),
+					withAdminName(admin),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+					},
+				},
+			},
+		},
+		"PasswordRotated": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)}
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockMariaDBServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1beta1.MariaDBServer) (mariadb.Server, error) {
+						return mariadb.Server{
+							Sku: &mariadb.Sku{},
+							ServerProperties: &mariadb.ServerProperties{
+								UserVisibleState:         mariadb.ServerStateReady,
+								FullyQualifiedDomainName: &endpoint,
+								StorageProfile:           &mariadb.StorageProfile{},
+							}}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withExternalName(name),
+					withAdminName(admin),
+					withConnectionSecret(name),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{
+						LastRotation: azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded},
+					}),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eo, err := tc.e.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		ec  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAMariaDBServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotMariaDBServer),
+			},
+		},
+		"ErrGeneratePassword": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return "", errBoom },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGenPassword),
+			},
+		},
+		"ErrCreateServer": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1beta1.MariaDBServer, _ string) error { return errBoom },
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateMariaDBServer),
+			},
+		},
+		"Successful": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1beta1.MariaDBServer, _ string) error { return nil },
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(),
+			},
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
+		"SuccessfulWithReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockMariaDBServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1beta1.MariaDBServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(withPasswordSecretRef("cool-password")),
+			},
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ec, err := tc.e.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ec, ec); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+	hash, _ := database.HashPassword(password)
+	oldHash, _ := database.HashPassword("old")
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want error
+	}{
+		"ErrNotAMariaDBServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: errors.New(errNotMariaDBServer),
+		},
+		"RotationInProgress": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(withPasswordRotation(v1beta1.PasswordRotationObservation{
+					LastRotation: azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusInProgress},
+				})),
+			},
+			want: nil,
+		},
+		"ErrDeletePendingPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockDelete: test.NewMockDeleteFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{
+						LastRotation: azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded},
+					}),
+				),
+			},
+			want: errors.Wrap(errBoom, "cannot delete pending administrator login password"),
+		},
+		"RotationSucceeded": {
+			e: &external{
+				kube: &test.MockClient{
+					MockDelete: test.NewMockDeleteFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{
+						LastRotation: azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded},
+					}),
+				),
+			},
+			want: nil,
+		},
+		"ErrStorePendingPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{NextRotationTime: &past}),
+				),
+			},
+			want: errors.Wrap(errors.Wrap(errBoom, "cannot get object"), "cannot store pending administrator login password"),
+		},
+		"ErrRotatePassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+				client: &MockMariaDBServerAPI{
+					MockRotateAdministratorLoginPassword: func(_ context.Context, _ *v1beta1.MariaDBServer, _ string) error { return errBoom },
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{NextRotationTime: &past}),
+				),
+			},
+			want: errors.Wrap(errBoom, errRotatePassword),
+		},
+		"ErrGetReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordSecretRef("cool-password"),
+				),
+			},
+			want: errors.Wrap(errBoom, "cannot get administrator login password"),
+		},
+		"ReferencedPasswordChanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-secret":   {xpv1.ResourceCredentialsSecretPasswordKey: []byte("old")},
+						"cool-password": {"password": []byte(password)},
+					}),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+				client: &MockMariaDBServerAPI{
+					MockRotateAdministratorLoginPassword: func(_ context.Context, _ *v1beta1.MariaDBServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordSecretRef("cool-password"),
+				),
+			},
+			want: nil,
+		},
+		"ReferencedPasswordChangedWithoutConnectionSecret": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockMariaDBServerAPI{
+					MockRotateAdministratorLoginPassword: func(_ context.Context, _ *v1beta1.MariaDBServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withPasswordSecretRef("cool-password"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{PasswordHash: oldHash}),
+				),
+			},
+			want: nil,
+		},
+		"ReferencedPasswordUnchanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockMariaDBServerAPI{
+					MockRotateAdministratorLoginPassword: func(_ context.Context, _ *v1beta1.MariaDBServer, _ string) error { return errBoom },
+					MockUpdateServer:                     func(_ context.Context, _ *v1beta1.MariaDBServer) error { return nil },
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mariadbserver(
+					withPasswordSecretRef("cool-password"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{PasswordHash: hash}),
+				),
+			},
+			want: nil,
+		},
+		"RotateWithoutConnectionSecret": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1beta1.MariaDBServer) error { return nil },
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(withPasswordRotation(v1beta1.PasswordRotationObservation{NextRotationTime: &past})),
+			},
+			want: nil,
+		},
+		"ErrUpdateServer": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1beta1.MariaDBServer) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(),
+			},
+			want: errors.Wrap(errBoom, errUpdateMariaDBServer),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want error
+	}{
+		"ErrNotAMariaDBServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: errors.New(errNotMariaDBServer),
+		},
+		"ErrDeleteServer": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockDeleteServer: func(_ context.Context, _ *v1beta1.MariaDBServer) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(),
+			},
+			want: errors.Wrap(errBoom, errDeleteMariaDBServer),
+		},
+		"Successful": {
+			e: &external{
+				client: &MockMariaDBServerAPI{
+					MockDeleteServer: func(_ context.Context, _ *v1beta1.MariaDBServer) error { return nil },
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mariadbserver(),
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.e.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
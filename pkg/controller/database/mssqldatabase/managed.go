@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqldatabase
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errUpdateCR                        = "cannot update MSSQLDatabase custom resource"
+	errNotMSSQLDatabase                = "managed resource is not an MSSQLDatabase"
+	errCreateMSSQLDatabase             = "cannot create MSSQLDatabase"
+	errUpdateMSSQLDatabase             = "cannot update MSSQLDatabase"
+	errGetMSSQLDatabase                = "cannot get MSSQLDatabase"
+	errDeleteMSSQLDatabase             = "cannot delete MSSQLDatabase"
+	errGetTransparentDataEncryption    = "cannot get transparent data encryption of MSSQLDatabase"
+	errUpdateTransparentDataEncryption = "cannot update transparent data encryption of MSSQLDatabase"
+)
+
+// Setup adds a controller that reconciles MSSQLDatabases.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.MSSQLDatabaseGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.MSSQLDatabase{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.MSSQLDatabaseList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MSSQLDatabaseGroupVersionKind),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := sql.NewDatabasesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	tde := sql.NewTransparentDataEncryptionsClient(creds[azure.CredentialsKeySubscriptionID])
+	tde.Authorizer = auth
+	return &external{kube: c.client, client: cl, tde: tde}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client sqlapi.DatabasesClientAPI
+	tde    sqlapi.TransparentDataEncryptionsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	d, ok := mg.(*v1alpha3.MSSQLDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMSSQLDatabase)
+	}
+
+	az, err := e.client.Get(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMSSQLDatabase)
+	}
+	tde, err := e.tde.Get(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTransparentDataEncryption)
+	}
+	status := database.MSSQLTransparentDataEncryptionStatus(tde)
+
+	database.LateInitializeMSSQLDatabase(&d.Spec.ForProvider, az)
+	if status != "" {
+		d.Spec.ForProvider.TransparentDataEncryption = azure.LateInitializeStringPtrFromVal(d.Spec.ForProvider.TransparentDataEncryption, status)
+	}
+	if err := e.kube.Update(ctx, d); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	database.UpdateMSSQLDatabaseObservation(&d.Status.AtProvider, az)
+	d.Status.AtProvider.TransparentDataEncryption = status
+
+	// Any status beside 'Online' is considered unavailable.
+	switch d.Status.AtProvider.Status {
+	case v1alpha3.MSSQLDatabaseStatusOnline:
+		d.SetConditions(xpv1.Available())
+	default:
+		d.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.IsMSSQLDatabaseUpToDate(d.Spec.ForProvider, az) && transparentDataEncryptionIsUpToDate(d),
+		ConnectionDetails: managed.ConnectionDetails{
+			database.ConnectionSecretDatabaseKey: []byte(meta.GetExternalName(d)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	d, ok := mg.(*v1alpha3.MSSQLDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMSSQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Creating())
+	p := database.NewMSSQLDatabaseParameters(d.Spec.ForProvider)
+	_, err := e.client.CreateOrUpdate(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d), p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateMSSQLDatabase)
+}
+
+// Update updates the transparent data encryption of the database before the
+// database itself, since the former completes synchronously.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	d, ok := mg.(*v1alpha3.MSSQLDatabase)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMSSQLDatabase)
+	}
+
+	if !transparentDataEncryptionIsUpToDate(d) {
+		t := database.NewMSSQLTransparentDataEncryptionParameters(azure.ToString(d.Spec.ForProvider.TransparentDataEncryption))
+		if _, err := e.tde.CreateOrUpdate(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d), t); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTransparentDataEncryption)
+		}
+	}
+
+	p := database.NewMSSQLDatabaseParameters(d.Spec.ForProvider)
+	_, err := e.client.CreateOrUpdate(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d), p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMSSQLDatabase)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	d, ok := mg.(*v1alpha3.MSSQLDatabase)
+	if !ok {
+		return errors.New(errNotMSSQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteMSSQLDatabase)
+}
+
+// transparentDataEncryptionIsUpToDate returns true if the observed transparent
+// data encryption status of the supplied database matches its spec.
+func transparentDataEncryptionIsUpToDate(d *v1alpha3.MSSQLDatabase) bool {
+	want := d.Spec.ForProvider.TransparentDataEncryption
+	return want == nil || *want == d.Status.AtProvider.TransparentDataEncryption
+}
@@ -0,0 +1,607 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqldatabase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolDatabase"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	collation         = "SQL_Latin1_General_CP1_CI_AS"
+	skuName           = "S0"
+	tdeEnabled        = string(sql.TransparentDataEncryptionStatusEnabled)
+	tdeDisabled       = string(sql.TransparentDataEncryptionStatusDisabled)
+)
+
+type databaseModifier func(*v1alpha3.MSSQLDatabase)
+
+func withConditions(c ...xpv1.Condition) databaseModifier {
+	return func(r *v1alpha3.MSSQLDatabase) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withType(s string) databaseModifier {
+	return func(r *v1alpha3.MSSQLDatabase) { r.Status.AtProvider.Type = s }
+}
+
+func withID(s string) databaseModifier {
+	return func(r *v1alpha3.MSSQLDatabase) { r.Status.AtProvider.ID = s }
+}
+
+func withStatus(s string) databaseModifier {
+	return func(r *v1alpha3.MSSQLDatabase) { r.Status.AtProvider.Status = s }
+}
+
+func withObservedTDE(s string) databaseModifier {
+	return func(r *v1alpha3.MSSQLDatabase) { r.Status.AtProvider.TransparentDataEncryption = s }
+}
+
+func withSKU(s string) databaseModifier {
+	return func(r *v1alpha3.MSSQLDatabase) { r.Spec.ForProvider.SKU = &v1alpha3.MSSQLSKU{Name: s} }
+}
+
+func withCollation(s string) databaseModifier {
+	return func(r *v1alpha3.MSSQLDatabase) { r.Spec.ForProvider.Collation = azure.ToStringPtr(s) }
+}
+
+func withTDE(s string) databaseModifier {
+	return func(r *v1alpha3.MSSQLDatabase) { r.Spec.ForProvider.TransparentDataEncryption = azure.ToStringPtr(s) }
+}
+
+func db(sm ...databaseModifier) *v1alpha3.MSSQLDatabase {
+	r := &v1alpha3.MSSQLDatabase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.MSSQLDatabaseSpec{
+			ForProvider: v1alpha3.MSSQLDatabaseParameters{
+				ServerName:        serverName,
+				ResourceGroupName: resourceGroupName,
+			},
+		},
+	}
+
+	meta.SetExternalName(r, name)
+
+	for _, m := range sm {
+		m(r)
+	}
+
+	return r
+}
+
+func tde(status string) func(context.Context, string, string, string) (sql.TransparentDataEncryption, error) {
+	return func(_ context.Context, _ string, _ string, _ string) (sql.TransparentDataEncryption, error) {
+		return sql.TransparentDataEncryption{
+			TransparentDataEncryptionProperties: &sql.TransparentDataEncryptionProperties{
+				Status: sql.TransparentDataEncryptionStatus(status),
+			},
+		}, nil
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMSSQLDatabase": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotMSSQLDatabase),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+					return sql.Database{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(),
+			},
+		},
+		"SuccessfulObserveExists": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+						return sql.Database{
+							ID:   azure.ToStringPtr(resourceID),
+							Type: azure.ToStringPtr(resourceType),
+							Sku:  &sql.Sku{Name: azure.ToStringPtr(skuName)},
+							DatabaseProperties: &sql.DatabaseProperties{
+								Status:    sql.DatabaseStatusOnline,
+								Collation: azure.ToStringPtr(collation),
+							},
+						}, nil
+					},
+				},
+				tde: &fake.MockMSSQLTransparentDataEncryptionsClient{MockGet: tde(tdeEnabled)},
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Available()),
+					withSKU(skuName),
+					withCollation(collation),
+					withTDE(tdeEnabled),
+					withType(resourceType),
+					withID(resourceID),
+					withStatus(v1alpha3.MSSQLDatabaseStatusOnline),
+					withObservedTDE(tdeEnabled),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey: []byte(name),
+					},
+				},
+			},
+		},
+		"SuccessfulObserveTDENotUpToDate": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+						return sql.Database{
+							Sku: &sql.Sku{Name: azure.ToStringPtr(skuName)},
+							DatabaseProperties: &sql.DatabaseProperties{
+								Status:    sql.DatabaseStatusOnline,
+								Collation: azure.ToStringPtr(collation),
+							},
+						}, nil
+					},
+				},
+				tde: &fake.MockMSSQLTransparentDataEncryptionsClient{MockGet: tde(tdeDisabled)},
+			},
+			args: args{
+				mg: db(withTDE(tdeEnabled)),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Available()),
+					withSKU(skuName),
+					withCollation(collation),
+					withTDE(tdeEnabled),
+					withStatus(v1alpha3.MSSQLDatabaseStatusOnline),
+					withObservedTDE(tdeDisabled),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey: []byte(name),
+					},
+				},
+			},
+		},
+		"SuccessfulObserveUnavailable": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+						return sql.Database{
+							Sku: &sql.Sku{Name: azure.ToStringPtr("P1")},
+							DatabaseProperties: &sql.DatabaseProperties{
+								Status: sql.DatabaseStatusScaling,
+							},
+						}, nil
+					},
+				},
+				tde: &fake.MockMSSQLTransparentDataEncryptionsClient{MockGet: tde(tdeEnabled)},
+			},
+			args: args{
+				mg: db(withSKU(skuName), withTDE(tdeEnabled)),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Unavailable()),
+					withSKU(skuName),
+					withTDE(tdeEnabled),
+					withStatus(string(sql.DatabaseStatusScaling)),
+					withObservedTDE(tdeEnabled),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey: []byte(name),
+					},
+				},
+			},
+		},
+		"FailedGetTDE": {
+			ec: &external{
+				client: &fake.MockMSSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+						return sql.Database{DatabaseProperties: &sql.DatabaseProperties{}}, nil
+					},
+				},
+				tde: &fake.MockMSSQLTransparentDataEncryptionsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.TransparentDataEncryption, error) {
+						return sql.TransparentDataEncryption{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetTransparentDataEncryption),
+			},
+		},
+		"FailedUpdateCR": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockMSSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+						return sql.Database{DatabaseProperties: &sql.DatabaseProperties{}}, nil
+					},
+				},
+				tde: &fake.MockMSSQLTransparentDataEncryptionsClient{MockGet: tde("")},
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+					return sql.Database{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetMSSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMSSQLDatabase": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotMSSQLDatabase),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.Database) (sql.DatabasesCreateOrUpdateFuture, error) {
+					return sql.DatabasesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreateMSSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, n string, p sql.Database) (sql.DatabasesCreateOrUpdateFuture, error) {
+					if n != name || azure.ToString(p.Sku.Name) != skuName || azure.ToString(p.Collation) != collation {
+						return sql.DatabasesCreateOrUpdateFuture{}, errBoom
+					}
+					return sql.DatabasesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: db(withSKU(skuName), withCollation(collation)),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Creating()),
+					withSKU(skuName),
+					withCollation(collation),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMSSQLDatabase": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotMSSQLDatabase),
+			},
+		},
+		"UpdateTDEError": {
+			ec: &external{tde: &fake.MockMSSQLTransparentDataEncryptionsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.TransparentDataEncryption) (sql.TransparentDataEncryption, error) {
+					return sql.TransparentDataEncryption{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(withTDE(tdeEnabled), withObservedTDE(tdeDisabled)),
+			},
+			want: want{
+				mg:  db(withTDE(tdeEnabled), withObservedTDE(tdeDisabled)),
+				err: errors.Wrap(errBoom, errUpdateTransparentDataEncryption),
+			},
+		},
+		"UpdateError": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.Database) (sql.DatabasesCreateOrUpdateFuture, error) {
+					return sql.DatabasesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errUpdateMSSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{
+				client: &fake.MockMSSQLDatabasesClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.Database) (sql.DatabasesCreateOrUpdateFuture, error) {
+						return sql.DatabasesCreateOrUpdateFuture{}, nil
+					},
+				},
+				tde: &fake.MockMSSQLTransparentDataEncryptionsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, p sql.TransparentDataEncryption) (sql.TransparentDataEncryption, error) {
+						if p.Status != sql.TransparentDataEncryptionStatusEnabled {
+							return sql.TransparentDataEncryption{}, errBoom
+						}
+						return p, nil
+					},
+				},
+			},
+			args: args{
+				mg: db(withTDE(tdeEnabled), withObservedTDE(tdeDisabled)),
+			},
+			want: want{
+				mg: db(withTDE(tdeEnabled), withObservedTDE(tdeDisabled)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMSSQLDatabase": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotMSSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.DatabasesDeleteFuture, error) {
+					return sql.DatabasesDeleteFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.DatabasesDeleteFuture, error) {
+					return sql.DatabasesDeleteFuture{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.DatabasesDeleteFuture, error) {
+					return sql.DatabasesDeleteFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteMSSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
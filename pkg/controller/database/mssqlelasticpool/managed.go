@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlelasticpool
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errUpdateCR               = "cannot update MSSQLElasticPool custom resource"
+	errNotMSSQLElasticPool    = "managed resource is not an MSSQLElasticPool"
+	errCreateMSSQLElasticPool = "cannot create MSSQLElasticPool"
+	errUpdateMSSQLElasticPool = "cannot update MSSQLElasticPool"
+	errGetMSSQLElasticPool    = "cannot get MSSQLElasticPool"
+	errDeleteMSSQLElasticPool = "cannot delete MSSQLElasticPool"
+)
+
+// Setup adds a controller that reconciles MSSQLElasticPools.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.MSSQLElasticPoolGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.MSSQLElasticPool{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.MSSQLElasticPoolList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MSSQLElasticPoolGroupVersionKind),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := sql.NewElasticPoolsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: cl}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client sqlapi.ElasticPoolsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	p, ok := mg.(*v1alpha3.MSSQLElasticPool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMSSQLElasticPool)
+	}
+
+	az, err := e.client.Get(ctx, p.Spec.ForProvider.ResourceGroupName, p.Spec.ForProvider.ServerName, meta.GetExternalName(p))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMSSQLElasticPool)
+	}
+
+	database.LateInitializeMSSQLElasticPool(&p.Spec.ForProvider, az)
+	if err := e.kube.Update(ctx, p); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	database.UpdateMSSQLElasticPoolObservation(&p.Status.AtProvider, az)
+
+	// Any state beside 'Ready' is considered unavailable.
+	switch p.Status.AtProvider.State {
+	case v1alpha3.MSSQLElasticPoolStateReady:
+		p.SetConditions(xpv1.Available())
+	default:
+		p.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.IsMSSQLElasticPoolUpToDate(p.Spec.ForProvider, az),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	p, ok := mg.(*v1alpha3.MSSQLElasticPool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMSSQLElasticPool)
+	}
+
+	p.SetConditions(xpv1.Creating())
+	params := database.NewMSSQLElasticPoolParameters(p.Spec.ForProvider)
+	_, err := e.client.CreateOrUpdate(ctx, p.Spec.ForProvider.ResourceGroupName, p.Spec.ForProvider.ServerName, meta.GetExternalName(p), params)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateMSSQLElasticPool)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	p, ok := mg.(*v1alpha3.MSSQLElasticPool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMSSQLElasticPool)
+	}
+
+	params := database.NewMSSQLElasticPoolParameters(p.Spec.ForProvider)
+	_, err := e.client.CreateOrUpdate(ctx, p.Spec.ForProvider.ResourceGroupName, p.Spec.ForProvider.ServerName, meta.GetExternalName(p), params)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMSSQLElasticPool)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	p, ok := mg.(*v1alpha3.MSSQLElasticPool)
+	if !ok {
+		return errors.New(errNotMSSQLElasticPool)
+	}
+
+	p.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, p.Spec.ForProvider.ResourceGroupName, p.Spec.ForProvider.ServerName, meta.GetExternalName(p))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteMSSQLElasticPool)
+}
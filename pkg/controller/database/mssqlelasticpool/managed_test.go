@@ -0,0 +1,492 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlelasticpool
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolPool"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	skuName           = "StandardPool"
+	capacity          = 50
+)
+
+type poolModifier func(*v1alpha3.MSSQLElasticPool)
+
+func withConditions(c ...xpv1.Condition) poolModifier {
+	return func(r *v1alpha3.MSSQLElasticPool) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withType(s string) poolModifier {
+	return func(r *v1alpha3.MSSQLElasticPool) { r.Status.AtProvider.Type = s }
+}
+
+func withID(s string) poolModifier {
+	return func(r *v1alpha3.MSSQLElasticPool) { r.Status.AtProvider.ID = s }
+}
+
+func withState(s string) poolModifier {
+	return func(r *v1alpha3.MSSQLElasticPool) { r.Status.AtProvider.State = s }
+}
+
+func withCapacity(c int) poolModifier {
+	return func(r *v1alpha3.MSSQLElasticPool) { r.Spec.ForProvider.SKU.Capacity = &c }
+}
+
+func withZoneRedundant(b bool) poolModifier {
+	return func(r *v1alpha3.MSSQLElasticPool) { r.Spec.ForProvider.ZoneRedundant = &b }
+}
+
+func pool(sm ...poolModifier) *v1alpha3.MSSQLElasticPool {
+	r := &v1alpha3.MSSQLElasticPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.MSSQLElasticPoolSpec{
+			ForProvider: v1alpha3.MSSQLElasticPoolParameters{
+				ServerName:        serverName,
+				ResourceGroupName: resourceGroupName,
+				SKU:               v1alpha3.MSSQLSKU{Name: skuName},
+			},
+		},
+	}
+
+	meta.SetExternalName(r, name)
+
+	for _, m := range sm {
+		m(r)
+	}
+
+	return r
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMSSQLElasticPool": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{}},
+			want: want{
+				err: errors.New(errNotMSSQLElasticPool),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+					return sql.ElasticPool{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg: pool(),
+			},
+		},
+		"SuccessfulObserveExists": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLElasticPoolsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+						return sql.ElasticPool{
+							ID:   azure.ToStringPtr(resourceID),
+							Type: azure.ToStringPtr(resourceType),
+							Sku:  &sql.Sku{Name: azure.ToStringPtr(skuName), Capacity: azure.ToInt32Ptr(capacity)},
+							ElasticPoolProperties: &sql.ElasticPoolProperties{
+								State:         sql.ElasticPoolStateReady,
+								ZoneRedundant: azure.ToBoolPtr(false, azure.FieldRequired),
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg: pool(
+					withConditions(xpv1.Available()),
+					withCapacity(capacity),
+					withZoneRedundant(false),
+					withType(resourceType),
+					withID(resourceID),
+					withState(v1alpha3.MSSQLElasticPoolStateReady),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SuccessfulObserveNotUpToDate": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLElasticPoolsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+						return sql.ElasticPool{
+							Sku: &sql.Sku{Name: azure.ToStringPtr(skuName), Capacity: azure.ToInt32Ptr(100)},
+							ElasticPoolProperties: &sql.ElasticPoolProperties{
+								State: sql.ElasticPoolStateCreating,
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: pool(withCapacity(capacity)),
+			},
+			want: want{
+				mg: pool(
+					withConditions(xpv1.Unavailable()),
+					withCapacity(capacity),
+					withState(string(sql.ElasticPoolStateCreating)),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"FailedUpdateCR": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockMSSQLElasticPoolsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+						return sql.ElasticPool{ElasticPoolProperties: &sql.ElasticPoolProperties{}}, nil
+					},
+				},
+			},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg:  pool(),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+					return sql.ElasticPool{}, errBoom
+				},
+			}},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg:  pool(),
+				err: errors.Wrap(errBoom, errGetMSSQLElasticPool),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMSSQLElasticPool": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{}},
+			want: want{
+				err: errors.New(errNotMSSQLElasticPool),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.ElasticPool) (sql.ElasticPoolsCreateOrUpdateFuture, error) {
+					return sql.ElasticPoolsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg: pool(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreateMSSQLElasticPool),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, n string, p sql.ElasticPool) (sql.ElasticPoolsCreateOrUpdateFuture, error) {
+					if n != name || azure.ToString(p.Sku.Name) != skuName || azure.ToInt(p.Sku.Capacity) != capacity {
+						return sql.ElasticPoolsCreateOrUpdateFuture{}, errBoom
+					}
+					return sql.ElasticPoolsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: pool(withCapacity(capacity)),
+			},
+			want: want{
+				mg: pool(
+					withConditions(xpv1.Creating()),
+					withCapacity(capacity),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMSSQLElasticPool": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{}},
+			want: want{
+				err: errors.New(errNotMSSQLElasticPool),
+			},
+		},
+		"UpdateError": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.ElasticPool) (sql.ElasticPoolsCreateOrUpdateFuture, error) {
+					return sql.ElasticPoolsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg:  pool(),
+				err: errors.Wrap(errBoom, errUpdateMSSQLElasticPool),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.ElasticPool) (sql.ElasticPoolsCreateOrUpdateFuture, error) {
+					return sql.ElasticPoolsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg: pool(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMSSQLElasticPool": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{}},
+			want: want{
+				err: errors.New(errNotMSSQLElasticPool),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPoolsDeleteFuture, error) {
+					return sql.ElasticPoolsDeleteFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg: pool(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPoolsDeleteFuture, error) {
+					return sql.ElasticPoolsDeleteFuture{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
+				},
+			}},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg: pool(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPoolsDeleteFuture, error) {
+					return sql.ElasticPoolsDeleteFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: pool(),
+			},
+			want: want{
+				mg: pool(
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteMSSQLElasticPool),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errUpdateCR                   = "cannot update MSSQLServer custom resource"
+	errGenPassword                = "cannot generate admin password"
+	errNotMSSQLServer             = "managed resource is not an MSSQLServer"
+	errCreateMSSQLServer          = "cannot create MSSQLServer"
+	errUpdateMSSQLServer          = "cannot update MSSQLServer"
+	errGetMSSQLServer             = "cannot get MSSQLServer"
+	errDeleteMSSQLServer          = "cannot delete MSSQLServer"
+	errGetAzureADAdministrator    = "cannot get Azure AD administrator of MSSQLServer"
+	errUpdateAzureADAdministrator = "cannot update Azure AD administrator of MSSQLServer"
+	errFetchLastOperation         = "cannot fetch last operation"
+)
+
+// Setup adds a controller that reconciles MSSQLServers.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.MSSQLServerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.MSSQLServer{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.MSSQLServerList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MSSQLServerGroupVersionKind),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	servers := sql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	servers.Authorizer = auth
+	admins := sql.NewServerAzureADAdministratorsClient(creds[azure.CredentialsKeySubscriptionID])
+	admins.Authorizer = auth
+	return &external{kube: c.client, client: database.NewMSSQLServerClient(servers, admins), newPasswordFn: password.Generate}, nil
+}
+
+type external struct {
+	kube          client.Client
+	client        database.MSSQLServerAPI
+	newPasswordFn func() (password string, err error)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLServer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMSSQLServer)
+	}
+	server, err := e.client.GetServer(ctx, cr)
+	if azure.IsNotFound(err) {
+		if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+		// Azure returns NotFound for GET calls until creation is completed,
+		// so we check whether a creation operation is in fact in motion.
+		creating := cr.Status.AtProvider.LastOperation.Method == http.MethodPut &&
+			cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress
+		return managed.ExternalObservation{ResourceExists: creating}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMSSQLServer)
+	}
+	// We only observe the Azure AD administrator when one is specified. An
+	// administrator that is not specified is left as is.
+	var admin sql.ServerAzureADAdministrator
+	if cr.Spec.ForProvider.AzureADAdministrator != nil {
+		admin, err = e.client.GetAzureADAdministrator(ctx, cr)
+		if resource.Ignore(azure.IsNotFound, err) != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAzureADAdministrator)
+		}
+	}
+	database.LateInitializeMSSQLServer(&cr.Spec.ForProvider, server)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+	database.UpdateMSSQLServerObservation(&cr.Status.AtProvider, server)
+	// We make this call after kube.Update since it doesn't update the
+	// status subresource but fetches the the whole object after it's done. So,
+	// changes to status has to be done after kube.Update in order not to get them
+	// lost.
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	// Any state beside 'Ready' is considered unavailable.
+	switch cr.Status.AtProvider.State {
+	case v1alpha3.MSSQLServerStateReady:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	pw, err := e.changedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: database.IsMSSQLServerUpToDate(cr.Spec.ForProvider, server) &&
+			database.IsMSSQLServerAzureADAdministratorUpToDate(cr.Spec.ForProvider.AzureADAdministrator, admin) &&
+			pw == "",
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.FullyQualifiedDomainName),
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.ForProvider.AdministratorLogin),
+			xpv1.ResourceCredentialsSecretPortKey:     []byte(v1alpha3.MSSQLServerPort),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLServer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMSSQLServer)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	pw, err := e.password(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := e.client.CreateServer(ctx, cr, pw); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMSSQLServer)
+	}
+	if cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef != nil {
+		h, err := database.HashPassword(pw)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		cr.Status.AtProvider.AdministratorLoginPasswordHash = h
+	}
+
+	ec := managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}
+	return ec, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+// Update updates the server's Azure AD administrator if it is not up to date,
+// and otherwise the rest of the server. Azure does not accept concurrent
+// operations on a server, so only one of them is updated at a time. The
+// administrator goes first because its update is quick, while a server update
+// such as a change of SKU may take a long time, and access to the server should
+// not wait for it.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLServer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMSSQLServer)
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	pw, err := e.changedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if a := cr.Spec.ForProvider.AzureADAdministrator; a != nil {
+		admin, err := e.client.GetAzureADAdministrator(ctx, cr)
+		if resource.Ignore(azure.IsNotFound, err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGetAzureADAdministrator)
+		}
+		if !database.IsMSSQLServerAzureADAdministratorUpToDate(a, admin) {
+			if err := e.client.UpdateAzureADAdministrator(ctx, cr); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAzureADAdministrator)
+			}
+			return managed.ExternalUpdate{}, errors.Wrap(
+				azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+				errFetchLastOperation)
+		}
+	}
+
+	if err := e.client.UpdateServer(ctx, cr, pw); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMSSQLServer)
+	}
+	eu := managed.ExternalUpdate{}
+	if pw != "" {
+		h, err := database.HashPassword(pw)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		cr.Status.AtProvider.AdministratorLoginPasswordHash = h
+		eu.ConnectionDetails = managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}
+	}
+	return eu, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+// password returns the administrator login password the supplied server
+// should be created with; either the referenced password or a new one.
+func (e *external) password(ctx context.Context, cr *v1alpha3.MSSQLServer) (string, error) {
+	if ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef; ref != nil {
+		return database.GetPassword(ctx, e.kube, *ref)
+	}
+	pw, err := e.newPasswordFn()
+	return pw, errors.Wrap(err, errGenPassword)
+}
+
+// changedPassword returns the administrator login password referenced by the
+// supplied server if it is not the password that was last sent to Azure, or if
+// Azure failed to apply it. It returns an empty string otherwise.
+func (e *external) changedPassword(ctx context.Context, cr *v1alpha3.MSSQLServer) (string, error) {
+	ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef
+	if ref == nil {
+		return "", nil
+	}
+	want, err := database.GetPassword(ctx, e.kube, *ref)
+	if err != nil {
+		return "", err
+	}
+	if database.IsPasswordHashed(cr.Status.AtProvider.AdministratorLoginPasswordHash, want) &&
+		!database.IsPasswordChangeFailed(cr.Status.AtProvider.LastOperation) {
+		return "", nil
+	}
+	return want, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.MSSQLServer)
+	if !ok {
+		return errors.New(errNotMSSQLServer)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	op := cr.Status.AtProvider.LastOperation
+	if op.Method == http.MethodDelete && op.Status == azure.AsyncOperationStatusInProgress {
+		return nil
+	}
+	if err := e.client.DeleteServer(ctx, cr); resource.Ignore(azure.IsNotFound, err) != nil {
+		return errors.Wrap(err, errDeleteMSSQLServer)
+	}
+	return errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
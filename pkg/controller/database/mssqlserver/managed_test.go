@@ -0,0 +1,851 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlserver
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azurev1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+	_ database.MSSQLServerAPI   = &MockMSSQLServerAPI{}
+)
+
+type MockMSSQLServerAPI struct {
+	MockGetServer     func(ctx context.Context, s *v1alpha3.MSSQLServer) (sql.Server, error)
+	MockCreateServer  func(ctx context.Context, s *v1alpha3.MSSQLServer, adminPassword string) error
+	MockDeleteServer  func(ctx context.Context, s *v1alpha3.MSSQLServer) error
+	MockUpdateServer  func(ctx context.Context, s *v1alpha3.MSSQLServer, adminPassword string) error
+	MockGetRESTClient func() autorest.Sender
+
+	MockGetAzureADAdministrator    func(ctx context.Context, s *v1alpha3.MSSQLServer) (sql.ServerAzureADAdministrator, error)
+	MockUpdateAzureADAdministrator func(ctx context.Context, s *v1alpha3.MSSQLServer) error
+}
+
+func (m *MockMSSQLServerAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
+
+func (m *MockMSSQLServerAPI) GetServer(ctx context.Context, s *v1alpha3.MSSQLServer) (sql.Server, error) {
+	return m.MockGetServer(ctx, s)
+}
+
+func (m *MockMSSQLServerAPI) CreateServer(ctx context.Context, s *v1alpha3.MSSQLServer, adminPassword string) error {
+	return m.MockCreateServer(ctx, s, adminPassword)
+}
+
+func (m *MockMSSQLServerAPI) UpdateServer(ctx context.Context, s *v1alpha3.MSSQLServer, adminPassword string) error {
+	return m.MockUpdateServer(ctx, s, adminPassword)
+}
+
+func (m *MockMSSQLServerAPI) DeleteServer(ctx context.Context, s *v1alpha3.MSSQLServer) error {
+	return m.MockDeleteServer(ctx, s)
+}
+
+func (m *MockMSSQLServerAPI) GetAzureADAdministrator(ctx context.Context, s *v1alpha3.MSSQLServer) (sql.ServerAzureADAdministrator, error) {
+	return m.MockGetAzureADAdministrator(ctx, s)
+}
+
+func (m *MockMSSQLServerAPI) UpdateAzureADAdministrator(ctx context.Context, s *v1alpha3.MSSQLServer) error {
+	return m.MockUpdateAzureADAdministrator(ctx, s)
+}
+
+type modifier func(*v1alpha3.MSSQLServer)
+
+func withExternalName(name string) modifier {
+	return func(p *v1alpha3.MSSQLServer) {
+		meta.SetExternalName(p, name)
+	}
+}
+
+func withAdminName(name string) modifier {
+	return func(p *v1alpha3.MSSQLServer) {
+		p.Spec.ForProvider.AdministratorLogin = name
+	}
+}
+
+func withAzureADAdministrator(login, objectID string) modifier {
+	return func(p *v1alpha3.MSSQLServer) {
+		p.Spec.ForProvider.AzureADAdministrator = &v1alpha3.MSSQLServerAzureADAdministrator{Login: login, ObjectID: objectID}
+	}
+}
+
+func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+	return func(p *v1alpha3.MSSQLServer) {
+		p.Status.AtProvider.LastOperation = op
+	}
+}
+
+func withConnectionSecret(name string) modifier {
+	return func(p *v1alpha3.MSSQLServer) {
+		p.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "cool-namespace", Name: name})
+	}
+}
+
+func withPasswordSecretRef(name string) modifier {
+	return func(p *v1alpha3.MSSQLServer) {
+		p.Spec.ForProvider.AdministratorLoginPasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "cool-namespace", Name: name},
+			Key:             "password",
+		}
+	}
+}
+
+// withSecrets returns a MockGetFn that gets the supplied secret data by name.
+func withSecrets(data map[string]map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		d, ok := data[key.Name]
+		if !ok {
+			return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+		}
+		obj.(*corev1.Secret).Data = d
+		return nil
+	}
+}
+
+func withAdministratorLoginPasswordHash(h string) modifier {
+	return func(s *v1alpha3.MSSQLServer) {
+		s.Status.AtProvider.AdministratorLoginPasswordHash = h
+	}
+}
+
+func server(m ...modifier) *v1alpha3.MSSQLServer {
+	p := &v1alpha3.MSSQLServer{}
+
+	for _, mod := range m {
+		mod(p)
+	}
+	return p
+}
+
+func notFoundAdministrator(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.ServerAzureADAdministrator, error) {
+	return sql.ServerAzureADAdministrator{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+}
+
+func nilSender() autorest.Sender {
+	return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+}
+
+const (
+	inProgressResponse = `{"status": "InProgress"}`
+)
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	name := "coolserver"
+	endpoint := "coolazure.example.prg"
+	admin := "cooladmin"
+	password := "verysecure"
+	hash, _ := database.HashPassword(password)
+	objectID := "00000000-0000-0000-0000-000000000001"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAMSSQLServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotMSSQLServer),
+			},
+		},
+		"ErrGetServer": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{}, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetMSSQLServer),
+			},
+		},
+		"ServerCreating": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+							return &http.Response{
+								Request:       req,
+								StatusCode:    http.StatusAccepted,
+								Body:          ioutil.NopCloser(strings.NewReader(inProgressResponse)),
+								ContentLength: int64(len([]byte(inProgressResponse))),
+							}, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "crossplane.io"})),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ServerNotFound": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"ErrGetAzureADAdministrator": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{}, nil
+					},
+					MockGetAzureADAdministrator: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.ServerAzureADAdministrator, error) {
+						return sql.ServerAzureADAdministrator{}, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withAzureADAdministrator("cooladmins", objectID)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetAzureADAdministrator),
+			},
+		},
+		"ErrUpdateCR": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{}, nil
+					},
+					MockGetAzureADAdministrator: notFoundAdministrator,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"ServerAvailable": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{
+							ServerProperties: &sql.ServerProperties{
+								State:                    azure.ToStringPtr(v1alpha3.MSSQLServerStateReady),
+								FullyQualifiedDomainName: &endpoint,
+							}}, nil
+					},
+					MockGetAzureADAdministrator: notFoundAdministrator,
+					MockGetRESTClient:           nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: server(
+					withExternalName(name),
+					withAdminName(admin),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1alpha3.MSSQLServerPort),
+					},
+				},
+			},
+		},
+		"AzureADAdministratorChanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{
+							ServerProperties: &sql.ServerProperties{
+								State:                    azure.ToStringPtr(v1alpha3.MSSQLServerStateReady),
+								FullyQualifiedDomainName: &endpoint,
+							}}, nil
+					},
+					MockGetAzureADAdministrator: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.ServerAzureADAdministrator, error) {
+						sid := uuid.Must(uuid.FromString(objectID))
+						return sql.ServerAzureADAdministrator{
+							AdministratorProperties: &sql.AdministratorProperties{
+								Login: azure.ToStringPtr("old-admins"),
+								Sid:   &sid,
+							}}, nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: server(
+					withExternalName(name),
+					withAdminName(admin),
+					withAzureADAdministrator("cool-admins", objectID),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1alpha3.MSSQLServerPort),
+					},
+				},
+			},
+		},
+		"ReferencedPasswordChanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-password": {"password": []byte(password)},
+						"cool-secret":   {xpv1.ResourceCredentialsSecretPasswordKey: []byte("old")},
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{
+							ServerProperties: &sql.ServerProperties{
+								State:                    azure.ToStringPtr(v1alpha3.MSSQLServerStateReady),
+								FullyQualifiedDomainName: &endpoint,
+							}}, nil
+					},
+					MockGetAzureADAdministrator: notFoundAdministrator,
+					MockGetRESTClient:           nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: server(
+					withExternalName(name),
+					withAdminName(admin),
+					withConnectionSecret("cool-secret"),
+					withPasswordSecretRef("cool-password"),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1alpha3.MSSQLServerPort),
+					},
+				},
+			},
+		},
+		"ReferencedPasswordUnchanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet:    withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockMSSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.Server, error) {
+						return sql.Server{
+							ServerProperties: &sql.ServerProperties{
+								State:                    azure.ToStringPtr(v1alpha3.MSSQLServerStateReady),
+								FullyQualifiedDomainName: &endpoint,
+							}}, nil
+					},
+					MockGetAzureADAdministrator: notFoundAdministrator,
+					MockGetRESTClient:           nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: server(
+					withExternalName(name),
+					withAdminName(admin),
+					withPasswordSecretRef("cool-password"),
+					withAdministratorLoginPasswordHash(hash),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1alpha3.MSSQLServerPort),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eo, err := tc.e.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		ec  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAMSSQLServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotMSSQLServer),
+			},
+		},
+		"ErrGeneratePassword": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return "", errBoom },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGenPassword),
+			},
+		},
+		"ErrCreateServer": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1alpha3.MSSQLServer, _ string) error { return errBoom },
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateMSSQLServer),
+			},
+		},
+		"Successful": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockCreateServer:  func(_ context.Context, _ *v1alpha3.MSSQLServer, _ string) error { return nil },
+					MockGetRESTClient: nilSender,
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
+		"SuccessfulWithReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockMSSQLServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1alpha3.MSSQLServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withPasswordSecretRef("cool-password")),
+			},
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ec, err := tc.e.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ec, ec); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+	oldHash, _ := database.HashPassword("old")
+	objectID := "00000000-0000-0000-0000-000000000001"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eu  managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAMSSQLServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotMSSQLServer),
+			},
+		},
+		"OperationInProgress": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withLastOperation(azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusInProgress})),
+			},
+		},
+		"ErrGetReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withConnectionSecret("cool-secret"), withPasswordSecretRef("cool-password")),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get administrator login password"),
+			},
+		},
+		"ErrUpdateServer": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.MSSQLServer, _ string) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateMSSQLServer),
+			},
+		},
+		"Successful": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.MSSQLServer, pw string) error {
+						if pw != "" {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+		},
+		"ErrGetAzureADAdministrator": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockGetAzureADAdministrator: func(_ context.Context, _ *v1alpha3.MSSQLServer) (sql.ServerAzureADAdministrator, error) {
+						return sql.ServerAzureADAdministrator{}, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withAzureADAdministrator("cooladmins", objectID)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetAzureADAdministrator),
+			},
+		},
+		"ErrUpdateAzureADAdministrator": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockGetAzureADAdministrator:    notFoundAdministrator,
+					MockUpdateAzureADAdministrator: func(_ context.Context, _ *v1alpha3.MSSQLServer) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withAzureADAdministrator("cooladmins", objectID)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateAzureADAdministrator),
+			},
+		},
+		"SuccessfulAzureADAdministrator": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockGetAzureADAdministrator:    notFoundAdministrator,
+					MockUpdateServer:               func(_ context.Context, _ *v1alpha3.MSSQLServer, _ string) error { return errBoom },
+					MockUpdateAzureADAdministrator: func(_ context.Context, _ *v1alpha3.MSSQLServer) error { return nil },
+					MockGetRESTClient:              nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withAzureADAdministrator("cooladmins", objectID)),
+			},
+		},
+		"ReferencedPasswordChanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-password": {"password": []byte(password)},
+						"cool-secret":   {xpv1.ResourceCredentialsSecretPasswordKey: []byte("old")},
+					}),
+				},
+				client: &MockMSSQLServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.MSSQLServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(withPasswordSecretRef("cool-password"), withAdministratorLoginPasswordHash(oldHash)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want error
+	}{
+		"ErrNotAMSSQLServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: errors.New(errNotMSSQLServer),
+		},
+		"DeleteInProgress": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg: server(withLastOperation(azurev1alpha3.AsyncOperation{
+					Method: http.MethodDelete,
+					Status: azure.AsyncOperationStatusInProgress,
+				})),
+			},
+			want: nil,
+		},
+		"ErrDeleteServer": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockDeleteServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: errors.Wrap(errBoom, errDeleteMSSQLServer),
+		},
+		"NotFound": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockDeleteServer: func(_ context.Context, _ *v1alpha3.MSSQLServer) error {
+						return autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: nil,
+		},
+		"Successful": {
+			e: &external{
+				client: &MockMSSQLServerAPI{
+					MockDeleteServer:  func(_ context.Context, _ *v1alpha3.MSSQLServer) error { return nil },
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  server(),
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.e.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
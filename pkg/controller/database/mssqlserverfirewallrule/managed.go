@@ -0,0 +1,146 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlserverfirewallrule
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errNotMSSQLServerFirewallRule    = "managed resource is not an MSSQLServerFirewallRule"
+	errCreateMSSQLServerFirewallRule = "cannot create MSSQLServerFirewallRule"
+	errUpdateMSSQLServerFirewallRule = "cannot update MSSQLServerFirewallRule"
+	errGetMSSQLServerFirewallRule    = "cannot get MSSQLServerFirewallRule"
+	errDeleteMSSQLServerFirewallRule = "cannot delete MSSQLServerFirewallRule"
+)
+
+// Setup adds a controller that reconciles MSSQLServerFirewallRules.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.MSSQLServerFirewallRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.MSSQLServerFirewallRule{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.MSSQLServerFirewallRuleList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MSSQLServerFirewallRuleGroupVersionKind),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := sql.NewFirewallRulesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client sqlapi.FirewallRulesClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	v, ok := mg.(*v1alpha3.MSSQLServerFirewallRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMSSQLServerFirewallRule)
+	}
+
+	az, err := e.client.Get(ctx, v.Spec.ForProvider.ResourceGroupName, v.Spec.ForProvider.ServerName, meta.GetExternalName(v))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMSSQLServerFirewallRule)
+	}
+
+	v.Status.AtProvider.ID = azure.ToString(az.ID)
+	v.Status.AtProvider.Type = azure.ToString(az.Type)
+	v.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.MSSQLServerFirewallRuleIsUpToDate(v, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	r, ok := mg.(*v1alpha3.MSSQLServerFirewallRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMSSQLServerFirewallRule)
+	}
+
+	r.SetConditions(xpv1.Creating())
+	p := database.NewMSSQLFirewallRuleParameters(r)
+	_, err := e.client.CreateOrUpdate(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.ServerName, meta.GetExternalName(r), p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateMSSQLServerFirewallRule)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	r, ok := mg.(*v1alpha3.MSSQLServerFirewallRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMSSQLServerFirewallRule)
+	}
+
+	p := database.NewMSSQLFirewallRuleParameters(r)
+	_, err := e.client.CreateOrUpdate(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.ServerName, meta.GetExternalName(r), p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMSSQLServerFirewallRule)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	r, ok := mg.(*v1alpha3.MSSQLServerFirewallRule)
+	if !ok {
+		return errors.New(errNotMSSQLServerFirewallRule)
+	}
+
+	r.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.ServerName, meta.GetExternalName(r))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteMSSQLServerFirewallRule)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlservervirtualnetworkrule
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errNotMSSQLServerVirtualNetworkRule    = "managed resource is not an MSSQLServerVirtualNetworkRule"
+	errCreateMSSQLServerVirtualNetworkRule = "cannot create MSSQLServerVirtualNetworkRule"
+	errUpdateMSSQLServerVirtualNetworkRule = "cannot update MSSQLServerVirtualNetworkRule"
+	errGetMSSQLServerVirtualNetworkRule    = "cannot get MSSQLServerVirtualNetworkRule"
+	errDeleteMSSQLServerVirtualNetworkRule = "cannot delete MSSQLServerVirtualNetworkRule"
+)
+
+// Setup adds a controller that reconciles MSSQLServerVirtualNetworkRules.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.MSSQLServerVirtualNetworkRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.MSSQLServerVirtualNetworkRule{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.MSSQLServerVirtualNetworkRuleList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MSSQLServerVirtualNetworkRuleGroupVersionKind),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	cl := sql.NewVirtualNetworkRulesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client sqlapi.VirtualNetworkRulesClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	v, ok := mg.(*v1alpha3.MSSQLServerVirtualNetworkRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMSSQLServerVirtualNetworkRule)
+	}
+
+	az, err := e.client.Get(ctx, v.Spec.ResourceGroupName, v.Spec.ServerName, meta.GetExternalName(v))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMSSQLServerVirtualNetworkRule)
+	}
+
+	database.UpdateMSSQLVirtualNetworkRuleStatusFromAzure(v, az)
+
+	v.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceUpToDate:  !database.MSSQLServerVirtualNetworkRuleNeedsUpdate(v, az),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	v, ok := mg.(*v1alpha3.MSSQLServerVirtualNetworkRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMSSQLServerVirtualNetworkRule)
+	}
+
+	v.SetConditions(xpv1.Creating())
+
+	vnet := database.NewMSSQLVirtualNetworkRuleParameters(v)
+	_, err := e.client.CreateOrUpdate(ctx, v.Spec.ResourceGroupName, v.Spec.ServerName, meta.GetExternalName(v), vnet)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateMSSQLServerVirtualNetworkRule)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	v, ok := mg.(*v1alpha3.MSSQLServerVirtualNetworkRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMSSQLServerVirtualNetworkRule)
+	}
+
+	vnet := database.NewMSSQLVirtualNetworkRuleParameters(v)
+	_, err := e.client.CreateOrUpdate(ctx, v.Spec.ResourceGroupName, v.Spec.ServerName, meta.GetExternalName(v), vnet)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMSSQLServerVirtualNetworkRule)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	v, ok := mg.(*v1alpha3.MSSQLServerVirtualNetworkRule)
+	if !ok {
+		return errors.New(errNotMSSQLServerVirtualNetworkRule)
+	}
+
+	v.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.Delete(ctx, v.Spec.ResourceGroupName, v.Spec.ServerName, meta.GetExternalName(v))
+
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteMSSQLServerVirtualNetworkRule)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqldatabase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql/mysqlapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errUpdateCR            = "cannot update MySQLDatabase custom resource"
+	errNotMySQLDatabase    = "managed resource is not a MySQLDatabase"
+	errCreateMySQLDatabase = "cannot create MySQLDatabase"
+	errGetMySQLDatabase    = "cannot get MySQLDatabase"
+	errGetServer           = "cannot get MySQLDatabase server"
+	errDeleteMySQLDatabase = "cannot delete MySQLDatabase"
+)
+
+// Setup adds a controller that reconciles MySQLDatabases.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.MySQLDatabaseGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.MySQLDatabase{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.MySQLDatabaseList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MySQLDatabaseGroupVersionKind),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := mysql.NewDatabasesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	sv := mysql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	sv.Authorizer = auth
+	return &external{kube: c.client, client: cl, servers: sv}, nil
+}
+
+type external struct {
+	kube    client.Client
+	client  mysqlapi.DatabasesClientAPI
+	servers mysqlapi.ServersClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	d, ok := mg.(*v1alpha3.MySQLDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMySQLDatabase)
+	}
+
+	az, err := e.client.Get(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMySQLDatabase)
+	}
+
+	srv, err := e.servers.Get(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetServer)
+	}
+
+	current := d.Spec.ForProvider.DeepCopy()
+	database.LateInitializeMySQLDatabase(&d.Spec.ForProvider, az)
+	if !reflect.DeepEqual(current, &d.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, d); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	d.Status.AtProvider.ID = azure.ToString(az.ID)
+	d.Status.AtProvider.Type = azure.ToString(az.Type)
+	d.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists: true,
+		// A database's charset and collation can't be changed, so there is
+		// nothing to update once it exists.
+		ResourceUpToDate: true,
+		// The password of the server's administrator is only published to
+		// the server's connection secret.
+		ConnectionDetails: managed.ConnectionDetails{
+			database.ConnectionSecretDatabaseKey: []byte(meta.GetExternalName(d)),
+		},
+	}
+	if srv.ServerProperties != nil {
+		o.ConnectionDetails[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(azure.ToString(srv.FullyQualifiedDomainName))
+		o.ConnectionDetails[xpv1.ResourceCredentialsSecretUserKey] = []byte(fmt.Sprintf("%s@%s", azure.ToString(srv.AdministratorLogin), d.Spec.ForProvider.ServerName))
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	d, ok := mg.(*v1alpha3.MySQLDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMySQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Creating())
+	p := database.NewMySQLDatabaseParameters(d)
+	_, err := e.client.CreateOrUpdate(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d), p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateMySQLDatabase)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, ok := mg.(*v1alpha3.MySQLDatabase)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMySQLDatabase)
+	}
+
+	// Databases are always up to date; see Observe.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	d, ok := mg.(*v1alpha3.MySQLDatabase)
+	if !ok {
+		return errors.New(errNotMySQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteMySQLDatabase)
+}
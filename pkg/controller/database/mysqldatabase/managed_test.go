@@ -0,0 +1,512 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqldatabase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolDatabase"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	serverFQDN        = "coolSrv.example.org"
+	adminLogin        = "coolAdmin"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	charset           = "utf8"
+	collation         = "utf8_general_ci"
+)
+
+type databaseModifier func(*v1alpha3.MySQLDatabase)
+
+func withConditions(c ...xpv1.Condition) databaseModifier {
+	return func(r *v1alpha3.MySQLDatabase) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withType(s string) databaseModifier {
+	return func(r *v1alpha3.MySQLDatabase) { r.Status.AtProvider.Type = s }
+}
+
+func withID(s string) databaseModifier {
+	return func(r *v1alpha3.MySQLDatabase) { r.Status.AtProvider.ID = s }
+}
+
+func withCharset(s string) databaseModifier {
+	return func(r *v1alpha3.MySQLDatabase) { r.Spec.ForProvider.Charset = azure.ToStringPtr(s) }
+}
+
+func withCollation(s string) databaseModifier {
+	return func(r *v1alpha3.MySQLDatabase) { r.Spec.ForProvider.Collation = azure.ToStringPtr(s) }
+}
+
+func db(sm ...databaseModifier) *v1alpha3.MySQLDatabase {
+	r := &v1alpha3.MySQLDatabase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.DatabaseSpec{
+			ForProvider: v1alpha3.DatabaseParameters{
+				ServerName:        serverName,
+				ResourceGroupName: resourceGroupName,
+			},
+		},
+	}
+
+	meta.SetExternalName(r, name)
+
+	for _, m := range sm {
+		m(r)
+	}
+
+	return r
+}
+
+func servers(err error) *fake.MockMySQLServersClient {
+	return &fake.MockMySQLServersClient{
+		MockGet: func(_ context.Context, _ string, _ string) (mysql.Server, error) {
+			return mysql.Server{
+				ServerProperties: &mysql.ServerProperties{
+					AdministratorLogin:       azure.ToStringPtr(adminLogin),
+					FullyQualifiedDomainName: azure.ToStringPtr(serverFQDN),
+				},
+			}, err
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMySQLDatabase": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotMySQLDatabase),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (mysql.Database, error) {
+					return mysql.Database{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(),
+			},
+		},
+		"SuccessfulObserveExists": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMySQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (mysql.Database, error) {
+						return mysql.Database{
+							ID:   azure.ToStringPtr(resourceID),
+							Type: azure.ToStringPtr(resourceType),
+							DatabaseProperties: &mysql.DatabaseProperties{
+								Charset:   azure.ToStringPtr(charset),
+								Collation: azure.ToStringPtr(collation),
+							},
+						}, nil
+					},
+				},
+				servers: servers(nil),
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Available()),
+					withCharset(charset),
+					withCollation(collation),
+					withType(resourceType),
+					withID(resourceID),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey:      []byte(name),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(serverFQDN),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", adminLogin, serverName)),
+					},
+				},
+			},
+		},
+		"NoLateInitialization": {
+			ec: &external{
+				client: &fake.MockMySQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (mysql.Database, error) {
+						return mysql.Database{
+							DatabaseProperties: &mysql.DatabaseProperties{
+								Charset:   azure.ToStringPtr(charset),
+								Collation: azure.ToStringPtr(collation),
+							},
+						}, nil
+					},
+				},
+				servers: servers(nil),
+			},
+			args: args{
+				mg: db(withCharset(charset), withCollation(collation)),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Available()),
+					withCharset(charset),
+					withCollation(collation),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey:      []byte(name),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(serverFQDN),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", adminLogin, serverName)),
+					},
+				},
+			},
+		},
+		"ErrGetServer": {
+			ec: &external{
+				client: &fake.MockMySQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (mysql.Database, error) {
+						return mysql.Database{}, nil
+					},
+				},
+				servers: servers(errBoom),
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetServer),
+			},
+		},
+		"FailedUpdateCR": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockMySQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (mysql.Database, error) {
+						return mysql.Database{DatabaseProperties: &mysql.DatabaseProperties{Charset: azure.ToStringPtr(charset)}}, nil
+					},
+				},
+				servers: servers(nil),
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(withCharset(charset)),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (mysql.Database, error) {
+					return mysql.Database{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetMySQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMySQLDatabase": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotMySQLDatabase),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ mysql.Database) (mysql.DatabasesCreateOrUpdateFuture, error) {
+					return mysql.DatabasesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreateMySQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, n string, p mysql.Database) (mysql.DatabasesCreateOrUpdateFuture, error) {
+					if n != name || azure.ToString(p.Charset) != charset || azure.ToString(p.Collation) != collation {
+						return mysql.DatabasesCreateOrUpdateFuture{}, errBoom
+					}
+					return mysql.DatabasesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: db(withCharset(charset), withCollation(collation)),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Creating()),
+					withCharset(charset),
+					withCollation(collation),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMySQLDatabase": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotMySQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotMySQLDatabase": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotMySQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (mysql.DatabasesDeleteFuture, error) {
+					return mysql.DatabasesDeleteFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (mysql.DatabasesDeleteFuture, error) {
+					return mysql.DatabasesDeleteFuture{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockMySQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (mysql.DatabasesDeleteFuture, error) {
+					return mysql.DatabasesDeleteFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteMySQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlflexibleserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/database/mysqlflexible"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errUpdateCR                  = "cannot update MySQLFlexibleServer custom resource"
+	errGenPassword               = "cannot generate admin password"
+	errNotMySQLFlexibleServer    = "managed resource is not a MySQLFlexibleServer"
+	errCreateMySQLFlexibleServer = "cannot create MySQLFlexibleServer"
+	errUpdateMySQLFlexibleServer = "cannot update MySQLFlexibleServer"
+	errGetMySQLFlexibleServer    = "cannot get MySQLFlexibleServer"
+	errDeleteMySQLFlexibleServer = "cannot delete MySQLFlexibleServer"
+	errFetchLastOperation        = "cannot fetch last operation"
+)
+
+// Setup adds a controller that reconciles MySQLFlexibleServers.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.MySQLFlexibleServerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.MySQLFlexibleServer{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.MySQLFlexibleServerList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MySQLFlexibleServerGroupVersionKind),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := mysqlflexible.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: database.NewMySQLFlexibleServerClient(cl), newPasswordFn: password.Generate}, nil
+}
+
+type external struct {
+	kube          client.Client
+	client        database.MySQLFlexibleServerAPI
+	newPasswordFn func() (password string, err error)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.MySQLFlexibleServer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMySQLFlexibleServer)
+	}
+	server, err := e.client.GetServer(ctx, cr)
+	if azure.IsNotFound(err) {
+		if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+		// Azure returns NotFound for GET calls until creation is completed,
+		// so we check whether a creation operation is in fact in motion.
+		creating := cr.Status.AtProvider.LastOperation.Method == http.MethodPut &&
+			cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress
+		return managed.ExternalObservation{ResourceExists: creating}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMySQLFlexibleServer)
+	}
+	database.LateInitializeMySQLFlexibleServer(&cr.Spec.ForProvider, server)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+	database.UpdateMySQLFlexibleServerObservation(&cr.Status.AtProvider, server)
+	// We make this call after kube.Update since it doesn't update the
+	// status subresource but fetches the the whole object after it's done. So,
+	// changes to status has to be done after kube.Update in order not to get them
+	// lost.
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	// Any state beside 'Ready' is considered unavailable.
+	switch cr.Status.AtProvider.State {
+	case v1alpha3.FlexibleServerStateReady:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	pw, err := e.changedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.IsMySQLFlexibleServerUpToDate(cr.Spec.ForProvider, server) && pw == "",
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.FullyQualifiedDomainName),
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.ForProvider.AdministratorLogin),
+		},
+	}
+
+	// Azure has applied the new password, so we publish it. It's no longer
+	// pending once it has been published, which happens when the server is
+	// next updated.
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusSucceeded && cr.GetWriteConnectionSecretToReference() != nil {
+		pw, err := database.GetPendingPassword(ctx, e.kube, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if pw != "" {
+			o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+			o.ResourceUpToDate = false
+		}
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.MySQLFlexibleServer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMySQLFlexibleServer)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	// Replicas and restored servers keep the administrator login and password
+	// of their source server.
+	pw := ""
+	if !database.IsMySQLFlexibleServerCopy(cr.Spec.ForProvider) {
+		var err error
+		if pw, err = e.password(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+	if err := e.client.CreateServer(ctx, cr, pw); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMySQLFlexibleServer)
+	}
+	if pw != "" && cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef != nil {
+		h, err := database.HashPassword(pw)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		cr.Status.AtProvider.AdministratorLoginPasswordHash = h
+	}
+
+	ec := managed.ExternalCreation{}
+	if pw != "" {
+		ec.ConnectionDetails = managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}
+	}
+	return ec, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.MySQLFlexibleServer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMySQLFlexibleServer)
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusSucceeded && cr.GetWriteConnectionSecretToReference() != nil {
+		pw, err := database.GetPendingPassword(ctx, e.kube, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if pw != "" {
+			// The new password was published when the server was observed.
+			return managed.ExternalUpdate{}, database.DeletePendingPassword(ctx, e.kube, cr)
+		}
+	}
+	pw, err := e.changedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// We store the new password before sending it to Azure so that it isn't
+	// lost if we fail to record that we did. It's published once Azure has
+	// applied it.
+	if pw != "" && cr.GetWriteConnectionSecretToReference() != nil {
+		if err := database.StorePendingPassword(ctx, e.kube, cr, v1alpha3.MySQLFlexibleServerGroupVersionKind, pw); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if err := e.client.UpdateServer(ctx, cr, pw); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMySQLFlexibleServer)
+	}
+	if pw != "" {
+		h, err := database.HashPassword(pw)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		cr.Status.AtProvider.AdministratorLoginPasswordHash = h
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+// password returns the administrator login password the supplied flexible
+// server should be created with; either the referenced password or a new one.
+func (e *external) password(ctx context.Context, cr *v1alpha3.MySQLFlexibleServer) (string, error) {
+	if ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef; ref != nil {
+		return database.GetPassword(ctx, e.kube, *ref)
+	}
+	pw, err := e.newPasswordFn()
+	return pw, errors.Wrap(err, errGenPassword)
+}
+
+// changedPassword returns the administrator login password referenced by the
+// supplied flexible server if it is not the password that was last sent to
+// Azure, or if Azure failed to apply it. It returns an empty string otherwise.
+func (e *external) changedPassword(ctx context.Context, cr *v1alpha3.MySQLFlexibleServer) (string, error) {
+	ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef
+	if ref == nil {
+		return "", nil
+	}
+	want, err := database.GetPassword(ctx, e.kube, *ref)
+	if err != nil {
+		return "", err
+	}
+	if database.IsPasswordHashed(cr.Status.AtProvider.AdministratorLoginPasswordHash, want) &&
+		!database.IsPasswordChangeFailed(cr.Status.AtProvider.LastOperation) {
+		return "", nil
+	}
+	return want, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.MySQLFlexibleServer)
+	if !ok {
+		return errors.New(errNotMySQLFlexibleServer)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha3.FlexibleServerStateDropping {
+		return nil
+	}
+	if err := e.client.DeleteServer(ctx, cr); resource.Ignore(azure.IsNotFound, err) != nil {
+		return errors.Wrap(err, errDeleteMySQLFlexibleServer)
+	}
+	return errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
@@ -19,56 +19,61 @@ package mysqlserver
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
-	"k8s.io/client-go/util/workqueue"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
-	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/password"
-	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
 )
 
 // Error strings.
 const (
-	errUpdateCR           = "cannot update MySQLServer custom resource"
-	errGenPassword        = "cannot generate admin password"
-	errNotMySQLServer     = "managed resource is not a MySQLServer"
-	errCreateMySQLServer  = "cannot create MySQLServer"
-	errUpdateMySQLServer  = "cannot update MySQLServer"
-	errGetMySQLServer     = "cannot get MySQLServer"
-	errDeleteMySQLServer  = "cannot delete MySQLServer"
-	errFetchLastOperation = "cannot fetch last operation"
+	errUpdateCR                   = "cannot update MySQLServer custom resource"
+	errGenPassword                = "cannot generate admin password"
+	errNotMySQLServer             = "managed resource is not a MySQLServer"
+	errCreateMySQLServer          = "cannot create MySQLServer"
+	errUpdateMySQLServer          = "cannot update MySQLServer"
+	errGetMySQLServer             = "cannot get MySQLServer"
+	errDeleteMySQLServer          = "cannot delete MySQLServer"
+	errFetchLastOperation         = "cannot fetch last operation"
+	errRotatePassword             = "cannot rotate MySQLServer administrator login password"
+	errGetAzureADAdministrator    = "cannot get Azure AD administrator of MySQLServer"
+	errUpdateAzureADAdministrator = "cannot update Azure AD administrator of MySQLServer"
 )
 
 // Setup adds a controller that reconciles MySQLServers.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func Setup(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.MySQLServerGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
-		}).
+		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.MySQLServer{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1beta1.MySQLServerList{})).
+		WithEventFilter(o.Predicate).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.MySQLServerGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
@@ -81,9 +86,11 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := mysql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
-	cl.Authorizer = auth
-	return &external{kube: c.client, client: database.NewMySQLServerClient(cl), newPasswordFn: password.Generate}, nil
+	servers := mysql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	servers.Authorizer = auth
+	admins := mysql.NewServerAdministratorsClient(creds[azure.CredentialsKeySubscriptionID])
+	admins.Authorizer = auth
+	return &external{kube: c.client, client: database.NewMySQLServerClient(servers, admins), newPasswordFn: password.Generate}, nil
 }
 
 type external struct {
@@ -114,6 +121,15 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetMySQLServer)
 	}
+	// We only observe the Azure AD administrator when one is specified. An
+	// administrator that is not specified is left as is.
+	var admin mysql.ServerAdministratorResource
+	if cr.Spec.ForProvider.AzureADAdministrator != nil {
+		admin, err = e.client.GetAzureADAdministrator(ctx, cr)
+		if resource.Ignore(azure.IsNotFound, err) != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAzureADAdministrator)
+		}
+	}
 	database.LateInitializeMySQL(&cr.Spec.ForProvider, server)
 	if err := e.kube.Update(ctx, cr); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
@@ -126,6 +142,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
 	}
+	rotation := &cr.Status.AtProvider.AdministratorLoginPasswordRotation
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &rotation.LastRotation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	database.UpdatePasswordRotationObservation(cr.Spec.ForProvider, rotation, time.Now())
 	switch cr.Status.AtProvider.UserVisibleState {
 	case v1beta1.StateReady:
 		cr.SetConditions(xpv1.Available())
@@ -133,14 +154,37 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		cr.SetConditions(xpv1.Unavailable())
 	}
 
-	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: database.IsMySQLUpToDate(cr.Spec.ForProvider, server),
+	pw, err := e.changedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	o := managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: database.IsMySQLUpToDate(cr.Spec.ForProvider, server) &&
+			database.IsMySQLServerAzureADAdministratorUpToDate(cr.Spec.ForProvider.AzureADAdministrator, admin) &&
+			!isPasswordRotationDue(cr) &&
+			pw == "" &&
+			!database.IsPasswordRotationSucceeded(*rotation),
 		ConnectionDetails: managed.ConnectionDetails{
 			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.FullyQualifiedDomainName),
 			xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", cr.Spec.ForProvider.AdministratorLogin, meta.GetExternalName(cr))),
 		},
-	}, nil
+	}
+
+	// Azure has applied the new password, so we publish it. It's no longer
+	// pending once it has been published.
+	if database.IsPasswordRotationSucceeded(*rotation) && cr.GetWriteConnectionSecretToReference() != nil {
+		pw, err := database.GetPendingPassword(ctx, e.kube, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if pw != "" {
+			o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+		}
+	}
+
+	return o, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -150,21 +194,30 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.SetConditions(xpv1.Creating())
-	pw, err := e.newPasswordFn()
+	pw, err := e.password(ctx, cr)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errGenPassword)
+		return managed.ExternalCreation{}, err
 	}
 	if err := e.client.CreateServer(ctx, cr, pw); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMySQLServer)
 	}
+	if cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef != nil {
+		h, err := database.HashPassword(pw)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		cr.Status.AtProvider.AdministratorLoginPasswordRotation.PasswordHash = h
+	}
+	now := metav1.Now()
+	cr.Status.AtProvider.AdministratorLoginPasswordRotation.LastRotationTime = &now
 
 	return managed.ExternalCreation{
-			ConnectionDetails: managed.ConnectionDetails{
-				xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
-			},
-		}, errors.Wrap(
-			azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
-			errFetchLastOperation)
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -172,9 +225,77 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotMySQLServer)
 	}
-	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+	if isOperationInProgress(cr) {
 		return managed.ExternalUpdate{}, nil
 	}
+
+	rotation := &cr.Status.AtProvider.AdministratorLoginPasswordRotation
+	if database.IsPasswordRotationSucceeded(*rotation) {
+		// The new password was published when the server was observed.
+		if cr.GetWriteConnectionSecretToReference() != nil {
+			if err := database.DeletePendingPassword(ctx, e.kube, cr); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		now := metav1.Now()
+		rotation.LastRotationTime = &now
+		rotation.LastRotation = v1alpha3.AsyncOperation{}
+		database.UpdatePasswordRotationObservation(cr.Spec.ForProvider, rotation, now.Time)
+		return managed.ExternalUpdate{}, nil
+	}
+	pw, err := e.changedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	referenced := pw != ""
+	if pw == "" && isPasswordRotationDue(cr) {
+		if pw, err = e.newPasswordFn(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenPassword)
+		}
+	}
+	if pw != "" {
+		// We store the new password before sending it to Azure so that it
+		// isn't lost if we fail to record that we did.
+		if cr.GetWriteConnectionSecretToReference() != nil {
+			if err := database.StorePendingPassword(ctx, e.kube, cr, v1beta1.MySQLServerGroupVersionKind, pw); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		if err := e.client.RotateAdministratorLoginPassword(ctx, cr, pw); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRotatePassword)
+		}
+		if referenced {
+			h, err := database.HashPassword(pw)
+			if err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+			rotation.PasswordHash = h
+		}
+		return managed.ExternalUpdate{}, errors.Wrap(
+			azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &rotation.LastRotation),
+			errFetchLastOperation)
+	}
+
+	// Azure does not accept concurrent operations on a server, so the Azure AD
+	// administrator is updated separately, before the rest of the server. It
+	// goes first because its update is quick, while a server update such as a
+	// change of SKU may take a long time, and access to the server should not
+	// wait for it.
+	if a := cr.Spec.ForProvider.AzureADAdministrator; a != nil {
+		admin, err := e.client.GetAzureADAdministrator(ctx, cr)
+		if resource.Ignore(azure.IsNotFound, err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGetAzureADAdministrator)
+		}
+		if !database.IsMySQLServerAzureADAdministratorUpToDate(a, admin) {
+			if err := e.client.UpdateAzureADAdministrator(ctx, cr); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAzureADAdministrator)
+			}
+			return managed.ExternalUpdate{}, errors.Wrap(
+				azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
+				errFetchLastOperation)
+		}
+	}
+
 	if err := e.client.UpdateServer(ctx, cr); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMySQLServer)
 	}
@@ -184,6 +305,52 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		errFetchLastOperation)
 }
 
+// isOperationInProgress returns true if any operation the controller started
+// on the supplied MySQL server is still in progress.
+func isOperationInProgress(cr *v1beta1.MySQLServer) bool {
+	return cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress ||
+		cr.Status.AtProvider.AdministratorLoginPasswordRotation.LastRotation.Status == azure.AsyncOperationStatusInProgress
+}
+
+// isPasswordRotationDue returns true if the supplied MySQL server's
+// administrator login password should be rotated now. Passwords are only
+// rotated if they are not supplied by a secret reference, and if there is a
+// connection secret to publish them to.
+func isPasswordRotationDue(cr *v1beta1.MySQLServer) bool {
+	return cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef == nil &&
+		cr.GetWriteConnectionSecretToReference() != nil &&
+		database.IsPasswordRotationDue(cr.Status.AtProvider.AdministratorLoginPasswordRotation, time.Now())
+}
+
+// password returns the administrator login password the supplied MySQL server
+// should be created with; either the referenced password or a new one.
+func (e *external) password(ctx context.Context, cr *v1beta1.MySQLServer) (string, error) {
+	if ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef; ref != nil {
+		return database.GetPassword(ctx, e.kube, *ref)
+	}
+	pw, err := e.newPasswordFn()
+	return pw, errors.Wrap(err, errGenPassword)
+}
+
+// changedPassword returns the administrator login password referenced by the
+// supplied MySQL server if it is not the password that was last sent to
+// Azure, or if Azure failed to apply it. It returns an empty string otherwise.
+func (e *external) changedPassword(ctx context.Context, cr *v1beta1.MySQLServer) (string, error) {
+	ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef
+	if ref == nil {
+		return "", nil
+	}
+	want, err := database.GetPassword(ctx, e.kube, *ref)
+	if err != nil {
+		return "", err
+	}
+	rotation := cr.Status.AtProvider.AdministratorLoginPasswordRotation
+	if database.IsPasswordHashed(rotation.PasswordHash, want) && !database.IsPasswordChangeFailed(rotation.LastRotation) {
+		return "", nil
+	}
+	return want, nil
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1beta1.MySQLServer)
 	if !ok {
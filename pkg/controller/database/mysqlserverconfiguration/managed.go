@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlserverconfiguration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql/mysqlapi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errNotMySQLServerConfiguration    = "managed resource is not a MySQLServerConfiguration"
+	errCreateMySQLServerConfiguration = "cannot create MySQLServerConfiguration"
+	errUpdateMySQLServerConfiguration = "cannot update MySQLServerConfiguration"
+	errGetMySQLServerConfiguration    = "cannot get MySQLServerConfiguration"
+	errDeleteMySQLServerConfiguration = "cannot reset MySQLServerConfiguration to its default value"
+	errFetchLastOperation             = "cannot fetch last operation"
+)
+
+// Setup adds a controller that reconciles MySQLServerConfigurations.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.MySQLServerConfigurationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.MySQLServerConfiguration{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.MySQLServerConfigurationList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MySQLServerConfigurationGroupVersionKind),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := mysql.NewConfigurationsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl, rest: cl.Client}, nil
+}
+
+type external struct {
+	client mysqlapi.ConfigurationsClientAPI
+	rest   autorest.Sender
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha3.MySQLServerConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMySQLServerConfiguration)
+	}
+
+	az, err := e.client.Get(ctx, c.Spec.ForProvider.ResourceGroupName, c.Spec.ForProvider.ServerName, c.Spec.ForProvider.Name)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMySQLServerConfiguration)
+	}
+
+	database.UpdateMySQLConfigurationObservation(&c.Status.AtProvider, az)
+	if err := azure.FetchAsyncOperation(ctx, e.rest, &c.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+
+	// Azure does not report the value being set until the operation that sets
+	// it is completed, so we neither create nor update the configuration while
+	// an operation is in progress.
+	if c.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	// Every configuration exists as long as its server does. We consider a
+	// configuration to exist only while it is overridden, so that we reset it
+	// to its default value when it is deleted.
+	if c.Status.AtProvider.Source == database.ConfigurationSourceSystemDefault {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	c.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.MySQLServerConfigurationIsUpToDate(c, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha3.MySQLServerConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMySQLServerConfiguration)
+	}
+
+	c.SetConditions(xpv1.Creating())
+	p := database.NewMySQLConfigurationParameters(c)
+	op, err := e.client.CreateOrUpdate(ctx, c.Spec.ForProvider.ResourceGroupName, c.Spec.ForProvider.ServerName, c.Spec.ForProvider.Name, p)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMySQLServerConfiguration)
+	}
+	c.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{PollingURL: op.PollingURL(), Method: http.MethodPut}
+	return managed.ExternalCreation{}, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.rest, &c.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha3.MySQLServerConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMySQLServerConfiguration)
+	}
+
+	p := database.NewMySQLConfigurationParameters(c)
+	op, err := e.client.CreateOrUpdate(ctx, c.Spec.ForProvider.ResourceGroupName, c.Spec.ForProvider.ServerName, c.Spec.ForProvider.Name, p)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMySQLServerConfiguration)
+	}
+	c.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{PollingURL: op.PollingURL(), Method: http.MethodPut}
+	return managed.ExternalUpdate{}, errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.rest, &c.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha3.MySQLServerConfiguration)
+	if !ok {
+		return errors.New(errNotMySQLServerConfiguration)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+	if c.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return nil
+	}
+	p := database.NewMySQLDefaultConfigurationParameters()
+	op, err := e.client.CreateOrUpdate(ctx, c.Spec.ForProvider.ResourceGroupName, c.Spec.ForProvider.ServerName, c.Spec.ForProvider.Name, p)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteMySQLServerConfiguration)
+	}
+	c.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{PollingURL: op.PollingURL(), Method: http.MethodPut}
+	return errors.Wrap(
+		azure.FetchAsyncOperation(ctx, e.rest, &c.Status.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
@@ -0,0 +1,515 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresqldatabase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolDatabase"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	serverFQDN        = "coolSrv.example.org"
+	adminLogin        = "coolAdmin"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	charset           = "UTF8"
+	collation         = "English_United States.1252"
+)
+
+type databaseModifier func(*v1alpha3.PostgreSQLDatabase)
+
+func withConditions(c ...xpv1.Condition) databaseModifier {
+	return func(r *v1alpha3.PostgreSQLDatabase) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withType(s string) databaseModifier {
+	return func(r *v1alpha3.PostgreSQLDatabase) { r.Status.AtProvider.Type = s }
+}
+
+func withID(s string) databaseModifier {
+	return func(r *v1alpha3.PostgreSQLDatabase) { r.Status.AtProvider.ID = s }
+}
+
+func withCharset(s string) databaseModifier {
+	return func(r *v1alpha3.PostgreSQLDatabase) { r.Spec.ForProvider.Charset = azure.ToStringPtr(s) }
+}
+
+func withCollation(s string) databaseModifier {
+	return func(r *v1alpha3.PostgreSQLDatabase) { r.Spec.ForProvider.Collation = azure.ToStringPtr(s) }
+}
+
+func db(sm ...databaseModifier) *v1alpha3.PostgreSQLDatabase {
+	r := &v1alpha3.PostgreSQLDatabase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.DatabaseSpec{
+			ForProvider: v1alpha3.DatabaseParameters{
+				ServerName:        serverName,
+				ResourceGroupName: resourceGroupName,
+			},
+		},
+	}
+
+	meta.SetExternalName(r, name)
+
+	for _, m := range sm {
+		m(r)
+	}
+
+	return r
+}
+
+func servers(err error) *fake.MockPostgreSQLServersClient {
+	return &fake.MockPostgreSQLServersClient{
+		MockGet: func(_ context.Context, _ string, _ string) (postgresql.Server, error) {
+			return postgresql.Server{
+				ServerProperties: &postgresql.ServerProperties{
+					AdministratorLogin:       azure.ToStringPtr(adminLogin),
+					FullyQualifiedDomainName: azure.ToStringPtr(serverFQDN),
+				},
+			}, err
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLDatabase": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLDatabase),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+					return postgresql.Database{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(),
+			},
+		},
+		"SuccessfulObserveExists": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPostgreSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+						return postgresql.Database{
+							ID:   azure.ToStringPtr(resourceID),
+							Type: azure.ToStringPtr(resourceType),
+							DatabaseProperties: &postgresql.DatabaseProperties{
+								Charset:   azure.ToStringPtr(charset),
+								Collation: azure.ToStringPtr(collation),
+							},
+						}, nil
+					},
+				},
+				servers: servers(nil),
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Available()),
+					withCharset(charset),
+					withCollation(collation),
+					withType(resourceType),
+					withID(resourceID),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey:      []byte(name),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(serverFQDN),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", adminLogin, serverName)),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+					},
+				},
+			},
+		},
+		"NoLateInitialization": {
+			ec: &external{
+				client: &fake.MockPostgreSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+						return postgresql.Database{
+							DatabaseProperties: &postgresql.DatabaseProperties{
+								Charset:   azure.ToStringPtr(charset),
+								Collation: azure.ToStringPtr(collation),
+							},
+						}, nil
+					},
+				},
+				servers: servers(nil),
+			},
+			args: args{
+				mg: db(withCharset(charset), withCollation(collation)),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Available()),
+					withCharset(charset),
+					withCollation(collation),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey:      []byte(name),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(serverFQDN),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", adminLogin, serverName)),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+					},
+				},
+			},
+		},
+		"ErrGetServer": {
+			ec: &external{
+				client: &fake.MockPostgreSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+						return postgresql.Database{}, nil
+					},
+				},
+				servers: servers(errBoom),
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetServer),
+			},
+		},
+		"FailedUpdateCR": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockPostgreSQLDatabasesClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+						return postgresql.Database{DatabaseProperties: &postgresql.DatabaseProperties{Charset: azure.ToStringPtr(charset)}}, nil
+					},
+				},
+				servers: servers(nil),
+			},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(withCharset(charset)),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+					return postgresql.Database{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetPostgreSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLDatabase": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLDatabase),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ postgresql.Database) (postgresql.DatabasesCreateOrUpdateFuture, error) {
+					return postgresql.DatabasesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreatePostgreSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, n string, p postgresql.Database) (postgresql.DatabasesCreateOrUpdateFuture, error) {
+					if n != name || azure.ToString(p.Charset) != charset || azure.ToString(p.Collation) != collation {
+						return postgresql.DatabasesCreateOrUpdateFuture{}, errBoom
+					}
+					return postgresql.DatabasesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: db(withCharset(charset), withCollation(collation)),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Creating()),
+					withCharset(charset),
+					withCollation(collation),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLDatabase": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLDatabase": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (postgresql.DatabasesDeleteFuture, error) {
+					return postgresql.DatabasesDeleteFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (postgresql.DatabasesDeleteFuture, error) {
+					return postgresql.DatabasesDeleteFuture{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (postgresql.DatabasesDeleteFuture, error) {
+					return postgresql.DatabasesDeleteFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: db(),
+			},
+			want: want{
+				mg: db(
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeletePostgreSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,854 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresqlflexibleserver
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azurev1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/database/postgresqlflexible"
+)
+
+var (
+	_ managed.ExternalClient               = &external{}
+	_ managed.ExternalConnecter            = &connecter{}
+	_ database.PostgreSQLFlexibleServerAPI = &MockPostgreSQLFlexibleServerAPI{}
+)
+
+type MockPostgreSQLFlexibleServerAPI struct {
+	MockGetServer     func(ctx context.Context, s *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error)
+	MockCreateServer  func(ctx context.Context, s *v1alpha3.PostgreSQLFlexibleServer, adminPassword string) error
+	MockDeleteServer  func(ctx context.Context, s *v1alpha3.PostgreSQLFlexibleServer) error
+	MockUpdateServer  func(ctx context.Context, s *v1alpha3.PostgreSQLFlexibleServer, adminPassword string) error
+	MockGetRESTClient func() autorest.Sender
+}
+
+func (m *MockPostgreSQLFlexibleServerAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
+
+func (m *MockPostgreSQLFlexibleServerAPI) GetServer(ctx context.Context, s *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+	return m.MockGetServer(ctx, s)
+}
+
+func (m *MockPostgreSQLFlexibleServerAPI) CreateServer(ctx context.Context, s *v1alpha3.PostgreSQLFlexibleServer, adminPassword string) error {
+	return m.MockCreateServer(ctx, s, adminPassword)
+}
+
+func (m *MockPostgreSQLFlexibleServerAPI) UpdateServer(ctx context.Context, s *v1alpha3.PostgreSQLFlexibleServer, adminPassword string) error {
+	return m.MockUpdateServer(ctx, s, adminPassword)
+}
+
+func (m *MockPostgreSQLFlexibleServerAPI) DeleteServer(ctx context.Context, s *v1alpha3.PostgreSQLFlexibleServer) error {
+	return m.MockDeleteServer(ctx, s)
+}
+
+type modifier func(*v1alpha3.PostgreSQLFlexibleServer)
+
+func withExternalName(name string) modifier {
+	return func(p *v1alpha3.PostgreSQLFlexibleServer) {
+		meta.SetExternalName(p, name)
+	}
+}
+
+func withAdminName(name string) modifier {
+	return func(p *v1alpha3.PostgreSQLFlexibleServer) {
+		p.Spec.ForProvider.AdministratorLogin = name
+	}
+}
+
+func withCreateMode(mode string) modifier {
+	return func(p *v1alpha3.PostgreSQLFlexibleServer) {
+		p.Spec.ForProvider.CreateMode = &mode
+	}
+}
+
+func withState(state string) modifier {
+	return func(p *v1alpha3.PostgreSQLFlexibleServer) {
+		p.Status.AtProvider.State = state
+	}
+}
+
+func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+	return func(p *v1alpha3.PostgreSQLFlexibleServer) {
+		p.Status.AtProvider.LastOperation = op
+	}
+}
+
+func withConnectionSecret(name string) modifier {
+	return func(p *v1alpha3.PostgreSQLFlexibleServer) {
+		p.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "cool-namespace", Name: name})
+	}
+}
+
+func withPasswordSecretRef(name string) modifier {
+	return func(p *v1alpha3.PostgreSQLFlexibleServer) {
+		p.Spec.ForProvider.AdministratorLoginPasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "cool-namespace", Name: name},
+			Key:             "password",
+		}
+	}
+}
+
+// withSecrets returns a MockGetFn that gets the supplied secret data by name.
+func withAdministratorLoginPasswordHash(h string) modifier {
+	return func(s *v1alpha3.PostgreSQLFlexibleServer) {
+		s.Status.AtProvider.AdministratorLoginPasswordHash = h
+	}
+}
+
+func withSecrets(data map[string]map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		d, ok := data[key.Name]
+		if !ok {
+			return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+		}
+		obj.(*corev1.Secret).Data = d
+		return nil
+	}
+}
+
+func flexibleserver(m ...modifier) *v1alpha3.PostgreSQLFlexibleServer {
+	p := &v1alpha3.PostgreSQLFlexibleServer{}
+
+	for _, mod := range m {
+		mod(p)
+	}
+	return p
+}
+
+func nilSender() autorest.Sender {
+	return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+}
+
+const (
+	inProgressResponse = `{"status": "InProgress"}`
+)
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	name := "coolserver"
+	endpoint := "coolazure.example.prg"
+	admin := "cooladmin"
+	password := "verysecure"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAPostgreSQLFlexibleServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotPostgreSQLFlexibleServer),
+			},
+		},
+		"ErrGetServer": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+						return postgresqlflexible.Server{}, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPostgreSQLFlexibleServer),
+			},
+		},
+		"ServerCreating": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+						return postgresqlflexible.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+							return &http.Response{
+								Request:       req,
+								StatusCode:    http.StatusAccepted,
+								Body:          ioutil.NopCloser(strings.NewReader(inProgressResponse)),
+								ContentLength: int64(len([]byte(inProgressResponse))),
+							}, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "crossplane.io"})),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ServerNotFound": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+						return postgresqlflexible.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"ErrUpdateCR": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+						return postgresqlflexible.Server{}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"ServerAvailable": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+						return postgresqlflexible.Server{
+							Sku: &postgresqlflexible.Sku{},
+							ServerProperties: &postgresqlflexible.ServerProperties{
+								State:                    azure.ToStringPtr(v1alpha3.FlexibleServerStateReady),
+								FullyQualifiedDomainName: &endpoint,
+								Storage:                  &postgresqlflexible.Storage{},
+								Backup:                   &postgresqlflexible.Backup{},
+							}}, nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: flexibleserver(
+					withExternalName(name),
+					withAdminName(admin),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+					},
+				},
+			},
+		},
+		"PendingPasswordPublished": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-secret-pending-password": {xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+						return postgresqlflexible.Server{
+							Sku: &postgresqlflexible.Sku{},
+							ServerProperties: &postgresqlflexible.ServerProperties{
+								State:                    azure.ToStringPtr(v1alpha3.FlexibleServerStateReady),
+								FullyQualifiedDomainName: &endpoint,
+								Storage:                  &postgresqlflexible.Storage{},
+								Backup:                   &postgresqlflexible.Backup{},
+							}}, nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: flexibleserver(
+					withExternalName(name),
+					withAdminName(admin),
+					withConnectionSecret("cool-secret"),
+					withLastOperation(azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded}),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+					},
+				},
+			},
+		},
+		"ReferencedPasswordChanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-password": {"password": []byte(password)},
+						"cool-secret":   {xpv1.ResourceCredentialsSecretPasswordKey: []byte("old")},
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) (postgresqlflexible.Server, error) {
+						return postgresqlflexible.Server{
+							Sku: &postgresqlflexible.Sku{},
+							ServerProperties: &postgresqlflexible.ServerProperties{
+								State:                    azure.ToStringPtr(v1alpha3.FlexibleServerStateReady),
+								FullyQualifiedDomainName: &endpoint,
+								Storage:                  &postgresqlflexible.Storage{},
+								Backup:                   &postgresqlflexible.Backup{},
+							}}, nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: flexibleserver(
+					withExternalName(name),
+					withAdminName(admin),
+					withConnectionSecret("cool-secret"),
+					withPasswordSecretRef("cool-password"),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eo, err := tc.e.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		ec  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAPostgreSQLFlexibleServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotPostgreSQLFlexibleServer),
+			},
+		},
+		"ErrGeneratePassword": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return "", errBoom },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGenPassword),
+			},
+		},
+		"ErrCreateServer": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, _ string) error { return errBoom },
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreatePostgreSQLFlexibleServer),
+			},
+		},
+		"Successful": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockCreateServer:  func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, _ string) error { return nil },
+					MockGetRESTClient: nilSender,
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
+		"SuccessfulWithReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withPasswordSecretRef("cool-password")),
+			},
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
+		"SuccessfulPointInTimeRestore": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, pw string) error {
+						if pw != "" {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+				newPasswordFn: func() (string, error) { return "", errBoom },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withCreateMode(v1alpha3.FlexibleServerCreateModePointInTimeRestore)),
+			},
+			want: want{
+				ec: managed.ExternalCreation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ec, err := tc.e.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ec, ec); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+	hash, _ := database.HashPassword(password)
+	oldHash, _ := database.HashPassword("old")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eu  managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAPostgreSQLFlexibleServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotPostgreSQLFlexibleServer),
+			},
+		},
+		"OperationInProgress": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withLastOperation(azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusInProgress})),
+			},
+		},
+		"ErrDeletePendingPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-secret-pending-password": {xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+					}),
+					MockDelete: test.NewMockDeleteFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: flexibleserver(
+					withConnectionSecret("cool-secret"),
+					withLastOperation(azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded}),
+				),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot delete pending administrator login password"),
+			},
+		},
+		"PendingPasswordPublished": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-secret-pending-password": {xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+					}),
+					MockDelete: test.NewMockDeleteFn(nil),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, _ string) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: flexibleserver(
+					withConnectionSecret("cool-secret"),
+					withLastOperation(azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded}),
+				),
+			},
+		},
+		"ErrStorePendingPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if key.Name == "cool-password" {
+							obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte(password)}
+							return nil
+						}
+						return errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withConnectionSecret("cool-secret"), withPasswordSecretRef("cool-password")),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get object"), "cannot store pending administrator login password"),
+			},
+		},
+		"ErrGetReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withConnectionSecret("cool-secret"), withPasswordSecretRef("cool-password")),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get administrator login password"),
+			},
+		},
+		"ErrUpdateServer": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, _ string) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdatePostgreSQLFlexibleServer),
+			},
+		},
+		"Successful": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, pw string) error {
+						if pw != "" {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+		},
+		"ReferencedPasswordChanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-password": {"password": []byte(password)},
+						"cool-secret":   {xpv1.ResourceCredentialsSecretPasswordKey: []byte("old")},
+					}),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withConnectionSecret("cool-secret"), withPasswordSecretRef("cool-password")),
+			},
+		},
+		"ReferencedPasswordChangedWithoutConnectionSecret": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withPasswordSecretRef("cool-password"), withAdministratorLoginPasswordHash(oldHash)),
+			},
+		},
+		"ReferencedPasswordUnchanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer, pw string) error {
+						if pw != "" {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withPasswordSecretRef("cool-password"), withAdministratorLoginPasswordHash(hash)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want error
+	}{
+		"ErrNotAPostgreSQLFlexibleServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: errors.New(errNotPostgreSQLFlexibleServer),
+		},
+		"AlreadyDropping": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(withState(v1alpha3.FlexibleServerStateDropping)),
+			},
+			want: nil,
+		},
+		"ErrDeleteServer": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockDeleteServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: errors.Wrap(errBoom, errDeletePostgreSQLFlexibleServer),
+		},
+		"NotFound": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockDeleteServer: func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) error {
+						return autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: nil,
+		},
+		"Successful": {
+			e: &external{
+				client: &MockPostgreSQLFlexibleServerAPI{
+					MockDeleteServer:  func(_ context.Context, _ *v1alpha3.PostgreSQLFlexibleServer) error { return nil },
+					MockGetRESTClient: nilSender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  flexibleserver(),
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.e.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
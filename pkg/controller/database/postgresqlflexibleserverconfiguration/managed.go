@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresqlflexibleserverconfiguration
+
+import (
+	"context"
+
+	"github.com/crossplane/provider-azure/pkg/clients/database/postgresqlflexible"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errNotPostgreSQLFlexibleServerConfiguration    = "managed resource is not a PostgreSQLFlexibleServerConfiguration"
+	errCreatePostgreSQLFlexibleServerConfiguration = "cannot create PostgreSQLFlexibleServerConfiguration"
+	errUpdatePostgreSQLFlexibleServerConfiguration = "cannot update PostgreSQLFlexibleServerConfiguration"
+	errGetPostgreSQLFlexibleServerConfiguration    = "cannot get PostgreSQLFlexibleServerConfiguration"
+	errDeletePostgreSQLFlexibleServerConfiguration = "cannot reset PostgreSQLFlexibleServerConfiguration to its default value"
+)
+
+// Setup adds a controller that reconciles PostgreSQLFlexibleServerConfigurations.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.PostgreSQLFlexibleServerConfigurationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.PostgreSQLFlexibleServerConfiguration{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.PostgreSQLFlexibleServerConfigurationList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.PostgreSQLFlexibleServerConfigurationGroupVersionKind),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := postgresqlflexible.NewConfigurationsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client postgresqlflexible.ConfigurationsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha3.PostgreSQLFlexibleServerConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPostgreSQLFlexibleServerConfiguration)
+	}
+
+	az, err := e.client.Get(ctx, c.Spec.ForProvider.ResourceGroupName, c.Spec.ForProvider.ServerName, c.Spec.ForProvider.Name)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPostgreSQLFlexibleServerConfiguration)
+	}
+
+	database.UpdatePostgreSQLFlexibleConfigurationObservation(&c.Status.AtProvider, az)
+
+	// Every configuration exists as long as its server does. We consider a
+	// configuration to exist only while it is overridden, so that we reset it
+	// to its default value when it is deleted.
+	if c.Status.AtProvider.Source == database.ConfigurationSourceSystemDefault {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	c.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.PostgreSQLFlexibleServerConfigurationIsUpToDate(c, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha3.PostgreSQLFlexibleServerConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPostgreSQLFlexibleServerConfiguration)
+	}
+
+	c.SetConditions(xpv1.Creating())
+	p := database.NewPostgreSQLFlexibleConfigurationParameters(c)
+	_, err := e.client.Put(ctx, c.Spec.ForProvider.ResourceGroupName, c.Spec.ForProvider.ServerName, c.Spec.ForProvider.Name, p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePostgreSQLFlexibleServerConfiguration)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha3.PostgreSQLFlexibleServerConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPostgreSQLFlexibleServerConfiguration)
+	}
+
+	p := database.NewPostgreSQLFlexibleConfigurationParameters(c)
+	_, err := e.client.Put(ctx, c.Spec.ForProvider.ResourceGroupName, c.Spec.ForProvider.ServerName, c.Spec.ForProvider.Name, p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePostgreSQLFlexibleServerConfiguration)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha3.PostgreSQLFlexibleServerConfiguration)
+	if !ok {
+		return errors.New(errNotPostgreSQLFlexibleServerConfiguration)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+	p := database.NewPostgreSQLFlexibleDefaultConfigurationParameters()
+	_, err := e.client.Put(ctx, c.Spec.ForProvider.ResourceGroupName, c.Spec.ForProvider.ServerName, c.Spec.ForProvider.Name, p)
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeletePostgreSQLFlexibleServerConfiguration)
+}
@@ -0,0 +1,457 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresqlflexibleserverconfiguration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	azureautorest "github.com/Azure/go-autorest/autorest/azure"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/database/postgresqlflexible"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolConfiguration"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	configurationName = "max_connections"
+	value             = "100"
+	defaultValue      = "50"
+)
+
+type configurationModifier func(*v1alpha3.PostgreSQLFlexibleServerConfiguration)
+
+func withConditions(c ...xpv1.Condition) configurationModifier {
+	return func(r *v1alpha3.PostgreSQLFlexibleServerConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(v, source string) configurationModifier {
+	return func(r *v1alpha3.PostgreSQLFlexibleServerConfiguration) {
+		r.Status.AtProvider.Value = v
+		r.Status.AtProvider.DefaultValue = defaultValue
+		r.Status.AtProvider.Source = source
+	}
+}
+
+func configuration(sm ...configurationModifier) *v1alpha3.PostgreSQLFlexibleServerConfiguration {
+	r := &v1alpha3.PostgreSQLFlexibleServerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.ConfigurationSpec{
+			ForProvider: v1alpha3.ConfigurationParameters{
+				ServerName:        serverName,
+				ResourceGroupName: resourceGroupName,
+				Name:              configurationName,
+				Value:             value,
+			},
+		},
+	}
+
+	for _, m := range sm {
+		m(r)
+	}
+
+	return r
+}
+
+func azConfiguration(v, source string) postgresqlflexible.Configuration {
+	return postgresqlflexible.Configuration{
+		ConfigurationProperties: &postgresqlflexible.ConfigurationProperties{
+			Value:        azure.ToStringPtr(v),
+			DefaultValue: azure.ToStringPtr(defaultValue),
+			Source:       azure.ToStringPtr(source),
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLFlexibleServerConfiguration": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLFlexibleServerConfiguration),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresqlflexible.Configuration, error) {
+					return postgresqlflexible.Configuration{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(),
+			},
+		},
+		"SuccessfulObserveSystemDefault": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresqlflexible.Configuration, error) {
+					return azConfiguration(defaultValue, database.ConfigurationSourceSystemDefault), nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(withObservation(defaultValue, database.ConfigurationSourceSystemDefault)),
+			},
+		},
+		"SuccessfulObserveUpToDate": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresqlflexible.Configuration, error) {
+					return azConfiguration(value, database.ConfigurationSourceUserOverride), nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Available()),
+					withObservation(value, database.ConfigurationSourceUserOverride),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SuccessfulObserveNotUpToDate": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresqlflexible.Configuration, error) {
+					return azConfiguration("200", database.ConfigurationSourceUserOverride), nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Available()),
+					withObservation("200", database.ConfigurationSourceUserOverride),
+				),
+				o: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresqlflexible.Configuration, error) {
+					return postgresqlflexible.Configuration{}, errBoom
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg:  configuration(),
+				err: errors.Wrap(errBoom, errGetPostgreSQLFlexibleServerConfiguration),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLFlexibleServerConfiguration": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLFlexibleServerConfiguration),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockPut: func(_ context.Context, _ string, _ string, _ string, _ postgresqlflexible.Configuration) (azureautorest.Future, error) {
+					return azureautorest.Future{}, errBoom
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreatePostgreSQLFlexibleServerConfiguration),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockPut: func(_ context.Context, _ string, _ string, n string, p postgresqlflexible.Configuration) (azureautorest.Future, error) {
+					if n != configurationName || azure.ToString(p.Value) != value || azure.ToString(p.Source) != database.ConfigurationSourceUserOverride {
+						return azureautorest.Future{}, errBoom
+					}
+					return azureautorest.Future{}, nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLFlexibleServerConfiguration": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLFlexibleServerConfiguration),
+			},
+		},
+		"UpdateError": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockPut: func(_ context.Context, _ string, _ string, _ string, _ postgresqlflexible.Configuration) (azureautorest.Future, error) {
+					return azureautorest.Future{}, errBoom
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg:  configuration(),
+				err: errors.Wrap(errBoom, errUpdatePostgreSQLFlexibleServerConfiguration),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockPut: func(_ context.Context, _ string, _ string, _ string, _ postgresqlflexible.Configuration) (azureautorest.Future, error) {
+					return azureautorest.Future{}, nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLFlexibleServerConfiguration": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLFlexibleServerConfiguration),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockPut: func(_ context.Context, _ string, _ string, _ string, p postgresqlflexible.Configuration) (azureautorest.Future, error) {
+					if azure.ToString(p.Source) != database.ConfigurationSourceSystemDefault {
+						return azureautorest.Future{}, errBoom
+					}
+					return azureautorest.Future{}, nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockPut: func(_ context.Context, _ string, _ string, _ string, _ postgresqlflexible.Configuration) (azureautorest.Future, error) {
+					return azureautorest.Future{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockPostgreSQLFlexibleConfigurationsClient{
+				MockPut: func(_ context.Context, _ string, _ string, _ string, _ postgresqlflexible.Configuration) (azureautorest.Future, error) {
+					return azureautorest.Future{}, errBoom
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeletePostgreSQLFlexibleServerConfiguration),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
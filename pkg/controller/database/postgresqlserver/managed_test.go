@@ -23,11 +23,17 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -37,6 +43,7 @@ import (
 
 	"github.com/crossplane/provider-azure/apis/database/v1beta1"
 	azurev1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
 	"github.com/crossplane/provider-azure/pkg/clients/database"
 )
 
@@ -52,6 +59,11 @@ type MockPostgreSQLServerAPI struct {
 	MockDeleteServer  func(ctx context.Context, s *v1beta1.PostgreSQLServer) error
 	MockUpdateServer  func(ctx context.Context, s *v1beta1.PostgreSQLServer) error
 	MockGetRESTClient func() autorest.Sender
+
+	MockRotateAdministratorLoginPassword func(ctx context.Context, s *v1beta1.PostgreSQLServer, password string) error
+
+	MockGetAzureADAdministrator    func(ctx context.Context, s *v1beta1.PostgreSQLServer) (postgresql.ServerAdministratorResource, error)
+	MockUpdateAzureADAdministrator func(ctx context.Context, s *v1beta1.PostgreSQLServer) error
 }
 
 func (m *MockPostgreSQLServerAPI) GetRESTClient() autorest.Sender {
@@ -70,10 +82,22 @@ func (m *MockPostgreSQLServerAPI) UpdateServer(ctx context.Context, s *v1beta1.P
 	return m.MockUpdateServer(ctx, s)
 }
 
+func (m *MockPostgreSQLServerAPI) RotateAdministratorLoginPassword(ctx context.Context, s *v1beta1.PostgreSQLServer, password string) error {
+	return m.MockRotateAdministratorLoginPassword(ctx, s, password)
+}
+
 func (m *MockPostgreSQLServerAPI) DeleteServer(ctx context.Context, s *v1beta1.PostgreSQLServer) error {
 	return m.MockDeleteServer(ctx, s)
 }
 
+func (m *MockPostgreSQLServerAPI) GetAzureADAdministrator(ctx context.Context, s *v1beta1.PostgreSQLServer) (postgresql.ServerAdministratorResource, error) {
+	return m.MockGetAzureADAdministrator(ctx, s)
+}
+
+func (m *MockPostgreSQLServerAPI) UpdateAzureADAdministrator(ctx context.Context, s *v1beta1.PostgreSQLServer) error {
+	return m.MockUpdateAzureADAdministrator(ctx, s)
+}
+
 type modifier func(*v1beta1.PostgreSQLServer)
 
 func withExternalName(name string) modifier {
@@ -88,12 +112,51 @@ func withAdminName(name string) modifier {
 	}
 }
 
+func withAzureADAdministrator(a *v1beta1.AzureADAdministrator) modifier {
+	return func(p *v1beta1.PostgreSQLServer) {
+		p.Spec.ForProvider.AzureADAdministrator = a
+	}
+}
+
 func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
 	return func(p *v1beta1.PostgreSQLServer) {
 		p.Status.AtProvider.LastOperation = op
 	}
 }
 
+func withConnectionSecret(name string) modifier {
+	return func(p *v1beta1.PostgreSQLServer) {
+		p.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "cool-namespace", Name: name})
+	}
+}
+
+func withPasswordSecretRef(name string) modifier {
+	return func(p *v1beta1.PostgreSQLServer) {
+		p.Spec.ForProvider.AdministratorLoginPasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "cool-namespace", Name: name},
+			Key:             "password",
+		}
+	}
+}
+
+// withSecrets returns a MockGetFn that gets the supplied secret data by name.
+func withSecrets(data map[string]map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		d, ok := data[key.Name]
+		if !ok {
+			return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+		}
+		obj.(*corev1.Secret).Data = d
+		return nil
+	}
+}
+
+func withPasswordRotation(o v1beta1.PasswordRotationObservation) modifier {
+	return func(p *v1beta1.PostgreSQLServer) {
+		p.Status.AtProvider.AdministratorLoginPasswordRotation = o
+	}
+}
+
 func postgresqlserver(m ...modifier) *v1beta1.PostgreSQLServer {
 	p := &v1beta1.PostgreSQLServer{}
 
@@ -112,6 +175,7 @@ func TestObserve(t *testing.T) {
 	name := "coolserver"
 	endpoint := "coolazure.example.prg"
 	admin := "cooladmin"
+	password := "verysecure"
 
 	type args struct {
 		ctx context.Context
@@ -201,6 +265,25 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ErrGetAzureADAdministrator": {
+			e: &external{
+				client: &MockPostgreSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
+						return postgresql.Server{}, nil
+					},
+					MockGetAzureADAdministrator: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.ServerAdministratorResource, error) {
+						return postgresql.ServerAdministratorResource{}, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(withAzureADAdministrator(&v1beta1.AzureADAdministrator{Login: "cooladmins"})),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetAzureADAdministrator),
+			},
+		},
 		"ServerAvailable": {
 			e: &external{
 				kube: &test.MockClient{
@@ -242,6 +325,99 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AzureADAdministratorNotFound": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockPostgreSQLServerAPI{
+					MockGetAzureADAdministrator: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.ServerAdministratorResource, error) {
+						return postgresql.ServerAdministratorResource{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockGetServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
+						return postgresql.Server{
+							Sku: &postgresql.Sku{},
+							ServerProperties: &postgresql.ServerProperties{
+								UserVisibleState:         postgresql.ServerStateReady,
+								FullyQualifiedDomainName: &endpoint,
+								StorageProfile:           &postgresql.StorageProfile{},
+							}}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withExternalName(name),
+					withAdminName(admin),
+					withAzureADAdministrator(&v1beta1.AzureADAdministrator{Login: "cooladmins"}),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+					},
+				},
+			},
+		},
+		"PasswordRotated": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)}
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &MockPostgreSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
+						return postgresql.Server{
+							Sku: &postgresql.Sku{},
+							ServerProperties: &postgresql.ServerProperties{
+								UserVisibleState:         postgresql.ServerStateReady,
+								FullyQualifiedDomainName: &endpoint,
+								StorageProfile:           &postgresql.StorageProfile{},
+							}}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withExternalName(name),
+					withAdminName(admin),
+					withConnectionSecret(name),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{
+						LastRotation: azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded},
+					}),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -334,6 +510,35 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"SuccessfulWithReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockPostgreSQLServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(withPasswordSecretRef("cool-password")),
+			},
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -350,6 +555,266 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+	hash, _ := database.HashPassword(password)
+	oldHash, _ := database.HashPassword("old")
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want error
+	}{
+		"ErrNotAPostgreSQLServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: errors.New(errNotPostgreSQLServer),
+		},
+		"RotationInProgress": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(withPasswordRotation(v1beta1.PasswordRotationObservation{
+					LastRotation: azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusInProgress},
+				})),
+			},
+			want: nil,
+		},
+		"ErrDeletePendingPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockDelete: test.NewMockDeleteFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{
+						LastRotation: azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded},
+					}),
+				),
+			},
+			want: errors.Wrap(errBoom, "cannot delete pending administrator login password"),
+		},
+		"RotationSucceeded": {
+			e: &external{
+				kube: &test.MockClient{
+					MockDelete: test.NewMockDeleteFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{
+						LastRotation: azurev1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusSucceeded},
+					}),
+				),
+			},
+			want: nil,
+		},
+		"ErrStorePendingPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{NextRotationTime: &past}),
+				),
+			},
+			want: errors.Wrap(errors.Wrap(errBoom, "cannot get object"), "cannot store pending administrator login password"),
+		},
+		"ErrRotatePassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+				client: &MockPostgreSQLServerAPI{
+					MockRotateAdministratorLoginPassword: func(_ context.Context, _ *v1beta1.PostgreSQLServer, _ string) error { return errBoom },
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{NextRotationTime: &past}),
+				),
+			},
+			want: errors.Wrap(errBoom, errRotatePassword),
+		},
+		"ErrGetReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordSecretRef("cool-password"),
+				),
+			},
+			want: errors.Wrap(errBoom, "cannot get administrator login password"),
+		},
+		"ReferencedPasswordChanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{
+						"cool-secret":   {xpv1.ResourceCredentialsSecretPasswordKey: []byte("old")},
+						"cool-password": {"password": []byte(password)},
+					}),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+				client: &MockPostgreSQLServerAPI{
+					MockRotateAdministratorLoginPassword: func(_ context.Context, _ *v1beta1.PostgreSQLServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withConnectionSecret("cool-secret"),
+					withPasswordSecretRef("cool-password"),
+				),
+			},
+			want: nil,
+		},
+		"ReferencedPasswordChangedWithoutConnectionSecret": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockPostgreSQLServerAPI{
+					MockRotateAdministratorLoginPassword: func(_ context.Context, _ *v1beta1.PostgreSQLServer, pw string) error {
+						if pw != password {
+							return errBoom
+						}
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withPasswordSecretRef("cool-password"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{PasswordHash: oldHash}),
+				),
+			},
+			want: nil,
+		},
+		"ReferencedPasswordUnchanged": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecrets(map[string]map[string][]byte{"cool-password": {"password": []byte(password)}}),
+				},
+				client: &MockPostgreSQLServerAPI{
+					MockRotateAdministratorLoginPassword: func(_ context.Context, _ *v1beta1.PostgreSQLServer, _ string) error { return errBoom },
+					MockUpdateServer:                     func(_ context.Context, _ *v1beta1.PostgreSQLServer) error { return nil },
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withPasswordSecretRef("cool-password"),
+					withPasswordRotation(v1beta1.PasswordRotationObservation{PasswordHash: hash}),
+				),
+			},
+			want: nil,
+		},
+		"RotateWithoutConnectionSecret": {
+			e: &external{
+				client: &MockPostgreSQLServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) error { return nil },
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(withPasswordRotation(v1beta1.PasswordRotationObservation{NextRotationTime: &past})),
+			},
+			want: nil,
+		},
+		"ErrUpdateAzureADAdministrator": {
+			e: &external{
+				client: &MockPostgreSQLServerAPI{
+					MockGetAzureADAdministrator: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.ServerAdministratorResource, error) {
+						return postgresql.ServerAdministratorResource{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+					MockUpdateAzureADAdministrator: func(_ context.Context, _ *v1beta1.PostgreSQLServer) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(withAzureADAdministrator(&v1beta1.AzureADAdministrator{Login: "cooladmins"})),
+			},
+			want: errors.Wrap(errBoom, errUpdateAzureADAdministrator),
+		},
+		"ErrUpdateServer": {
+			e: &external{
+				client: &MockPostgreSQLServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(),
+			},
+			want: errors.Wrap(errBoom, errUpdatePostgreSQLServer),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -0,0 +1,497 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresqlserverconfiguration
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolConfiguration"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	configurationName = "max_connections"
+	value             = "100"
+	defaultValue      = "50"
+)
+
+type configurationModifier func(*v1alpha3.PostgreSQLServerConfiguration)
+
+func withConditions(c ...xpv1.Condition) configurationModifier {
+	return func(r *v1alpha3.PostgreSQLServerConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(v, source string) configurationModifier {
+	return func(r *v1alpha3.PostgreSQLServerConfiguration) {
+		r.Status.AtProvider.Value = v
+		r.Status.AtProvider.DefaultValue = defaultValue
+		r.Status.AtProvider.Source = source
+	}
+}
+
+func withLastOperation(op apisv1alpha3.AsyncOperation) configurationModifier {
+	return func(r *v1alpha3.PostgreSQLServerConfiguration) { r.Status.AtProvider.LastOperation = op }
+}
+
+func configuration(sm ...configurationModifier) *v1alpha3.PostgreSQLServerConfiguration {
+	r := &v1alpha3.PostgreSQLServerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.ConfigurationSpec{
+			ForProvider: v1alpha3.ConfigurationParameters{
+				ServerName:        serverName,
+				ResourceGroupName: resourceGroupName,
+				Name:              configurationName,
+				Value:             value,
+			},
+		},
+	}
+
+	for _, m := range sm {
+		m(r)
+	}
+
+	return r
+}
+
+func azConfiguration(v, source string) postgresql.Configuration {
+	return postgresql.Configuration{
+		ConfigurationProperties: &postgresql.ConfigurationProperties{
+			Value:        azure.ToStringPtr(v),
+			DefaultValue: azure.ToStringPtr(defaultValue),
+			Source:       azure.ToStringPtr(source),
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLServerConfiguration": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLServerConfiguration),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Configuration, error) {
+					return postgresql.Configuration{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(),
+			},
+		},
+		"SuccessfulObserveSystemDefault": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Configuration, error) {
+					return azConfiguration(defaultValue, database.ConfigurationSourceSystemDefault), nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(withObservation(defaultValue, database.ConfigurationSourceSystemDefault)),
+			},
+		},
+		"SuccessfulObserveOperationInProgress": {
+			ec: &external{
+				client: &fake.MockPostgreSQLConfigurationsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Configuration, error) {
+						return azConfiguration(defaultValue, database.ConfigurationSourceSystemDefault), nil
+					},
+				},
+				rest: autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						Request:       req,
+						StatusCode:    http.StatusAccepted,
+						Body:          ioutil.NopCloser(strings.NewReader(`{"status": "InProgress"}`)),
+						ContentLength: int64(len(`{"status": "InProgress"}`)),
+					}, nil
+				}),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  configuration(withLastOperation(apisv1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "crossplane.io"})),
+			},
+			want: want{
+				mg: configuration(
+					withObservation(defaultValue, database.ConfigurationSourceSystemDefault),
+					withLastOperation(apisv1alpha3.AsyncOperation{
+						Method:     http.MethodPut,
+						PollingURL: "crossplane.io",
+						Status:     azure.AsyncOperationStatusInProgress,
+					}),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SuccessfulObserveUpToDate": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Configuration, error) {
+					return azConfiguration(value, database.ConfigurationSourceUserOverride), nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Available()),
+					withObservation(value, database.ConfigurationSourceUserOverride),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SuccessfulObserveNotUpToDate": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Configuration, error) {
+					return azConfiguration("200", database.ConfigurationSourceUserOverride), nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Available()),
+					withObservation("200", database.ConfigurationSourceUserOverride),
+				),
+				o: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Configuration, error) {
+					return postgresql.Configuration{}, errBoom
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg:  configuration(),
+				err: errors.Wrap(errBoom, errGetPostgreSQLServerConfiguration),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLServerConfiguration": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLServerConfiguration),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ postgresql.Configuration) (postgresql.ConfigurationsCreateOrUpdateFuture, error) {
+					return postgresql.ConfigurationsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreatePostgreSQLServerConfiguration),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, n string, p postgresql.Configuration) (postgresql.ConfigurationsCreateOrUpdateFuture, error) {
+					if n != configurationName || azure.ToString(p.Value) != value || azure.ToString(p.Source) != database.ConfigurationSourceUserOverride {
+						return postgresql.ConfigurationsCreateOrUpdateFuture{}, errBoom
+					}
+					return postgresql.ConfigurationsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Creating()),
+					withLastOperation(apisv1alpha3.AsyncOperation{Method: http.MethodPut}),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLServerConfiguration": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLServerConfiguration),
+			},
+		},
+		"UpdateError": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ postgresql.Configuration) (postgresql.ConfigurationsCreateOrUpdateFuture, error) {
+					return postgresql.ConfigurationsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg:  configuration(),
+				err: errors.Wrap(errBoom, errUpdatePostgreSQLServerConfiguration),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ postgresql.Configuration) (postgresql.ConfigurationsCreateOrUpdateFuture, error) {
+					return postgresql.ConfigurationsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(withLastOperation(apisv1alpha3.AsyncOperation{Method: http.MethodPut})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotPostgreSQLServerConfiguration": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLServerConfiguration),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, p postgresql.Configuration) (postgresql.ConfigurationsCreateOrUpdateFuture, error) {
+					if azure.ToString(p.Source) != database.ConfigurationSourceSystemDefault {
+						return postgresql.ConfigurationsCreateOrUpdateFuture{}, errBoom
+					}
+					return postgresql.ConfigurationsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Deleting()),
+					withLastOperation(apisv1alpha3.AsyncOperation{Method: http.MethodPut}),
+				),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ postgresql.Configuration) (postgresql.ConfigurationsCreateOrUpdateFuture, error) {
+					return postgresql.ConfigurationsCreateOrUpdateFuture{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockPostgreSQLConfigurationsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ postgresql.Configuration) (postgresql.ConfigurationsCreateOrUpdateFuture, error) {
+					return postgresql.ConfigurationsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			args: args{
+				mg: configuration(),
+			},
+			want: want{
+				mg: configuration(
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeletePostgreSQLServerConfiguration),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
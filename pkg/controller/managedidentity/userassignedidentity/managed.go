@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userassignedidentity
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi/msiapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/managedidentity/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/managedidentity"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
+)
+
+// Error strings.
+const (
+	errUpdateCR                   = "cannot update UserAssignedIdentity custom resource"
+	errNotUserAssignedIdentity    = "managed resource is not a UserAssignedIdentity"
+	errCreateUserAssignedIdentity = "cannot create UserAssignedIdentity"
+	errUpdateUserAssignedIdentity = "cannot update UserAssignedIdentity"
+	errGetUserAssignedIdentity    = "cannot get UserAssignedIdentity"
+	errDeleteUserAssignedIdentity = "cannot delete UserAssignedIdentity"
+)
+
+// Setup adds a controller that reconciles UserAssignedIdentities.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1alpha3.UserAssignedIdentityGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.UserAssignedIdentity{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.UserAssignedIdentityList{})).
+		WithEventFilter(o.Predicate).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.UserAssignedIdentityGroupVersionKind),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := msi.NewUserAssignedIdentitiesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: cl}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client msiapi.UserAssignedIdentitiesClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	i, ok := mg.(*v1alpha3.UserAssignedIdentity)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUserAssignedIdentity)
+	}
+
+	az, err := e.client.Get(ctx, i.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(i))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetUserAssignedIdentity)
+	}
+
+	managedidentity.LateInitializeUserAssignedIdentity(&i.Spec.ForProvider, az)
+	if err := e.kube.Update(ctx, i); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	managedidentity.UpdateUserAssignedIdentityObservation(&i.Status.AtProvider, az)
+
+	// Identities are usable as soon as they exist.
+	i.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: managedidentity.IsUserAssignedIdentityUpToDate(i.Spec.ForProvider, az),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	i, ok := mg.(*v1alpha3.UserAssignedIdentity)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUserAssignedIdentity)
+	}
+
+	i.SetConditions(xpv1.Creating())
+	params := managedidentity.NewUserAssignedIdentityParameters(i.Spec.ForProvider)
+	_, err := e.client.CreateOrUpdate(ctx, i.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(i), params)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateUserAssignedIdentity)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	i, ok := mg.(*v1alpha3.UserAssignedIdentity)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUserAssignedIdentity)
+	}
+
+	params := managedidentity.NewUserAssignedIdentityParameters(i.Spec.ForProvider)
+	_, err := e.client.CreateOrUpdate(ctx, i.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(i), params)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateUserAssignedIdentity)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	i, ok := mg.(*v1alpha3.UserAssignedIdentity)
+	if !ok {
+		return errors.New(errNotUserAssignedIdentity)
+	}
+
+	i.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, i.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(i))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteUserAssignedIdentity)
+}
@@ -0,0 +1,482 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userassignedidentity
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/managedidentity/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/managedidentity/fake"
+)
+
+const (
+	name              = "coolIdentity"
+	uid               = types.UID("definitely-a-uuid")
+	resourceGroupName = "coolRG"
+	location          = "coolplace"
+	resourceID        = "a-very-cool-id"
+	tenantID          = "6a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	principalID       = "0c2b6d7a-2a4c-4e5f-8c6c-3a1f2e6b9d10"
+)
+
+type identityModifier func(*v1alpha3.UserAssignedIdentity)
+
+func withConditions(c ...xpv1.Condition) identityModifier {
+	return func(i *v1alpha3.UserAssignedIdentity) { i.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(t map[string]string) identityModifier {
+	return func(i *v1alpha3.UserAssignedIdentity) { i.Spec.ForProvider.Tags = t }
+}
+
+func withObservation(o v1alpha3.UserAssignedIdentityObservation) identityModifier {
+	return func(i *v1alpha3.UserAssignedIdentity) { i.Status.AtProvider = o }
+}
+
+func identity(im ...identityModifier) *v1alpha3.UserAssignedIdentity {
+	i := &v1alpha3.UserAssignedIdentity{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.UserAssignedIdentitySpec{
+			ForProvider: v1alpha3.UserAssignedIdentityParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+			},
+		},
+	}
+
+	meta.SetExternalName(i, name)
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	tid := uuid.Must(uuid.FromString(tenantID))
+	pid := uuid.Must(uuid.FromString(principalID))
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotUserAssignedIdentity": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{}},
+			want: want{
+				err: errors.New(errNotUserAssignedIdentity),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (msi.Identity, error) {
+					return msi.Identity{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg: identity(),
+			},
+		},
+		"SuccessfulObserveExists": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockUserAssignedIdentitiesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (msi.Identity, error) {
+						return msi.Identity{
+							ID:       azure.ToStringPtr(resourceID),
+							Location: azure.ToStringPtr(location),
+							Tags:     map[string]*string{"cool": azure.ToStringPtr("very")},
+							UserAssignedIdentityProperties: &msi.UserAssignedIdentityProperties{
+								TenantID:    &tid,
+								PrincipalID: &pid,
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg: identity(
+					withConditions(xpv1.Available()),
+					withTags(map[string]string{"cool": "very"}),
+					withObservation(v1alpha3.UserAssignedIdentityObservation{
+						ID:          resourceID,
+						TenantID:    tenantID,
+						PrincipalID: principalID,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SuccessfulObserveNotUpToDate": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockUserAssignedIdentitiesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (msi.Identity, error) {
+						return msi.Identity{
+							ID:   azure.ToStringPtr(resourceID),
+							Tags: map[string]*string{"cool": azure.ToStringPtr("very")},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: identity(withTags(map[string]string{"cool": "extremely"})),
+			},
+			want: want{
+				mg: identity(
+					withConditions(xpv1.Available()),
+					withTags(map[string]string{"cool": "extremely"}),
+					withObservation(v1alpha3.UserAssignedIdentityObservation{ID: resourceID}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"FailedUpdateCR": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockUserAssignedIdentitiesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (msi.Identity, error) {
+						return msi.Identity{}, nil
+					},
+				},
+			},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg:  identity(),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (msi.Identity, error) {
+					return msi.Identity{}, errBoom
+				},
+			}},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg:  identity(),
+				err: errors.Wrap(errBoom, errGetUserAssignedIdentity),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotUserAssignedIdentity": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{}},
+			want: want{
+				err: errors.New(errNotUserAssignedIdentity),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ msi.Identity) (msi.Identity, error) {
+					return msi.Identity{}, errBoom
+				},
+			}},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg: identity(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreateUserAssignedIdentity),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, n string, p msi.Identity) (msi.Identity, error) {
+					if rg != resourceGroupName || n != name || azure.ToString(p.Location) != location {
+						return msi.Identity{}, errBoom
+					}
+					return msi.Identity{}, nil
+				},
+			}},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg: identity(
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotUserAssignedIdentity": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{}},
+			want: want{
+				err: errors.New(errNotUserAssignedIdentity),
+			},
+		},
+		"UpdateError": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ msi.Identity) (msi.Identity, error) {
+					return msi.Identity{}, errBoom
+				},
+			}},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg:  identity(),
+				err: errors.Wrap(errBoom, errUpdateUserAssignedIdentity),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, p msi.Identity) (msi.Identity, error) {
+					if azure.ToString(p.Tags["cool"]) != "very" {
+						return msi.Identity{}, errBoom
+					}
+					return msi.Identity{}, nil
+				},
+			}},
+			args: args{
+				mg: identity(withTags(map[string]string{"cool": "very"})),
+			},
+			want: want{
+				mg: identity(withTags(map[string]string{"cool": "very"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotUserAssignedIdentity": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{}},
+			want: want{
+				err: errors.New(errNotUserAssignedIdentity),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, nil
+				},
+			}},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg: identity(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
+				},
+			}},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg: identity(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			args: args{
+				mg: identity(),
+			},
+			want: want{
+				mg: identity(
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteUserAssignedIdentity),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
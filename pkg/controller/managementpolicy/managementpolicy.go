@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package managementpolicy limits what controllers may do to the external
+// resources of managed resources.
+package managementpolicy
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyManagementPolicy is the annotation that sets the management
+// policy of a managed resource.
+const AnnotationKeyManagementPolicy = "azure.crossplane.io/management-policy"
+
+// ObserveOnly is a management policy under which a controller only observes
+// the external resource of a managed resource. The external resource is never
+// created, updated or deleted. Its observed state is reflected in the status
+// of the managed resource and its connection details are published.
+const ObserveOnly = "ObserveOnly"
+
+// Error strings.
+const (
+	errObserveOnlyNotFound = "external resource does not exist, and is not created because the managed resource's management policy is " + ObserveOnly
+)
+
+// IsObserveOnly returns true if the supplied object's management policy is
+// ObserveOnly.
+func IsObserveOnly(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyManagementPolicy] == ObserveOnly
+}
+
+// NewConnecter returns an ExternalConnecter whose ExternalClients honour the
+// management policy of a managed resource. Other management policies are
+// passed through to the supplied ExternalConnecter's clients.
+func NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{wrapped: c}
+}
+
+type connecter struct {
+	wrapped managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.wrapped.Connect(ctx, mg)
+	if err != nil || !IsObserveOnly(mg) {
+		return e, err
+	}
+	return &observeOnly{wrapped: e}, nil
+}
+
+// observeOnly wraps an ExternalClient, allowing only Observe.
+type observeOnly struct {
+	wrapped managed.ExternalClient
+}
+
+func (e *observeOnly) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.wrapped.Observe(ctx, mg)
+	if err != nil {
+		return o, err
+	}
+
+	// The managed reconciler only releases a deleted managed resource once its
+	// external resource no longer exists. Report that it does not, so that
+	// the managed resource is released without its external resource being
+	// deleted.
+	if meta.WasDeleted(mg) {
+		o.ResourceExists = false
+		return o, nil
+	}
+
+	// Drift is reflected in status, but never corrected.
+	o.ResourceUpToDate = true
+	return o, nil
+}
+
+func (e *observeOnly) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, errors.New(errObserveOnlyNotFound)
+}
+
+func (e *observeOnly) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *observeOnly) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managementpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type mrOption func(*fake.Managed)
+
+func withPolicy(p string) mrOption {
+	return func(mg *fake.Managed) {
+		mg.SetAnnotations(map[string]string{AnnotationKeyManagementPolicy: p})
+	}
+}
+
+func withDeletionTimestamp() mrOption {
+	return func(mg *fake.Managed) {
+		now := metav1.Now()
+		mg.SetDeletionTimestamp(&now)
+	}
+}
+
+func mr(o ...mrOption) *fake.Managed {
+	mg := &fake.Managed{}
+	for _, f := range o {
+		f(mg)
+	}
+	return mg
+}
+
+var errBoom = errors.New("boom")
+
+// external is an ExternalClient that reports an existing, outdated external
+// resource and fails every other operation, so that calls that should not
+// reach it are detected.
+var external = managed.ExternalClientFns{
+	ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{"cool": []byte("secret")},
+		}, nil
+	},
+	CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+		return managed.ExternalCreation{}, errBoom
+	},
+	UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+		return managed.ExternalUpdate{}, errBoom
+	},
+	DeleteFn: func(_ context.Context, _ resource.Managed) error {
+		return errBoom
+	},
+}
+
+func connect(t *testing.T, mg resource.Managed) managed.ExternalClient {
+	t.Helper()
+	c := NewConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return external, nil
+	}))
+	e, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	return e
+}
+
+func TestObserve(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want managed.ExternalObservation
+	}{
+		"DefaultPolicy": {
+			mg: mr(),
+			want: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{"cool": []byte("secret")},
+			},
+		},
+		"ObserveOnly": {
+			mg: mr(withPolicy(ObserveOnly)),
+			want: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{"cool": []byte("secret")},
+			},
+		},
+		"ObserveOnlyDeleted": {
+			mg: mr(withPolicy(ObserveOnly), withDeletionTimestamp()),
+			want: managed.ExternalObservation{
+				ResourceExists:    false,
+				ConnectionDetails: managed.ConnectionDetails{"cool": []byte("secret")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := connect(t, tc.mg).Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Observe(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMutations(t *testing.T) {
+	cases := map[string]struct {
+		mg         resource.Managed
+		wantCreate error
+		wantUpdate error
+		wantDelete error
+	}{
+		"DefaultPolicy": {
+			mg:         mr(),
+			wantCreate: errBoom,
+			wantUpdate: errBoom,
+			wantDelete: errBoom,
+		},
+		"ObserveOnly": {
+			mg:         mr(withPolicy(ObserveOnly)),
+			wantCreate: errors.New(errObserveOnlyNotFound),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := connect(t, tc.mg)
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantCreate, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			_, err = e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantUpdate, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			err = e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantDelete, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
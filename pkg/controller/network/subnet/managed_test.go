@@ -34,7 +34,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
-	"github.com/crossplane/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/network/v1beta1"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 	"github.com/crossplane/provider-azure/pkg/clients/network/fake"
 )
@@ -60,30 +60,30 @@ type testCase struct {
 	wantErr error
 }
 
-type subnetModifier func(*v1alpha3.Subnet)
+type subnetModifier func(*v1beta1.Subnet)
 
 func withConditions(c ...xpv1.Condition) subnetModifier {
-	return func(r *v1alpha3.Subnet) { r.Status.ConditionedStatus.Conditions = c }
+	return func(r *v1beta1.Subnet) { r.Status.ConditionedStatus.Conditions = c }
 }
 
 func withState(s string) subnetModifier {
-	return func(r *v1alpha3.Subnet) { r.Status.State = s }
+	return func(r *v1beta1.Subnet) { r.Status.State = s }
 }
-func subnet(sm ...subnetModifier) *v1alpha3.Subnet {
-	r := &v1alpha3.Subnet{
+func subnet(sm ...subnetModifier) *v1beta1.Subnet {
+	r := &v1beta1.Subnet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:       name,
 			UID:        uid,
 			Finalizers: []string{},
 		},
-		Spec: v1alpha3.SubnetSpec{
+		Spec: v1beta1.SubnetSpec{
 			VirtualNetworkName: virtualNetworkName,
 			ResourceGroupName:  resourceGroupName,
-			SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+			SubnetPropertiesFormat: v1beta1.SubnetPropertiesFormat{
 				AddressPrefix: addressPrefix,
 			},
 		},
-		Status: v1alpha3.SubnetStatus{},
+		Status: v1beta1.SubnetStatus{},
 	}
 
 	meta.SetExternalName(r, name)
@@ -104,8 +104,8 @@ func TestCreate(t *testing.T) {
 		{
 			name:    "NotSubnet",
 			e:       &external{client: &fake.MockSubnetsClient{}},
-			r:       &v1alpha3.VirtualNetwork{},
-			want:    &v1alpha3.VirtualNetwork{},
+			r:       &v1beta1.VirtualNetwork{},
+			want:    &v1beta1.VirtualNetwork{},
 			wantErr: errors.New(errNotSubnet),
 		},
 		{
@@ -155,8 +155,8 @@ func TestObserve(t *testing.T) {
 		{
 			name:    "NotSubnet",
 			e:       &external{client: &fake.MockSubnetsClient{}},
-			r:       &v1alpha3.VirtualNetwork{},
-			want:    &v1alpha3.VirtualNetwork{},
+			r:       &v1beta1.VirtualNetwork{},
+			want:    &v1beta1.VirtualNetwork{},
 			wantErr: errors.New(errNotSubnet),
 		},
 		{
@@ -164,12 +164,12 @@ func TestObserve(t *testing.T) {
 			e: &external{client: &fake.MockSubnetsClient{
 				MockGet: func(_ context.Context, _ string, _ string, _ string, _ string) (result network.Subnet, err error) {
 					return network.Subnet{
-							SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
-								AddressPrefix: azure.ToStringPtr(addressPrefix),
-							},
-						}, autorest.DetailedError{
-							StatusCode: http.StatusNotFound,
-						}
+						SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+							AddressPrefix: azure.ToStringPtr(addressPrefix),
+						},
+					}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
 				},
 			}},
 			r:    subnet(),
@@ -226,8 +226,8 @@ func TestUpdate(t *testing.T) {
 		{
 			name:    "NotSubnet",
 			e:       &external{client: &fake.MockSubnetsClient{}},
-			r:       &v1alpha3.VirtualNetwork{},
-			want:    &v1alpha3.VirtualNetwork{},
+			r:       &v1beta1.VirtualNetwork{},
+			want:    &v1beta1.VirtualNetwork{},
 			wantErr: errors.New(errNotSubnet),
 		},
 		{
@@ -316,8 +316,8 @@ func TestDelete(t *testing.T) {
 		{
 			name:    "NotSubnet",
 			e:       &external{client: &fake.MockSubnetsClient{}},
-			r:       &v1alpha3.VirtualNetwork{},
-			want:    &v1alpha3.VirtualNetwork{},
+			r:       &v1beta1.VirtualNetwork{},
+			want:    &v1beta1.VirtualNetwork{},
 			wantErr: errors.New(errNotSubnet),
 		},
 		{
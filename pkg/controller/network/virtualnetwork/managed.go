@@ -22,22 +22,20 @@ import (
 	azurenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network/networkapi"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
-	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
-	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
-	"github.com/crossplane/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/network/v1beta1"
 	azureclients "github.com/crossplane/provider-azure/pkg/clients"
 	"github.com/crossplane/provider-azure/pkg/clients/network"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
 )
 
 // Error strings.
@@ -50,21 +48,22 @@ const (
 )
 
 // Setup adds a controller that reconciles VirtualNetworks.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
-	name := managed.ControllerName(v1alpha3.VirtualNetworkGroupKind)
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.VirtualNetworkGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
-		}).
-		For(&v1alpha3.VirtualNetwork{}).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.VirtualNetwork{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1beta1.VirtualNetworkList{})).
+		WithEventFilter(o.Predicate).
 		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1alpha3.VirtualNetworkGroupVersionKind),
+			resource.ManagedKind(v1beta1.VirtualNetworkGroupVersionKind),
 			managed.WithConnectionPublishers(),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithExternalConnecter(managementpolicy.NewConnecter(&connecter{client: mgr.GetClient()})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
@@ -87,7 +86,7 @@ type external struct {
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	v, ok := mg.(*v1alpha3.VirtualNetwork)
+	v, ok := mg.(*v1beta1.VirtualNetwork)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotVirtualNetwork)
 	}
@@ -113,7 +112,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	v, ok := mg.(*v1alpha3.VirtualNetwork)
+	v, ok := mg.(*v1beta1.VirtualNetwork)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotVirtualNetwork)
 	}
@@ -129,7 +128,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	v, ok := mg.(*v1alpha3.VirtualNetwork)
+	v, ok := mg.(*v1beta1.VirtualNetwork)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotVirtualNetwork)
 	}
@@ -149,7 +148,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
-	v, ok := mg.(*v1alpha3.VirtualNetwork)
+	v, ok := mg.(*v1beta1.VirtualNetwork)
 	if !ok {
 		return errors.New(errNotVirtualNetwork)
 	}
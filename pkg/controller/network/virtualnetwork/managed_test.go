@@ -34,7 +34,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
-	"github.com/crossplane/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/network/v1beta1"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 	"github.com/crossplane/provider-azure/pkg/clients/network/fake"
 )
@@ -61,27 +61,27 @@ type testCase struct {
 	wantErr error
 }
 
-type virtualNetworkModifier func(*v1alpha3.VirtualNetwork)
+type virtualNetworkModifier func(*v1beta1.VirtualNetwork)
 
 func withConditions(c ...xpv1.Condition) virtualNetworkModifier {
-	return func(r *v1alpha3.VirtualNetwork) { r.Status.ConditionedStatus.Conditions = c }
+	return func(r *v1beta1.VirtualNetwork) { r.Status.ConditionedStatus.Conditions = c }
 }
 
 func withState(s string) virtualNetworkModifier {
-	return func(r *v1alpha3.VirtualNetwork) { r.Status.State = s }
+	return func(r *v1beta1.VirtualNetwork) { r.Status.State = s }
 }
 
-func virtualNetwork(vm ...virtualNetworkModifier) *v1alpha3.VirtualNetwork {
-	r := &v1alpha3.VirtualNetwork{
+func virtualNetwork(vm ...virtualNetworkModifier) *v1beta1.VirtualNetwork {
+	r := &v1beta1.VirtualNetwork{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:       name,
 			UID:        uid,
 			Finalizers: []string{},
 		},
-		Spec: v1alpha3.VirtualNetworkSpec{
+		Spec: v1beta1.VirtualNetworkSpec{
 			ResourceGroupName: resourceGroupName,
-			VirtualNetworkPropertiesFormat: v1alpha3.VirtualNetworkPropertiesFormat{
-				AddressSpace: v1alpha3.AddressSpace{
+			VirtualNetworkPropertiesFormat: v1beta1.VirtualNetworkPropertiesFormat{
+				AddressSpace: v1beta1.AddressSpace{
 					AddressPrefixes: []string{addressPrefix},
 				},
 				EnableDDOSProtection: true,
@@ -90,7 +90,7 @@ func virtualNetwork(vm ...virtualNetworkModifier) *v1alpha3.VirtualNetwork {
 			Location: location,
 			Tags:     tags,
 		},
-		Status: v1alpha3.VirtualNetworkStatus{},
+		Status: v1beta1.VirtualNetworkStatus{},
 	}
 	meta.SetExternalName(r, name)
 
@@ -110,8 +110,8 @@ func TestCreate(t *testing.T) {
 		{
 			name:    "NotVirtualNetwok",
 			e:       &external{client: &fake.MockVirtualNetworksClient{}},
-			r:       &v1alpha3.Subnet{},
-			want:    &v1alpha3.Subnet{},
+			r:       &v1beta1.Subnet{},
+			want:    &v1beta1.Subnet{},
 			wantErr: errors.New(errNotVirtualNetwork),
 		},
 		{
@@ -161,8 +161,8 @@ func TestObserve(t *testing.T) {
 		{
 			name:    "NotVirtualNetwok",
 			e:       &external{client: &fake.MockVirtualNetworksClient{}},
-			r:       &v1alpha3.Subnet{},
-			want:    &v1alpha3.Subnet{},
+			r:       &v1beta1.Subnet{},
+			want:    &v1beta1.Subnet{},
 			wantErr: errors.New(errNotVirtualNetwork),
 		},
 		{
@@ -170,17 +170,17 @@ func TestObserve(t *testing.T) {
 			e: &external{client: &fake.MockVirtualNetworksClient{
 				MockGet: func(_ context.Context, _ string, _ string, _ string) (result network.VirtualNetwork, err error) {
 					return network.VirtualNetwork{
-							Tags: azure.ToStringPtrMap(tags),
-							VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
-								AddressSpace: &network.AddressSpace{
-									AddressPrefixes: &[]string{addressPrefix},
-								},
-								EnableDdosProtection: azure.ToBoolPtr(true),
-								EnableVMProtection:   azure.ToBoolPtr(true),
+						Tags: azure.ToStringPtrMap(tags),
+						VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+							AddressSpace: &network.AddressSpace{
+								AddressPrefixes: &[]string{addressPrefix},
 							},
-						}, autorest.DetailedError{
-							StatusCode: http.StatusNotFound,
-						}
+							EnableDdosProtection: azure.ToBoolPtr(true),
+							EnableVMProtection:   azure.ToBoolPtr(true),
+						},
+					}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
 				},
 			}},
 			r:    virtualNetwork(),
@@ -242,8 +242,8 @@ func TestUpdate(t *testing.T) {
 		{
 			name:    "NotVirtualNetwok",
 			e:       &external{client: &fake.MockVirtualNetworksClient{}},
-			r:       &v1alpha3.Subnet{},
-			want:    &v1alpha3.Subnet{},
+			r:       &v1beta1.Subnet{},
+			want:    &v1beta1.Subnet{},
 			wantErr: errors.New(errNotVirtualNetwork),
 		},
 		{
@@ -352,8 +352,8 @@ func TestDelete(t *testing.T) {
 		{
 			name:    "NotVirtualNetwok",
 			e:       &external{client: &fake.MockVirtualNetworksClient{}},
-			r:       &v1alpha3.Subnet{},
-			want:    &v1alpha3.Subnet{},
+			r:       &v1beta1.Subnet{},
+			want:    &v1beta1.Subnet{},
 			wantErr: errors.New(errNotVirtualNetwork),
 		},
 		{
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package options contains options shared by Azure controllers.
+package options
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/v1beta1"
+)
+
+// Options configure an Azure controller.
+type Options struct {
+	// Logger used by the controller.
+	Logger logging.Logger
+
+	// GlobalRateLimiter limits the rate at which all controllers reconcile.
+	GlobalRateLimiter workqueue.RateLimiter
+
+	// Predicate filters the resources the controller reconciles.
+	Predicate predicate.Predicate
+
+	// PollInterval is how often a managed resource that is up to date is
+	// observed again.
+	PollInterval time.Duration
+
+	// MaxConcurrentReconciles is the maximum number of resources each
+	// controller reconciles at once.
+	MaxConcurrentReconciles int
+
+	// ProviderConfigEvents returns the handler of ProviderConfig events used
+	// by the controller of the managed resources of the kind of the supplied
+	// list. ProviderConfig events are ignored if it is nil.
+	ProviderConfigEvents func(l resource.ManagedList) handler.EventHandler
+}
+
+// ProviderConfigSource returns the source of ProviderConfig events.
+func (o Options) ProviderConfigSource() source.Source {
+	return &source.Kind{Type: &v1beta1.ProviderConfig{}}
+}
+
+// ProviderConfigHandler returns the handler of ProviderConfig events used by
+// the controller of the managed resources of the kind of the supplied list.
+func (o Options) ProviderConfigHandler(l resource.ManagedList) handler.EventHandler {
+	if o.ProviderConfigEvents == nil {
+		return handler.Funcs{}
+	}
+	return o.ProviderConfigEvents(l)
+}
+
+// ForControllerRuntime returns the controller-runtime options of a
+// controller.
+func (o Options) ForControllerRuntime() controller.Options {
+	return controller.Options{
+		MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(o.GlobalRateLimiter),
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shard splits managed resources between several provider replicas.
+package shard
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/v1beta1"
+)
+
+const errNoProviderConfig = "managed resource references neither a ProviderConfig nor a Provider"
+
+// Config determines which managed resources a provider replica is responsible
+// for. Replicas may be sharded by a hash of the names of managed resources, or
+// by the labels of the ProviderConfigs they use, but not both.
+type Config struct {
+	// Count is the number of shards managed resources are hashed into. Zero
+	// or one disables hashing.
+	Count int
+
+	// Index of the shard this replica is responsible for, from zero to Count
+	// minus one.
+	Index int
+
+	// ProviderConfigSelector selects the ProviderConfigs whose managed
+	// resources this replica is responsible for. Nil disables selection.
+	ProviderConfigSelector labels.Selector
+}
+
+// Validate returns an error if the Config is invalid.
+func (c Config) Validate() error {
+	if c.Count > 1 && c.ProviderConfigSelector != nil {
+		return errors.New("cannot shard by both name hash and ProviderConfig selector")
+	}
+	if c.Count > 1 && (c.Index < 0 || c.Index >= c.Count) {
+		return errors.Errorf("shard index %d is not between 0 and %d", c.Index, c.Count-1)
+	}
+	return nil
+}
+
+// Enabled returns true if the Config shards managed resources.
+func (c Config) Enabled() bool {
+	return c.Count > 1 || c.ProviderConfigSelector != nil
+}
+
+// ID uniquely identifies this replica's shard, e.g. for use in its leader
+// election ID. It is empty if sharding is disabled.
+func (c Config) ID() string {
+	switch {
+	case c.Count > 1:
+		return fmt.Sprintf("%d-of-%d", c.Index, c.Count)
+	case c.ProviderConfigSelector != nil:
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(c.ProviderConfigSelector.String()))
+		return fmt.Sprintf("%08x", h.Sum32())
+	}
+	return ""
+}
+
+// Predicate returns a predicate that passes events for managed resources in
+// this replica's shard, and for any object that is not a managed resource.
+// ProviderConfigs are read using the supplied client, which should be backed
+// by a cache.
+func (c Config) Predicate(kube client.Reader) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		if _, ok := o.(resource.Managed); !ok {
+			return true
+		}
+		return c.Owns(context.Background(), kube, o)
+	})
+}
+
+// Owns returns true if the supplied object is in this replica's shard.
+func (c Config) Owns(ctx context.Context, kube client.Reader, o client.Object) bool {
+	if c.Count > 1 && int(Hash(o.GetName())%uint32(c.Count)) != c.Index {
+		return false
+	}
+	if c.ProviderConfigSelector == nil {
+		return true
+	}
+	pc, err := getProviderConfig(ctx, kube, o)
+	if err != nil {
+		// We can't tell which shard the resource belongs to until its
+		// ProviderConfig exists. It will be reconsidered when its
+		// ProviderConfig is created, or when it is next updated or resynced.
+		return false
+	}
+	return c.ProviderConfigSelector.Matches(labels.Set(pc.GetLabels()))
+}
+
+// getProviderConfig gets the ProviderConfig the supplied object uses. It falls
+// back to the deprecated Provider the object references, if any, because
+// those objects are still reconciled.
+func getProviderConfig(ctx context.Context, kube client.Reader, o client.Object) (client.Object, error) {
+	if pr, ok := o.(resource.ProviderConfigReferencer); ok && pr.GetProviderConfigReference() != nil {
+		pc := &v1beta1.ProviderConfig{}
+		return pc, kube.Get(ctx, types.NamespacedName{Name: pr.GetProviderConfigReference().Name}, pc)
+	}
+	if pr, ok := o.(resource.ProviderReferencer); ok && pr.GetProviderReference() != nil {
+		p := &v1alpha3.Provider{}
+		return p, kube.Get(ctx, types.NamespacedName{Name: pr.GetProviderReference().Name}, p)
+	}
+	return nil, errors.New(errNoProviderConfig)
+}
+
+// ProviderConfigHandler returns an event handler that enqueues the managed
+// resources in this replica's shard that use a ProviderConfig when it is
+// created or its labels change, rather than waiting for them to be resynced.
+// The supplied list determines the kind of managed resources that are
+// enqueued. The handler ignores all events unless ProviderConfigs are
+// selected.
+func (c Config) ProviderConfigHandler(kube client.Reader, l resource.ManagedList) handler.EventHandler {
+	if c.ProviderConfigSelector == nil {
+		return handler.Funcs{}
+	}
+	return handler.Funcs{
+		CreateFunc: func(e event.CreateEvent, q workqueue.RateLimitingInterface) {
+			c.enqueue(context.Background(), kube, l, e.Object, q)
+		},
+		UpdateFunc: func(e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+			if reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) {
+				return
+			}
+			c.enqueue(context.Background(), kube, l, e.ObjectNew, q)
+		},
+	}
+}
+
+// enqueue the managed resources in this replica's shard that use the supplied
+// ProviderConfig.
+func (c Config) enqueue(ctx context.Context, kube client.Reader, l resource.ManagedList, pc client.Object, q workqueue.RateLimitingInterface) {
+	l, ok := l.DeepCopyObject().(resource.ManagedList)
+	if !ok {
+		return
+	}
+	if err := kube.List(ctx, l); err != nil {
+		// The managed resources will be reconsidered when they are next
+		// resynced.
+		return
+	}
+	for _, mg := range l.GetItems() {
+		ref := mg.GetProviderConfigReference()
+		if ref == nil || ref.Name != pc.GetName() || !c.Owns(ctx, kube, mg) {
+			continue
+		}
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: mg.GetName()}})
+	}
+}
+
+// Hash returns the hash of the supplied name used to shard managed resources.
+func Hash(name string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return h.Sum32()
+}
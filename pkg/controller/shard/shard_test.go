@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azurev1beta1 "github.com/crossplane/provider-azure/apis/v1beta1"
+)
+
+func redis(name, providerConfig string) *v1beta1.Redis {
+	r := &v1beta1.Redis{}
+	r.SetName(name)
+	if providerConfig != "" {
+		r.SetProviderConfigReference(&xpv1.Reference{Name: providerConfig})
+	}
+	return r
+}
+
+func TestOwns(t *testing.T) {
+	shardA := labels.SelectorFromSet(labels.Set{"shard": "a"})
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name != "pc-a" {
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			obj.SetLabels(map[string]string{"shard": "a"})
+			return nil
+		},
+	}
+	name := "cool-redis"
+	index := int(Hash(name) % 3)
+
+	cases := map[string]struct {
+		c    Config
+		o    client.Object
+		want bool
+	}{
+		"Disabled": {
+			o:    redis(name, ""),
+			want: true,
+		},
+		"InHashShard": {
+			c:    Config{Count: 3, Index: index},
+			o:    redis(name, ""),
+			want: true,
+		},
+		"NotInHashShard": {
+			c:    Config{Count: 3, Index: (index + 1) % 3},
+			o:    redis(name, ""),
+			want: false,
+		},
+		"ProviderConfigSelected": {
+			c:    Config{ProviderConfigSelector: shardA},
+			o:    redis(name, "pc-a"),
+			want: true,
+		},
+		"ProviderConfigNotFound": {
+			c:    Config{ProviderConfigSelector: shardA},
+			o:    redis(name, "pc-b"),
+			want: false,
+		},
+		"DeprecatedProviderSelected": {
+			c: Config{ProviderConfigSelector: shardA},
+			o: func() client.Object {
+				r := redis(name, "")
+				r.SetProviderReference(&xpv1.Reference{Name: "pc-a"})
+				return r
+			}(),
+			want: true,
+		},
+		"NoProviderConfig": {
+			c:    Config{ProviderConfigSelector: shardA},
+			o:    redis(name, ""),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.c.Owns(context.Background(), kube, tc.o)
+			if got != tc.want {
+				t.Errorf("Owns(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestProviderConfigHandler(t *testing.T) {
+	shardA := labels.SelectorFromSet(labels.Set{"shard": "a"})
+	pc := func(l map[string]string) *azurev1beta1.ProviderConfig {
+		pc := &azurev1beta1.ProviderConfig{}
+		pc.SetName("pc-a")
+		pc.SetLabels(l)
+		return pc
+	}
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			obj.SetLabels(map[string]string{"shard": "a"})
+			return nil
+		},
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*v1beta1.RedisList).Items = []v1beta1.Redis{*redis("a", "pc-a"), *redis("b", "pc-b"), *redis("c", "")}
+			return nil
+		},
+	}
+
+	cases := map[string]struct {
+		c    Config
+		e    func(h handler.EventHandler, q workqueue.RateLimitingInterface)
+		want []string
+	}{
+		"Disabled": {
+			e: func(h handler.EventHandler, q workqueue.RateLimitingInterface) {
+				h.Create(event.CreateEvent{Object: pc(nil)}, q)
+			},
+		},
+		"Created": {
+			c: Config{ProviderConfigSelector: shardA},
+			e: func(h handler.EventHandler, q workqueue.RateLimitingInterface) {
+				h.Create(event.CreateEvent{Object: pc(nil)}, q)
+			},
+			want: []string{"a"},
+		},
+		"LabelsChanged": {
+			c: Config{ProviderConfigSelector: shardA},
+			e: func(h handler.EventHandler, q workqueue.RateLimitingInterface) {
+				h.Update(event.UpdateEvent{ObjectOld: pc(nil), ObjectNew: pc(map[string]string{"shard": "a"})}, q)
+			},
+			want: []string{"a"},
+		},
+		"LabelsUnchanged": {
+			c: Config{ProviderConfigSelector: shardA},
+			e: func(h handler.EventHandler, q workqueue.RateLimitingInterface) {
+				h.Update(event.UpdateEvent{ObjectOld: pc(nil), ObjectNew: pc(nil)}, q)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer q.ShutDown()
+			tc.e(tc.c.ProviderConfigHandler(kube, &v1beta1.RedisList{}), q)
+
+			var got []string
+			for q.Len() > 0 {
+				i, _ := q.Get()
+				got = append(got, i.(reconcile.Request).Name)
+				q.Done(i)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ProviderConfigHandler(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
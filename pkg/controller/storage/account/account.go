@@ -27,22 +27,21 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
-	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
 )
 
 const (
@@ -54,6 +53,11 @@ const (
 	requeueAfterOnWait    = 30 * time.Second
 )
 
+// Error strings
+const (
+	errObserveOnlyNotFound = "storage account does not exist, and is not created because its management policy is " + managementpolicy.ObserveOnly
+)
+
 var (
 	resultRequeue    = reconcile.Result{Requeue: true}
 	requeueOnSuccess = reconcile.Result{RequeueAfter: requeueAfterOnSuccess}
@@ -71,22 +75,22 @@ type Reconciler struct {
 }
 
 // Setup adds a controller that reconciles Accounts.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func Setup(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1alpha3.AccountGroupKind)
 
 	r := &Reconciler{
 		Client:           mgr.GetClient(),
 		syncdeleterMaker: &accountSyncdeleterMaker{mgr.GetClient()},
 		Initializer:      managed.NewNameAsExternalName(mgr.GetClient()),
-		log:              l.WithValues("controller", name),
+		log:              o.Logger.WithValues("controller", name),
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
-		}).
+		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha3.Account{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.AccountList{})).
+		WithEventFilter(o.Predicate).
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
@@ -195,6 +199,10 @@ func (asd *accountSyncDeleter) delete(ctx context.Context) (reconcile.Result, er
 	asd.acct.Status.SetConditions(xpv1.Deleting())
 	switch asd.acct.Spec.DeletionPolicy {
 	case xpv1.DeletionDelete, "":
+		if managementpolicy.IsObserveOnly(asd.acct) {
+			// Observe only accounts are never deleted.
+			break
+		}
 		if err := asd.Delete(ctx); err != nil && !azure.IsNotFound(err) {
 			asd.acct.Status.SetConditions(xpv1.ReconcileError(err))
 			return resultRequeue, asd.kube.Status().Update(ctx, asd.acct)
@@ -253,6 +261,10 @@ func newAccountCreateUpdater(ao azurestorage.AccountOperations, kube client.Clie
 
 // create new storage account resource and save changes back to account specs
 func (acu *accountCreateUpdater) create(ctx context.Context) (reconcile.Result, error) {
+	if managementpolicy.IsObserveOnly(acu.acct) {
+		acu.acct.Status.SetConditions(xpv1.ReconcileError(errors.New(errObserveOnlyNotFound)))
+		return resultRequeue, acu.kube.Status().Update(ctx, acu.acct)
+	}
 	acu.acct.Status.SetConditions(xpv1.Creating())
 	meta.AddFinalizer(acu.acct, finalizer)
 
@@ -273,7 +285,7 @@ func (acu *accountCreateUpdater) update(ctx context.Context, account *storage.Ac
 		acu.acct.Status.SetConditions(xpv1.Available())
 
 		current := v1alpha3.NewStorageAccountSpec(account)
-		if reflect.DeepEqual(current, acu.acct.Spec.StorageAccountSpec) {
+		if reflect.DeepEqual(current, acu.acct.Spec.StorageAccountSpec) || managementpolicy.IsObserveOnly(acu.acct) {
 			acu.acct.Status.SetConditions(xpv1.ReconcileSuccess())
 			return requeueOnSuccess, acu.kube.Status().Update(ctx, acu.acct)
 		}
@@ -27,16 +27,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
-	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -44,6 +41,8 @@ import (
 
 	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
 	"github.com/crossplane/provider-azure/pkg/clients/storage"
+	"github.com/crossplane/provider-azure/pkg/controller/managementpolicy"
+	"github.com/crossplane/provider-azure/pkg/controller/options"
 )
 
 const (
@@ -56,7 +55,8 @@ const (
 
 // Error strings
 const (
-	errAcctSecretNil = "account does not have a connection secret"
+	errAcctSecretNil       = "account does not have a connection secret"
+	errObserveOnlyNotFound = "container does not exist, and is not created because its management policy is " + managementpolicy.ObserveOnly
 )
 
 var (
@@ -75,22 +75,22 @@ type Reconciler struct {
 }
 
 // Setup adds a controller that reconciles Containers.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+func Setup(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1alpha3.ContainerGroupKind)
 
 	r := &Reconciler{
 		Client:           mgr.GetClient(),
 		syncdeleterMaker: &containerSyncdeleterMaker{mgr.GetClient()},
 		Initializer:      managed.NewNameAsExternalName(mgr.GetClient()),
-		log:              l.WithValues("controller", name),
+		log:              o.Logger.WithValues("controller", name),
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
-		}).
+		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha3.Container{}).
+		Watches(o.ProviderConfigSource(), o.ProviderConfigHandler(&v1alpha3.ContainerList{})).
+		WithEventFilter(o.Predicate).
 		Complete(r)
 }
 
@@ -228,7 +228,7 @@ type containerSyncdeleter struct {
 
 func (csd *containerSyncdeleter) delete(ctx context.Context) (reconcile.Result, error) {
 	csd.container.Status.SetConditions(xpv1.Deleting())
-	if csd.container.Spec.DeletionPolicy == xpv1.DeletionDelete {
+	if csd.container.Spec.DeletionPolicy == xpv1.DeletionDelete && !managementpolicy.IsObserveOnly(csd.container) {
 		if err := csd.Delete(ctx); err != nil && !azure.IsNotFound(err) {
 			csd.container.Status.SetConditions(xpv1.ReconcileError(err))
 			return resultRequeue, csd.kube.Status().Update(ctx, csd.container)
@@ -272,6 +272,10 @@ var _ createupdater = &containerCreateUpdater{}
 
 func (ccu *containerCreateUpdater) create(ctx context.Context) (reconcile.Result, error) {
 	container := ccu.container
+	if managementpolicy.IsObserveOnly(container) {
+		container.Status.SetConditions(xpv1.ReconcileError(errors.New(errObserveOnlyNotFound)))
+		return resultRequeue, ccu.kube.Status().Update(ctx, container)
+	}
 	container.Status.SetConditions(xpv1.Creating())
 
 	meta.AddFinalizer(container, finalizer)
@@ -293,7 +297,8 @@ func (ccu *containerCreateUpdater) update(ctx context.Context, accessType *azblo
 	container := ccu.container
 	spec := container.Spec
 
-	if !reflect.DeepEqual(*accessType, spec.PublicAccessType) || !reflect.DeepEqual(meta, spec.Metadata) {
+	changed := !reflect.DeepEqual(*accessType, spec.PublicAccessType) || !reflect.DeepEqual(meta, spec.Metadata)
+	if changed && !managementpolicy.IsObserveOnly(container) {
 		if err := ccu.Update(ctx, spec.PublicAccessType, spec.Metadata); err != nil {
 			container.Status.SetConditions(xpv1.ReconcileError(err))
 			return resultRequeue, ccu.kube.Status().Update(ctx, container)
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diagnostics contains health checks and profiling endpoints of the
+// provider.
+package diagnostics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// Error strings.
+const (
+	errNewDiscoveryClient = "cannot create discovery client"
+	errAPIServer          = "cannot reach API server"
+	errNewARMRequest      = "cannot create Azure Resource Manager request"
+	errARM                = "cannot reach Azure Resource Manager"
+	errListen             = "cannot listen for profiling requests"
+)
+
+// DefaultCheckTimeout is the default timeout of a health check.
+const DefaultCheckTimeout = 5 * time.Second
+
+// APIServerChecker returns a health check that passes if the API server
+// described by the supplied config is reachable.
+func APIServerChecker(cfg *rest.Config) (healthz.Checker, error) {
+	c := rest.CopyConfig(cfg)
+	c.Timeout = DefaultCheckTimeout
+	dc, err := discovery.NewDiscoveryClientForConfig(c)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewDiscoveryClient)
+	}
+	return func(_ *http.Request) error {
+		_, err := dc.ServerVersion()
+		return errors.Wrap(err, errAPIServer)
+	}, nil
+}
+
+// ARMChecker returns a health check that passes if the supplied Azure
+// Resource Manager endpoint is reachable. Any HTTP response counts as
+// reachable; requests are unauthenticated, so errors are expected.
+func ARMChecker(endpoint string, c *http.Client) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), DefaultCheckTimeout)
+		defer cancel()
+		r, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return errors.Wrap(err, errNewARMRequest)
+		}
+		rsp, err := c.Do(r)
+		if err != nil {
+			return errors.Wrap(err, errARM)
+		}
+		return rsp.Body.Close()
+	}
+}
+
+// A ProfilingServer serves pprof endpoints. It runs on every replica,
+// regardless of leader election.
+type ProfilingServer struct {
+	addr string
+}
+
+// NewProfilingServer returns a ProfilingServer that listens on the supplied
+// address.
+func NewProfilingServer(addr string) *ProfilingServer {
+	return &ProfilingServer{addr: addr}
+}
+
+// NeedLeaderElection returns false; profiling is useful on every replica.
+func (s *ProfilingServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serving pprof endpoints until the supplied context is done.
+func (s *ProfilingServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	l, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return errors.Wrap(err, errListen)
+	}
+	srv := &http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
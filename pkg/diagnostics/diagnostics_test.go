@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestAPIServerChecker(t *testing.T) {
+	cases := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"Reachable":   {status: http.StatusOK},
+		"Unavailable": {status: http.StatusServiceUnavailable, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(`{"major":"1","minor":"20"}`))
+			}))
+			defer srv.Close()
+
+			check, err := APIServerChecker(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatalf("APIServerChecker(...): %s", err)
+			}
+			if err := check(httptest.NewRequest(http.MethodGet, "/readyz", nil)); (err != nil) != tc.wantErr {
+				t.Errorf("check(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestARMChecker(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// ARM rejects unauthenticated requests, which still proves it is
+		// reachable.
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	closed := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	closed.Close()
+	defer srv.Close()
+
+	cases := map[string]struct {
+		endpoint string
+		wantErr  bool
+	}{
+		"Reachable":   {endpoint: srv.URL},
+		"Unreachable": {endpoint: closed.URL, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			check := ARMChecker(tc.endpoint, srv.Client())
+			if err := check(httptest.NewRequest(http.MethodGet, "/readyz", nil)); (err != nil) != tc.wantErr {
+				t.Errorf("check(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
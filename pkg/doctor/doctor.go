@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package doctor diagnoses problems with the Azure credentials used by a
+// ProviderConfig.
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// Error strings.
+const (
+	errUnmarshalCredentials = "credentials must be a JSON object such as the output of az ad sp create-for-rbac --sdk-auth"
+	errMissingKeys          = "credentials are missing required keys"
+	errNewToken             = "cannot create service principal token"
+	errRefreshToken         = "cannot acquire token"
+	errGetSubscription      = "cannot get subscription"
+	errSubscriptionState    = "subscription is not enabled"
+	errGetProvider          = "cannot get resource provider"
+	errUnregistered         = "resource providers are not registered in the subscription; register them with az provider register --namespace"
+	errListApplications     = "cannot list Azure Active Directory applications; the AKSCluster controller needs Azure Active Directory Graph permission to read and write applications"
+)
+
+// Check names.
+const (
+	CheckCredentials           = "credentials"
+	CheckResourceManagerToken  = "resource-manager-token"
+	CheckSubscription          = "subscription"
+	CheckResourceProviders     = "resource-providers"
+	CheckGraphToken            = "graph-token"
+	CheckGraphReadApplications = "graph-read-applications"
+)
+
+// ValidateChecks are the checks needed for credentials to be usable at all.
+var ValidateChecks = []string{
+	CheckCredentials,
+	CheckResourceManagerToken,
+	CheckSubscription,
+}
+
+// AllChecks are the checks needed for credentials to be usable by every
+// controller of the provider. The AKSCluster controller also needs to write
+// Azure Active Directory applications, which cannot be checked without
+// creating one; only reading them is checked.
+var AllChecks = []string{
+	CheckCredentials,
+	CheckResourceManagerToken,
+	CheckSubscription,
+	CheckResourceProviders,
+	CheckGraphToken,
+	CheckGraphReadApplications,
+}
+
+// prerequisites of each check. A check is skipped if any of its
+// prerequisites did not pass.
+var prerequisites = map[string][]string{
+	CheckResourceManagerToken:  {CheckCredentials},
+	CheckSubscription:          {CheckResourceManagerToken},
+	CheckResourceProviders:     {CheckResourceManagerToken},
+	CheckGraphToken:            {CheckCredentials},
+	CheckGraphReadApplications: {CheckGraphToken},
+}
+
+// ResourceProviders that must be registered in the subscription for every
+// kind of managed resource to work.
+var ResourceProviders = []string{
+	"Microsoft.Cache",
+	"Microsoft.ContainerService",
+	"Microsoft.DBforMariaDB",
+	"Microsoft.DBforMySQL",
+	"Microsoft.DBforPostgreSQL",
+	"Microsoft.DocumentDB",
+	"Microsoft.ManagedIdentity",
+	"Microsoft.Network",
+	"Microsoft.Sql",
+	"Microsoft.Storage",
+}
+
+// requiredKeys of the credentials. The Graph resource ID is only required by
+// the Graph checks.
+var requiredKeys = []string{
+	azure.CredentialsKeyClientID,
+	azure.CredentialsKeyClientSecret,
+	azure.CredentialsKeyTenantID,
+	azure.CredentialsKeySubscriptionID,
+	azure.CredentialsKeyActiveDirectoryEndpointURL,
+	azure.CredentialsKeyResourceManagerEndpointURL,
+}
+
+// A TokenRefresher acquires an OAuth token.
+type TokenRefresher interface {
+	RefreshWithContext(ctx context.Context) error
+}
+
+// A SubscriptionGetter gets a subscription.
+type SubscriptionGetter interface {
+	Get(ctx context.Context, subscriptionID string) (subscriptions.Subscription, error)
+}
+
+// A ProviderGetter gets a resource provider.
+type ProviderGetter interface {
+	Get(ctx context.Context, resourceProviderNamespace string, expand string) (resources.Provider, error)
+}
+
+// An ApplicationLister lists Azure Active Directory applications.
+type ApplicationLister interface {
+	List(ctx context.Context, filter string) (graphrbac.ApplicationListResultPage, error)
+}
+
+// A Result of a check.
+type Result struct {
+	Check   string
+	Err     error
+	Skipped bool
+}
+
+// A Doctor checks Azure credentials.
+type Doctor struct {
+	creds map[string]string
+
+	// NewToken returns a token for the supplied resource.
+	NewToken func(resource string) (TokenRefresher, autorest.Authorizer, error)
+
+	// NewSubscriptionGetter, NewProviderGetter and NewApplicationLister
+	// return clients that use the supplied authorizer.
+	NewSubscriptionGetter func(a autorest.Authorizer) SubscriptionGetter
+	NewProviderGetter     func(a autorest.Authorizer) ProviderGetter
+	NewApplicationLister  func(a autorest.Authorizer) ApplicationLister
+
+	armAuthorizer   autorest.Authorizer
+	graphAuthorizer autorest.Authorizer
+}
+
+// New returns a Doctor for the supplied JSON encoded credentials, in the
+// format expected by a ProviderConfig. Credentials are parsed and tokens are
+// acquired the same way azure.GetAuthInfo does for controllers.
+func New(credentials []byte) (*Doctor, error) {
+	m, err := azure.UnmarshalCredentials(credentials)
+	if err != nil {
+		return nil, errors.Wrap(err, errUnmarshalCredentials)
+	}
+	d := &Doctor{creds: m}
+	d.NewToken = func(resource string) (TokenRefresher, autorest.Authorizer, error) {
+		t, err := azure.NewServicePrincipalToken(m, resource)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errNewToken)
+		}
+		return t, autorest.NewBearerAuthorizer(t), nil
+	}
+	d.NewSubscriptionGetter = func(a autorest.Authorizer) SubscriptionGetter {
+		c := subscriptions.NewClientWithBaseURI(m[azure.CredentialsKeyResourceManagerEndpointURL])
+		c.Authorizer = a
+		_ = c.AddToUserAgent(azure.UserAgent)
+		return c
+	}
+	d.NewProviderGetter = func(a autorest.Authorizer) ProviderGetter {
+		c := resources.NewProvidersClientWithBaseURI(m[azure.CredentialsKeyResourceManagerEndpointURL], m[azure.CredentialsKeySubscriptionID])
+		c.Authorizer = a
+		_ = c.AddToUserAgent(azure.UserAgent)
+		return c
+	}
+	d.NewApplicationLister = func(a autorest.Authorizer) ApplicationLister {
+		c := graphrbac.NewApplicationsClient(m[azure.CredentialsKeyTenantID])
+		c.Authorizer = a
+		_ = c.AddToUserAgent(azure.UserAgent)
+		return c
+	}
+	return d, nil
+}
+
+// Run the supplied checks in order. A check is skipped if a check it depends
+// on did not pass.
+func (d *Doctor) Run(ctx context.Context, checks ...string) []Result {
+	passed := map[string]bool{}
+	results := make([]Result, 0, len(checks))
+	for _, c := range checks {
+		r := Result{Check: c}
+		for _, p := range prerequisites[c] {
+			if !passed[p] {
+				r.Skipped = true
+			}
+		}
+		if !r.Skipped {
+			r.Err = d.run(ctx, c)
+			passed[c] = r.Err == nil
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+func (d *Doctor) run(ctx context.Context, check string) error {
+	switch check {
+	case CheckCredentials:
+		return d.checkCredentials()
+	case CheckResourceManagerToken:
+		a, err := d.checkToken(ctx, d.creds[azure.CredentialsKeyResourceManagerEndpointURL])
+		d.armAuthorizer = a
+		return err
+	case CheckSubscription:
+		return d.checkSubscription(ctx)
+	case CheckResourceProviders:
+		return d.checkResourceProviders(ctx)
+	case CheckGraphToken:
+		if d.creds[azure.CredentialsKeyActiveDirectoryGraphResourceID] == "" {
+			return errors.Errorf("%s: %s", errMissingKeys, azure.CredentialsKeyActiveDirectoryGraphResourceID)
+		}
+		a, err := d.checkToken(ctx, d.creds[azure.CredentialsKeyActiveDirectoryGraphResourceID])
+		d.graphAuthorizer = a
+		return err
+	case CheckGraphReadApplications:
+		_, err := d.NewApplicationLister(d.graphAuthorizer).List(ctx, "")
+		return errors.Wrap(err, errListApplications)
+	}
+	return errors.Errorf("unknown check %q", check)
+}
+
+func (d *Doctor) checkCredentials() error {
+	missing := []string{}
+	for _, k := range requiredKeys {
+		if d.creds[k] == "" {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("%s: %s", errMissingKeys, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (d *Doctor) checkToken(ctx context.Context, resource string) (autorest.Authorizer, error) {
+	t, a, err := d.NewToken(resource)
+	if err != nil {
+		return nil, err
+	}
+	return a, errors.Wrap(t.RefreshWithContext(ctx), errRefreshToken)
+}
+
+func (d *Doctor) checkSubscription(ctx context.Context) error {
+	s, err := d.NewSubscriptionGetter(d.armAuthorizer).Get(ctx, d.creds[azure.CredentialsKeySubscriptionID])
+	if err != nil {
+		return errors.Wrap(err, errGetSubscription)
+	}
+	if s.State != subscriptions.Enabled {
+		return errors.Errorf("%s: state is %s", errSubscriptionState, s.State)
+	}
+	return nil
+}
+
+func (d *Doctor) checkResourceProviders(ctx context.Context) error {
+	pg := d.NewProviderGetter(d.armAuthorizer)
+	unregistered := []string{}
+	for _, ns := range ResourceProviders {
+		p, err := pg.Get(ctx, ns, "")
+		if err != nil {
+			return errors.Wrapf(err, "%s %s", errGetProvider, ns)
+		}
+		if azure.ToString(p.RegistrationState) != "Registered" {
+			unregistered = append(unregistered, ns)
+		}
+	}
+	if len(unregistered) > 0 {
+		sort.Strings(unregistered)
+		return errors.Errorf("%s: %s", errUnregistered, strings.Join(unregistered, ", "))
+	}
+	return nil
+}
+
+// Report writes the supplied results to the supplied writer, and returns
+// true if every check passed.
+func Report(w io.Writer, results []Result) bool {
+	ok := true
+	for _, r := range results {
+		switch {
+		case r.Skipped:
+			ok = false
+			fmt.Fprintf(w, "[SKIP] %s: a check it depends on did not pass\n", r.Check)
+		case r.Err != nil:
+			ok = false
+			fmt.Fprintf(w, "[FAIL] %s: %s\n", r.Check, r.Err)
+		default:
+			fmt.Fprintf(w, "[ OK ] %s\n", r.Check)
+		}
+	}
+	return ok
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const creds = `{
+	"clientId": "cool-id",
+	"clientSecret": "cool-secret",
+	"tenantId": "cool-tenant",
+	"subscriptionId": "cool-subscription",
+	"activeDirectoryEndpointUrl": "https://login.microsoftonline.com",
+	"resourceManagerEndpointUrl": "https://management.azure.com/",
+	"activeDirectoryGraphResourceId": "https://graph.windows.net/"
+}`
+
+type token struct{ err error }
+
+func (t token) RefreshWithContext(_ context.Context) error { return t.err }
+
+type subscriptionGetter struct {
+	s   subscriptions.Subscription
+	err error
+}
+
+func (g subscriptionGetter) Get(_ context.Context, _ string) (subscriptions.Subscription, error) {
+	return g.s, g.err
+}
+
+type providerGetter map[string]string
+
+func (g providerGetter) Get(_ context.Context, ns string, _ string) (resources.Provider, error) {
+	return resources.Provider{RegistrationState: to.StringPtr(g[ns])}, nil
+}
+
+type applicationLister struct{ err error }
+
+func (l applicationLister) List(_ context.Context, _ string) (graphrbac.ApplicationListResultPage, error) {
+	return graphrbac.ApplicationListResultPage{}, l.err
+}
+
+func registered() providerGetter {
+	g := providerGetter{}
+	for _, ns := range ResourceProviders {
+		g[ns] = "Registered"
+	}
+	return g
+}
+
+func TestRun(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type fakes struct {
+		token           error
+		subscription    subscriptionGetter
+		providers       providerGetter
+		listApplication error
+	}
+
+	cases := map[string]struct {
+		creds  string
+		fakes  fakes
+		checks []string
+		want   []Result
+	}{
+		"AllPassed": {
+			creds: creds,
+			fakes: fakes{
+				subscription: subscriptionGetter{s: subscriptions.Subscription{State: subscriptions.Enabled}},
+				providers:    registered(),
+			},
+			checks: AllChecks,
+			want: []Result{
+				{Check: CheckCredentials},
+				{Check: CheckResourceManagerToken},
+				{Check: CheckSubscription},
+				{Check: CheckResourceProviders},
+				{Check: CheckGraphToken},
+				{Check: CheckGraphReadApplications},
+			},
+		},
+		"MissingKeys": {
+			creds:  `{"clientId": "cool-id"}`,
+			checks: ValidateChecks,
+			want: []Result{
+				{Check: CheckCredentials, Err: errors.New(errMissingKeys + ": clientSecret, tenantId, subscriptionId, activeDirectoryEndpointUrl, resourceManagerEndpointUrl")},
+				{Check: CheckResourceManagerToken, Skipped: true},
+				{Check: CheckSubscription, Skipped: true},
+			},
+		},
+		"TokenError": {
+			creds:  creds,
+			fakes:  fakes{token: errBoom},
+			checks: ValidateChecks,
+			want: []Result{
+				{Check: CheckCredentials},
+				{Check: CheckResourceManagerToken, Err: errors.Wrap(errBoom, errRefreshToken)},
+				{Check: CheckSubscription, Skipped: true},
+			},
+		},
+		"SubscriptionDisabled": {
+			creds:  creds,
+			fakes:  fakes{subscription: subscriptionGetter{s: subscriptions.Subscription{State: subscriptions.Disabled}}},
+			checks: ValidateChecks,
+			want: []Result{
+				{Check: CheckCredentials},
+				{Check: CheckResourceManagerToken},
+				{Check: CheckSubscription, Err: errors.New(errSubscriptionState + ": state is Disabled")},
+			},
+		},
+		"UnregisteredProviders": {
+			creds: creds,
+			fakes: fakes{providers: func() providerGetter {
+				g := registered()
+				g["Microsoft.DBforMySQL"] = "NotRegistered"
+				g["Microsoft.Cache"] = "Unregistering"
+				return g
+			}()},
+			checks: []string{CheckCredentials, CheckResourceManagerToken, CheckResourceProviders},
+			want: []Result{
+				{Check: CheckCredentials},
+				{Check: CheckResourceManagerToken},
+				{Check: CheckResourceProviders, Err: errors.New(errUnregistered + ": Microsoft.Cache, Microsoft.DBforMySQL")},
+			},
+		},
+		"GraphPermissionMissing": {
+			creds:  creds,
+			fakes:  fakes{listApplication: errBoom},
+			checks: []string{CheckCredentials, CheckGraphToken, CheckGraphReadApplications},
+			want: []Result{
+				{Check: CheckCredentials},
+				{Check: CheckGraphToken},
+				{Check: CheckGraphReadApplications, Err: errors.Wrap(errBoom, errListApplications)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := New([]byte(tc.creds))
+			if err != nil {
+				t.Fatalf("New(...): %s", err)
+			}
+			d.NewToken = func(_ string) (TokenRefresher, autorest.Authorizer, error) {
+				return token{err: tc.fakes.token}, autorest.NullAuthorizer{}, nil
+			}
+			d.NewSubscriptionGetter = func(_ autorest.Authorizer) SubscriptionGetter { return tc.fakes.subscription }
+			d.NewProviderGetter = func(_ autorest.Authorizer) ProviderGetter { return tc.fakes.providers }
+			d.NewApplicationLister = func(_ autorest.Authorizer) ApplicationLister {
+				return applicationLister{err: tc.fakes.listApplication}
+			}
+
+			got := d.Run(context.Background(), tc.checks...)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("Run(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
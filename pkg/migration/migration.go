@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration migrates stored custom resources to the storage version
+// of their CustomResourceDefinition.
+package migration
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// Error strings.
+const (
+	errAddToScheme      = "cannot add CustomResourceDefinitions to scheme"
+	errNewClient        = "cannot create Kubernetes client"
+	errGetCRD           = "cannot get CustomResourceDefinition"
+	errNoStorageVersion = "CustomResourceDefinition has no storage version"
+	errListResources    = "cannot list custom resources"
+	errUpdateResource   = "cannot update custom resource"
+	errUpdateCRDStatus  = "cannot update CustomResourceDefinition status"
+)
+
+// CustomResourceDefinitions whose custom resources may be stored at a version
+// other than their current storage version, e.g. because the storage version
+// changed when a kind graduated to a new API version.
+var CustomResourceDefinitions = []string{
+	"virtualnetworks.network.azure.crossplane.io",
+	"subnets.network.azure.crossplane.io",
+}
+
+// A StorageVersionMigrator migrates custom resources to the storage version of
+// their CustomResourceDefinition.
+type StorageVersionMigrator struct {
+	kube client.Client
+}
+
+// NewStorageVersionMigrator returns a StorageVersionMigrator that uses the
+// supplied client.
+func NewStorageVersionMigrator(c client.Client) *StorageVersionMigrator {
+	return &StorageVersionMigrator{kube: c}
+}
+
+// Migrate rewrites every custom resource of the named CustomResourceDefinition
+// so that the API server stores it at the current storage version, then
+// records that the storage version is the only stored version. Older versions
+// may be removed from the CustomResourceDefinition once this has succeeded.
+func (m *StorageVersionMigrator) Migrate(ctx context.Context, name string) error {
+	crd := &extv1.CustomResourceDefinition{}
+	if err := m.kube.Get(ctx, types.NamespacedName{Name: name}, crd); err != nil {
+		return errors.Wrap(err, errGetCRD)
+	}
+
+	storage := ""
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			storage = v.Name
+		}
+	}
+	if storage == "" {
+		return errors.New(errNoStorageVersion)
+	}
+	if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == storage {
+		return nil
+	}
+
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(schema.GroupVersionKind{Group: crd.Spec.Group, Version: storage, Kind: crd.Spec.Names.ListKind})
+	if err := m.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListResources)
+	}
+	for i := range l.Items {
+		// An update that changes nothing still causes the API server to
+		// write the resource at the storage version. A conflict means the
+		// resource was written by someone else since we listed it, which
+		// has the same effect.
+		err := m.kube.Update(ctx, &l.Items[i])
+		if err != nil && !kerrors.IsConflict(err) && !kerrors.IsNotFound(err) {
+			return errors.Wrap(err, errUpdateResource)
+		}
+	}
+
+	crd.Status.StoredVersions = []string{storage}
+	return errors.Wrap(m.kube.Status().Update(ctx, crd), errUpdateCRDStatus)
+}
+
+// Setup adds a runnable to the supplied manager that migrates the custom
+// resources of the named CustomResourceDefinitions to their storage versions.
+// It runs once, when the manager is elected leader.
+func Setup(mgr ctrl.Manager, l logging.Logger, crds ...string) error {
+	s := runtime.NewScheme()
+	if err := extv1.AddToScheme(s); err != nil {
+		return errors.Wrap(err, errAddToScheme)
+	}
+	c, err := client.New(mgr.GetConfig(), client.Options{Scheme: s, Mapper: mgr.GetRESTMapper()})
+	if err != nil {
+		return errors.Wrap(err, errNewClient)
+	}
+	m := NewStorageVersionMigrator(c)
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		for _, name := range crds {
+			// Resources that are not migrated are still served, so a
+			// failure here should not stop the provider.
+			if err := m.Migrate(ctx, name); err != nil {
+				l.Info("Cannot migrate custom resources to storage version", "crd", name, "error", err)
+				continue
+			}
+			l.Debug("Migrated custom resources to storage version", "crd", name)
+		}
+		return nil
+	}))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func crd(stored ...string) *extv1.CustomResourceDefinition {
+	return &extv1.CustomResourceDefinition{
+		Spec: extv1.CustomResourceDefinitionSpec{
+			Group: "network.azure.crossplane.io",
+			Names: extv1.CustomResourceDefinitionNames{ListKind: "SubnetList"},
+			Versions: []extv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha3", Served: true},
+				{Name: "v1beta1", Served: true, Storage: true},
+			},
+		},
+		Status: extv1.CustomResourceDefinitionStatus{StoredVersions: stored},
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	errBoom := errors.New("boom")
+	getCRD := func(stored ...string) test.MockGetFn {
+		return test.NewMockGetFn(nil, func(o client.Object) error {
+			crd(stored...).DeepCopyInto(o.(*extv1.CustomResourceDefinition))
+			return nil
+		})
+	}
+	listOne := test.NewMockListFn(nil, func(o client.ObjectList) error {
+		l := o.(*unstructured.UnstructuredList)
+		if l.GroupVersionKind() != (schema.GroupVersionKind{Group: "network.azure.crossplane.io", Version: "v1beta1", Kind: "SubnetList"}) {
+			return errors.Errorf("unexpected list kind %s", l.GroupVersionKind())
+		}
+		l.Items = []unstructured.Unstructured{{}}
+		return nil
+	})
+
+	cases := map[string]struct {
+		kube client.Client
+		want error
+	}{
+		"GetCRDError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: errors.Wrap(errBoom, errGetCRD),
+		},
+		"AlreadyMigrated": {
+			kube: &test.MockClient{
+				MockGet:  getCRD("v1beta1"),
+				MockList: test.NewMockListFn(errBoom),
+			},
+			want: nil,
+		},
+		"ListError": {
+			kube: &test.MockClient{
+				MockGet:  getCRD("v1alpha3", "v1beta1"),
+				MockList: test.NewMockListFn(errBoom),
+			},
+			want: errors.Wrap(errBoom, errListResources),
+		},
+		"UpdateError": {
+			kube: &test.MockClient{
+				MockGet:    getCRD("v1alpha3", "v1beta1"),
+				MockList:   listOne,
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			want: errors.Wrap(errBoom, errUpdateResource),
+		},
+		"UpdateConflict": {
+			kube: &test.MockClient{
+				MockGet:    getCRD("v1alpha3", "v1beta1"),
+				MockList:   listOne,
+				MockUpdate: test.NewMockUpdateFn(kerrors.NewConflict(schema.GroupResource{}, "cool", errBoom)),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil, func(o client.Object) error {
+					if diff := cmp.Diff([]string{"v1beta1"}, o.(*extv1.CustomResourceDefinition).Status.StoredVersions); diff != "" {
+						t.Errorf("StoredVersions: -want, +got:\n%s", diff)
+					}
+					return nil
+				}),
+			},
+			want: nil,
+		},
+		"UpdateCRDStatusError": {
+			kube: &test.MockClient{
+				MockGet:          getCRD("v1alpha3", "v1beta1"),
+				MockList:         listOne,
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+			},
+			want: errors.Wrap(errBoom, errUpdateCRDStatus),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := NewStorageVersionMigrator(tc.kube)
+			err := m.Migrate(context.Background(), "subnets.network.azure.crossplane.io")
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Migrate(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook serves admission webhooks for Azure managed resources.
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	cachev1beta1 "github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	computev1alpha3 "github.com/crossplane/provider-azure/apis/compute/v1alpha3"
+	databasev1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	databasev1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	managedidentityv1alpha3 "github.com/crossplane/provider-azure/apis/managedidentity/v1alpha3"
+)
+
+// Setup registers the validating webhooks of Azure managed resources with the
+// supplied manager's webhook server.
+func Setup(mgr ctrl.Manager) error {
+	for _, o := range []runtime.Object{
+		&cachev1beta1.Redis{},
+		&computev1alpha3.AKSCluster{},
+		&databasev1alpha3.CosmosDBAccount{},
+		&databasev1alpha3.MySQLDatabase{},
+		&databasev1alpha3.PostgreSQLDatabase{},
+		&databasev1alpha3.MySQLServerConfiguration{},
+		&databasev1alpha3.PostgreSQLServerConfiguration{},
+		&databasev1alpha3.PostgreSQLFlexibleServer{},
+		&databasev1alpha3.PostgreSQLFlexibleServerConfiguration{},
+		&databasev1alpha3.MySQLFlexibleServer{},
+		&databasev1alpha3.MSSQLServer{},
+		&databasev1alpha3.MSSQLDatabase{},
+		&databasev1alpha3.MSSQLElasticPool{},
+		&databasev1beta1.MySQLServer{},
+		&databasev1beta1.PostgreSQLServer{},
+		&databasev1beta1.MariaDBServer{},
+		&managedidentityv1alpha3.UserAssignedIdentity{},
+	} {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(o).Complete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}